@@ -141,20 +141,26 @@ var runtimeDecls = [...]struct {
 	{"uint64tofloat64", funcTag, 110},
 	{"uint32tofloat64", funcTag, 111},
 	{"complex128div", funcTag, 112},
-	{"racefuncenter", funcTag, 113},
+	{"searchStart", funcTag, 113},
+	{"searchTaskStart", funcTag, 114},
+	{"searchTaskEnd", funcTag, 115},
+	{"searchNanotime", funcTag, 116},
+	{"searchBudget", funcTag, 118},
+	{"searchPanic", funcTag, 119},
+	{"racefuncenter", funcTag, 120},
 	{"racefuncexit", funcTag, 5},
-	{"raceread", funcTag, 113},
-	{"racewrite", funcTag, 113},
-	{"racereadrange", funcTag, 114},
-	{"racewriterange", funcTag, 114},
-	{"msanread", funcTag, 114},
-	{"msanwrite", funcTag, 114},
+	{"raceread", funcTag, 120},
+	{"racewrite", funcTag, 120},
+	{"racereadrange", funcTag, 121},
+	{"racewriterange", funcTag, 121},
+	{"msanread", funcTag, 121},
+	{"msanwrite", funcTag, 121},
 	{"support_popcnt", varTag, 11},
 	{"support_sse41", varTag, 11},
 }
 
 func runtimeTypes() []*types.Type {
-	var typs [115]*types.Type
+	var typs [122]*types.Type
 	typs[0] = types.Bytetype
 	typs[1] = types.NewPtr(typs[0])
 	typs[2] = types.Types[TANY]
@@ -268,7 +274,14 @@ func runtimeTypes() []*types.Type {
 	typs[110] = functype(nil, []*Node{anonfield(typs[17])}, []*Node{anonfield(typs[13])})
 	typs[111] = functype(nil, []*Node{anonfield(typs[59])}, []*Node{anonfield(typs[13])})
 	typs[112] = functype(nil, []*Node{anonfield(typs[19]), anonfield(typs[19])}, []*Node{anonfield(typs[19])})
-	typs[113] = functype(nil, []*Node{anonfield(typs[48])}, nil)
-	typs[114] = functype(nil, []*Node{anonfield(typs[48]), anonfield(typs[48])}, nil)
+	typs[113] = functype(nil, nil, []*Node{anonfield(typs[32])})
+	typs[114] = functype(nil, []*Node{anonfield(typs[32]), anonfield(typs[32]), anonfield(typs[32])}, nil)
+	typs[115] = functype(nil, []*Node{anonfield(typs[32]), anonfield(typs[32])}, nil)
+	typs[116] = functype(nil, nil, []*Node{anonfield(typs[15])})
+	typs[117] = types.NewPtr(typs[15])
+	typs[118] = functype(nil, []*Node{anonfield(typs[117])}, []*Node{anonfield(typs[11])})
+	typs[119] = functype(nil, []*Node{anonfield(typs[6])}, []*Node{anonfield(typs[6])})
+	typs[120] = functype(nil, []*Node{anonfield(typs[48])}, nil)
+	typs[121] = functype(nil, []*Node{anonfield(typs[48]), anonfield(typs[48])}, nil)
 	return typs[:]
 }
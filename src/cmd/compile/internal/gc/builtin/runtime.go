@@ -182,6 +182,14 @@ func uint32tofloat64(uint32) float64
 
 func complex128div(num complex128, den complex128) (quo complex128)
 
+// search statements
+func searchStart() int
+func searchTaskStart(searchID, id, parent int)
+func searchTaskEnd(searchID, id int)
+func searchNanotime() int64
+func searchBudget(budget *int64) bool
+func searchPanic(v interface{}) interface{}
+
 // race detection
 func racefuncenter(uintptr)
 func racefuncexit()
@@ -20,6 +20,14 @@ func (p *noder) funcLit(expr *syntax.FuncLit) *Node {
 	n.Func.Outerfunc = Curfn
 
 	old := p.funchdr(n)
+	if searchClauseLits[expr] {
+		// The implicit parameters of a search clause are declared at
+		// the clause label rather than at the current line.
+		delete(searchClauseLits, expr)
+		for _, n1 := range ntype.List.Slice() {
+			n1.Left.Pos = n1.Pos
+		}
+	}
 
 	// steal ntype's argument names and
 	// leave a fresh copy in their place.
@@ -144,6 +152,9 @@ func typecheckclosure(func_ *Node, top int) {
 		typecheckslice(func_.Nbody.Slice(), Etop)
 		decldepth = olddd
 		Curfn = oldfn
+		if func_.Func.SearchChildren() {
+			typecheckSearchChildren(func_)
+		}
 	}
 
 	// Create top-level function
@@ -384,7 +384,7 @@ func createSimpleVars(automDecls []*Node) ([]*Node, []*dwarf.Var, map[*Node]bool
 	var decls []*Node
 	selected := make(map[*Node]bool)
 	for _, n := range automDecls {
-		if n.IsAutoTmp() {
+		if n.IsAutoTmp() || isSearchEngineVar(n) {
 			continue
 		}
 		var abbrev int
@@ -485,7 +485,7 @@ func createComplexVars(fnsym *obj.LSym, debugInfo *ssa.FuncDebug, automDecls []*
 		}
 		n := slot.N.(*Node)
 		parts := varParts[n]
-		if parts == nil {
+		if parts == nil || isSearchEngineVar(n) {
 			continue
 		}
 		// Don't work on this variable again, no matter how many slots it has.
@@ -536,7 +536,7 @@ func createDwarfVars(fnsym *obj.LSym, debugInfo *ssa.FuncDebug, automDecls []*No
 			continue
 		}
 		c := n.Sym.Name[0]
-		if c == '.' || n.Type.IsUntyped() {
+		if c == '.' || n.Type.IsUntyped() || isSearchEngineVar(n) {
 			continue
 		}
 		typename := dwarf.InfoPrefix + typesymname(n.Type)
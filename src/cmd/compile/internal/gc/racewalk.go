@@ -364,6 +364,7 @@ func instrumentnode(np **Node, init *Nodes, wr int, skip int) {
 	case OCALLMETH,
 		ORETURN,
 		ORETJMP,
+		OSEARCH,
 		OSELECT,
 		OEMPTY,
 		OBREAK,
@@ -298,6 +298,96 @@ func TestScopeRanges(t *testing.T) {
 	}
 }
 
+var searchfile = []testline{
+	{line: "package main"},
+	{line: "func main() {"},
+	{line: "	search 0; int {"},
+	{line: "	children:"},
+	{line: "		c := make(chan int, 1)"},
+	{line: "		if len(solution) < 2 {"},
+	{line: "			c <- node + 1"},
+	{line: "		}"},
+	{line: "		close(c)"},
+	{line: "		return c"},
+	{line: "	accept:"},
+	{line: "		return len(solution) == 2"},
+	{line: "	}"},
+	{line: "}"},
+}
+
+// TestSearchVars checks that the implicit parameters node and solution
+// of the clauses of a search statement are declared at the clause
+// labels, and that the variables of the search engine are omitted from
+// debug_info.
+func TestSearchVars(t *testing.T) {
+	testenv.MustHaveGoBuild(t)
+
+	if runtime.GOOS == "plan9" {
+		t.Skip("skipping on plan9; no DWARF symbol table in executables")
+	}
+
+	dir, err := ioutil.TempDir("", "TestSearchVars")
+	if err != nil {
+		t.Fatalf("could not create directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, f := gobuild(t, dir, searchfile)
+	defer f.Close()
+
+	dwarfData, err := f.DWARF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dwarfReader := dwarfData.Reader()
+
+	// decls maps the lines of the clause labels to the implicit
+	// parameters declared there.
+	decls := make(map[int64][]string)
+	var fn string
+	for {
+		entry, err := dwarfReader.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if entry == nil {
+			break
+		}
+		switch entry.Tag {
+		case dwarf.TagSubprogram:
+			fn, _ = entry.Val(dwarf.AttrName).(string)
+			continue
+		case dwarf.TagVariable, dwarf.TagFormalParameter:
+		default:
+			continue
+		}
+		if !strings.HasPrefix(fn, "main.") {
+			continue
+		}
+		name := entry.Val(dwarf.AttrName).(string)
+		if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "&.") {
+			t.Errorf("%s: engine variable %s in debug_info", fn, name)
+		}
+		if name == "node" || name == "solution" {
+			line := entry.Val(dwarf.AttrDeclLine).(int64)
+			decls[line] = append(decls[line], name)
+		}
+	}
+
+	for i, l := range searchfile {
+		if !strings.HasSuffix(l.line, ":") {
+			continue
+		}
+		if got := decls[int64(i+1)]; !checkVars([]string{"node", "solution"}, got) {
+			t.Errorf("line %d %q: declares %v, want node and solution", i+1, l.line, got)
+		}
+		delete(decls, int64(i+1))
+	}
+	for line, names := range decls {
+		t.Errorf("%v declared at line %d, want clause label", names, line)
+	}
+}
+
 func scopesToString(v []*lexblock) string {
 	r := make([]string, len(v))
 	for i, s := range v {
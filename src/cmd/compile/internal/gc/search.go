@@ -0,0 +1,2429 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gc
+
+import (
+	"cmd/compile/internal/syntax"
+	"cmd/compile/internal/types"
+	"cmd/internal/src"
+	"strconv"
+	"strings"
+)
+
+// searchStmt lowers the search statement
+//
+//	search Root; T; Concurrency; Context {
+//	strategy:
+//		...
+//	children:
+//		...
+//	accept:
+//		...
+//	reject:
+//		...
+//	priority:
+//		...
+//	key:
+//		...
+//	results:
+//		...
+//	stats:
+//		...
+//	}
+//
+// into an OSEARCH node whose body runs the search engine. Each clause
+// becomes a closure with the implicit parameters
+//
+//	node     T
+//	solution []T
+//	gid      *__GraphNode
+//	stop     func()
+//	done     <-chan struct{}
+//
+// and by default the engine explores the tree rooted at Root depth
+// first with Concurrency engine goroutines, which steal pending subtrees
+// from each other (see depthFirst). Once stop is called or the optional
+// search context is done, the engine goroutines stop expanding nodes,
+// hand the children channels they hold to goroutines draining them (see
+// kids), and the search statement completes. A goroutine sending
+// children on a channel must close it once it is done; done is closed
+// once the search is stopped or complete, so that a goroutine which
+// would send children forever can select on it to finish.
+//
+// A panic in a clause called on an engine goroutine stops the search
+// like stop. Once the engine goroutines are done, the search statement
+// panics with a *runtime.SearchPanic holding the first such value and
+// the stack trace of its panic, with the source positions of the clause.
+// Clauses called on the goroutine running the search statement panic
+// through it directly. See recovering.
+//
+// The children clause returns the children of node as a <-chan T, a []T,
+// or a pull function func() (T, bool) reporting false once the children
+// are exhausted; nil means node has no children. The clause returns the
+// same form throughout, and the engine is built for it (see kids), so
+// slices and pull functions spare the clause a goroutine per node.
+//
+// The optional results clause is called with a copy of each accepted
+// solution, by one goroutine at a time. The ordered clause is like the
+// results clause, but it is called after the search is complete, with
+// the solutions in the order a serial search accepts them.
+//
+// The strategy clause selects another traversal order; see
+// searchStrategy.
+//
+// The header options maxDepth and maxNodes limit the length of the
+// solutions reached and the number of nodes expanded. The first limit
+// hit stops the search as if stop was called, and the stats clause finds
+// the name of the option in stats.Limit. See limits.
+//
+// The header option deterministic selects, if true at run time, an
+// engine for the depthFirst strategy whose results clause sees the same
+// solutions in the same order, under the same graph nodes, on every run
+// and at every concurrency (see deterministic). The key and ordered
+// clauses are not allowed with it.
+//
+// The key clause maps a node to an interface{} key. Once a node with
+// some key was reached, nodes reached later with the same key are
+// skipped as if rejected; the root is reached first. This turns the
+// search of a graph with cycles into the search of a spanning tree. With
+// concurrency, which one of several paths to a node is kept depends on
+// the schedule.
+//
+// The cost clause makes the search a branch-and-bound search. It returns
+// a float64 lower bound on the cost of every solution through node, and
+// the exact cost of node's solution if it is accepted. A candidate whose
+// bound is not below the cost of the incumbent, the cheapest solution
+// accepted so far by any engine goroutine, is pruned along with its
+// subtree, and an accepted solution is delivered to the results clause
+// only if it becomes the new incumbent, so that the results clause sees
+// ever cheaper solutions. The optional best clause is
+// called with the final incumbent, if any, once the search is complete.
+// See incumbent.
+//
+// The stats clause is called once the search is complete, after the
+// ordered and best clauses, with the implicit parameter stats of type
+//
+//	type __SearchStats struct {
+//		Expanded   int     // nodes whose children were requested
+//		Rejected   int     // candidates rejected or skipped by key
+//		Pruned     int     // candidates pruned by their cost
+//		Accepted   int     // candidates accepted
+//		MaxDepth   int     // length of the longest solution reached
+//		Goroutines int     // engine goroutines started
+//		Lifetimes  []int64 // run time of each engine goroutine in ns
+//		Limit      string  // header option that stopped the search, or ""
+//	}
+//
+// The engine keeps the statistics only for a search with a stats
+// clause. Independently, every engine goroutine reports the subtrees it
+// expands as search task events to the execution tracer (see
+// runtime/search.go), so that go tool trace shows how the search tree
+// was partitioned.
+//
+// The engine is constructed as syntax and converted like any other
+// block, so that the user's type, root and concurrency expressions are
+// used as they were written. The synthesized nodes are positioned at the
+// search statement: the header at its expressions, each clause at its
+// label, and the engine itself at the closing brace, which keeps
+// diagnostics and scope marks in source order. Names introduced by the
+// lowering start with a '.' and cannot clash with user identifiers.
+// Variables with these names get no DWARF information, so that a
+// debugger stopped in a clause shows the user's variables and the
+// implicit parameters, declared at the clause label, only.
+func (p *noder) searchStmt(stmt *syntax.SearchStmt) *Node {
+	p.openScope(stmt.Pos())
+	// The engine calls the runtime support functions through aliases,
+	// which are undeclared again when the scope is closed.
+	for _, name := range searchRuntime {
+		s := lookup("." + name)
+		types.Pushdcl(s)
+		s.Def = asTypesNode(syslook(name))
+	}
+	n := p.nod(stmt, OSEARCH, nil, nil)
+	l := &searchLowering{stmt: stmt, pos: stmt.Pos()}
+	n.Nbody.Set(p.stmts(l.engine()))
+	// See typecheckSearchHeader.
+	if stmt.Concurrency != nil {
+		n.List.Append(oldname(lookup(".max")))
+	}
+	if stmt.MaxDepth != nil {
+		n.List.Append(oldname(lookup(".maxDepth")))
+	}
+	if stmt.MaxNodes != nil {
+		n.List.Append(oldname(lookup(".maxNodes")))
+	}
+	if stmt.Deterministic != nil {
+		n.List.Append(oldname(lookup(".deterministic")))
+	}
+	if stmt.Children != nil {
+		// See typecheckSearchChildren and typecheckSearchEngine.
+		children := oldname(lookup(".children"))
+		children.Name.Defn.Right.Func.SetSearchChildren(true)
+		k := &searchKids{
+			children: children,
+			kids:     oldname(lookup(".kids")),
+			abandon:  oldname(lookup(".abandon")).Name.Defn,
+			pull:     oldname(lookup(".pull")).Name.Defn,
+		}
+		l.pos = stmt.Rbrace
+		for i, form := range []types.EType{TSLICE, TFUNC} {
+			ops := l.kidsOps(form)
+			k.forms[i] = [2]*Node{p.expr(ops[0]), p.expr(ops[1])}
+		}
+		n.SetOpt(k)
+	}
+	p.closeScope(stmt.Rbrace)
+	return n
+}
+
+// searchKids records the parts of a search engine that depend on the
+// form of the children returned by the children clause, which is only
+// known once the clause is typechecked. The lowering declares the
+// children of the engine as the form <-chan T; see kids.
+type searchKids struct {
+	children *Node       // .children
+	kids     *Node       // type .kids
+	abandon  *Node       // definition of .abandon
+	pull     *Node       // definition of .pull
+	forms    [2][2]*Node // closures .abandon and .pull for []T and func() (T, bool)
+}
+
+// searchHeaderNames maps the variables holding the concurrency and the
+// options of a search header to their names in diagnostics.
+var searchHeaderNames = map[string]string{
+	".max":           "concurrency",
+	".maxDepth":      "maxDepth",
+	".maxNodes":      "maxNodes",
+	".deterministic": "deterministic",
+}
+
+// typecheckSearchHeader typechecks the definition of the variable v
+// holding the concurrency or an option of a search statement, ahead of
+// the engine using it. The value must be a non-negative integer, or a
+// boolean for the deterministic option. An invalid value is reported at
+// the search header, and v assumes type int or bool so that the engine
+// does not report it again.
+func typecheckSearchHeader(v *Node) {
+	what := searchHeaderNames[v.Sym.Name]
+	def := typecheck(v.Name.Defn, Etop)
+	x := def.Right
+	switch {
+	case x == nil || x.Type == nil:
+		// error reported elsewhere
+	case what == "deterministic":
+		if !x.Type.IsBoolean() {
+			yyerrorl(def.Pos, "search %s %L must be boolean", what, x)
+			v.Type = types.Types[TBOOL]
+		}
+	case !x.Type.IsInteger():
+		yyerrorl(def.Pos, "search %s %L must be integer", what, x)
+		v.Type = types.Types[TINT]
+	case Isconst(x, CTINT) && x.Val().U.(*Mpint).CmpInt64(0) < 0:
+		yyerrorl(def.Pos, "search %s %v must not be negative", what, x)
+	}
+}
+
+// typecheckSearchChildren completes the closure clo of a children
+// clause, whose body was typechecked with an interface{} result. The
+// clause returns the children in one of the forms <-chan T, []T, or
+// func() (T, bool): the form of the first value it returns other than
+// nil, to which all the values returned must be assignable. A clause
+// which returns only nil has no children, as a []T. The values and the
+// result of clo are converted to the form, so that the engine is built
+// for it (see typecheckSearchEngine).
+func typecheckSearchChildren(clo *Node) {
+	T := clo.Type.Params().Field(0).Type
+	forms := []*types.Type{
+		types.NewChan(T, types.Crecv),
+		types.NewSlice(T),
+		functype(nil, nil, []*Node{anonfield(T), anonfield(types.Types[TBOOL])}),
+	}
+	var returns []*Node
+	inspectList(clo.Nbody, func(n *Node) bool {
+		switch n.Op {
+		case OCLOSURE:
+			return false
+		case ORETURN:
+			if n.List.Len() == 1 && n.List.First().Type != nil {
+				returns = append(returns, n)
+			}
+			return false
+		}
+		return true
+	})
+
+	var form *types.Type
+	var nils []*Node
+	for _, n := range returns {
+		x := n.List.First()
+		if isnil(x) {
+			nils = append(nils, n)
+			continue
+		}
+		if x.Op == OCONVIFACE {
+			x = x.Left
+		}
+		if form == nil {
+			for _, t := range forms {
+				if assignop(x.Type, t, nil) != 0 {
+					form = t
+					break
+				}
+			}
+			if form == nil {
+				yyerrorl(n.Pos, "cannot use %L as type <-chan %v, []%v, or func() (%v, bool) in return argument", x, T, T, T)
+				continue
+			}
+		}
+		lno := setlineno(n)
+		n.List.SetFirst(assignconv(x, form, "return argument"))
+		lineno = lno
+	}
+	if form == nil {
+		form = forms[1]
+	}
+	for _, n := range nils {
+		lno := setlineno(n)
+		n.List.SetFirst(assignconv(typecheck(nodnil(), Erv), form, "return argument"))
+		lineno = lno
+	}
+
+	// makeclosure builds the type of the closure function from Rlist.
+	clo.Type.Results().Field(0).Type = form
+	clo.Rlist.First().Right = typenod(form)
+}
+
+// typecheckSearchEngine typechecks the body of the search statement n.
+// The children clause is typechecked ahead of the declaration of .kids,
+// which then becomes an alias of the form of the children, and the
+// functions for that form replace the ones declared by the lowering.
+func typecheckSearchEngine(n *Node) {
+	k, _ := n.Opt().(*searchKids)
+	n.SetOpt(nil)
+	for i, s := range n.Nbody.Slice() {
+		if k != nil && s.Op == ODCLTYPE && s.Left == k.kids {
+			k.specialize()
+		}
+		n.Nbody.SetIndex(i, typecheck(s, Etop))
+	}
+}
+
+// specialize makes the engine handle the form of children returned by
+// the typechecked children clause.
+func (k *searchKids) specialize() {
+	t := k.children.Type
+	if t == nil {
+		// error reported elsewhere
+		return
+	}
+	form := t.Results().Field(0).Type
+	k.kids.Name.Param.Ntype = typenod(form)
+	var ops [2]*Node
+	switch form.Etype {
+	case TSLICE:
+		ops = k.forms[0]
+	case TFUNC:
+		ops = k.forms[1]
+	default:
+		return
+	}
+	k.abandon.Right = ops[0]
+	k.pull.Right = ops[1]
+}
+
+// searchRuntime lists the runtime functions called by search engines.
+var searchRuntime = []string{"searchStart", "searchTaskStart", "searchTaskEnd", "searchNanotime", "searchBudget", "searchPanic"}
+
+// searchEngineNames records the names introduced by the lowering of
+// search statements.
+var searchEngineNames = make(map[string]bool)
+
+// searchClauseLits records the closures of the clauses of search
+// statements until the noder converts them; see funcLit.
+var searchClauseLits = make(map[*syntax.FuncLit]bool)
+
+// isSearchEngineVar reports whether n is a variable of a search engine,
+// or the copy of one captured by reference by a closure.
+func isSearchEngineVar(n *Node) bool {
+	return searchEngineNames[strings.TrimPrefix(n.Sym.Name, "&")]
+}
+
+// Search strategies.
+const (
+	searchDepthFirst = iota
+	searchBreadthFirst
+	searchBestFirst
+	searchIterativeDeepening
+)
+
+var searchStrategies = map[string]int{
+	"depthFirst":         searchDepthFirst,
+	"breadthFirst":       searchBreadthFirst,
+	"bestFirst":          searchBestFirst,
+	"iterativeDeepening": searchIterativeDeepening,
+}
+
+// searchStrategy returns the strategy selected by the strategy clause c
+// and, for an iterative-deepening search, the depth bound, if any. The
+// clause consists of one of the statements
+//
+//	depthFirst
+//	breadthFirst
+//	bestFirst
+//	iterativeDeepening
+//	iterativeDeepening(bound)
+//
+// A breadth-first search expands the nodes level by level. A best-first
+// search expands the nodes in increasing order of the float64 returned
+// by the priority clause. Both expand up to Concurrency nodes at a time.
+// An iterative-deepening search repeats a depth-first search with a
+// depth limit of 1, 2, ... until the limit cuts off no node or reaches
+// the positive bound. Each iteration visits the nodes above the limit
+// again, but only the solutions of length limit are delivered.
+func searchStrategy(c *syntax.BlockStmt) (strategy int, bound syntax.Expr) {
+	if len(c.List) == 1 {
+		if s, ok := c.List[0].(*syntax.ExprStmt); ok {
+			switch x := s.X.(type) {
+			case *syntax.Name:
+				if strategy, ok := searchStrategies[x.Value]; ok {
+					return strategy, nil
+				}
+			case *syntax.CallExpr:
+				if fn, ok := x.Fun.(*syntax.Name); ok && fn.Value == "iterativeDeepening" && len(x.ArgList) == 1 && !x.HasDots {
+					return searchIterativeDeepening, x.ArgList[0]
+				}
+			}
+		}
+	}
+	yyerrorpos(c.Pos(), "invalid search strategy; expected depthFirst, breadthFirst, bestFirst, or iterativeDeepening")
+	return searchDepthFirst, nil
+}
+
+// A searchLowering builds the syntax of a search engine.
+// All nodes it creates are positioned at pos.
+type searchLowering struct {
+	stmt     *syntax.SearchStmt
+	pos      src.Pos
+	strategy int
+	ordered  bool // the solutions are delivered by an ordered clause
+}
+
+// engine returns the statements implementing l.stmt.
+func (l *searchLowering) engine() []syntax.Stmt {
+	s := l.stmt
+	T := s.UType
+
+	// The header is evaluated once, before the search starts.
+	var concurrency syntax.Expr = l.lit(1)
+	if s.Concurrency != nil {
+		concurrency = s.Concurrency
+	}
+	// Only the Done method of the search context is used.
+	doneType := func() syntax.Expr { return l.chanType(syntax.RecvOnly, l.structType()) }
+	// The root and concurrency are assigned at their own positions,
+	// where the typechecker reports them if they are invalid.
+	l.pos = s.Root.Pos()
+	list := []syntax.Stmt{
+		l.varDecl(".root", T),
+		l.assign(".root", s.Root),
+	}
+	l.pos = concurrency.Pos()
+	list = append(list, l.def(".max", concurrency))
+	if s.MaxDepth != nil {
+		l.pos = s.MaxDepth.Pos()
+		list = append(list, l.def(".maxDepth", s.MaxDepth))
+	}
+	if s.MaxNodes != nil {
+		l.pos = s.MaxNodes.Pos()
+		list = append(list, l.def(".maxNodes", s.MaxNodes))
+	}
+	if s.Deterministic != nil {
+		l.pos = s.Deterministic.Pos()
+		list = append(list, l.def(".deterministic", s.Deterministic))
+	}
+	l.pos = s.Pos()
+	list = append(list, l.varDecl(".ctxDone", doneType()))
+	if s.Context != nil {
+		l.pos = s.Context.Pos()
+		list = append(list,
+			l.varDecl(".ctx", l.interfaceType("Done", l.funcType(nil, l.params(nil, doneType())))),
+			l.assign(".ctx", s.Context),
+			l.assign(".ctxDone", l.call(l.sel(".ctx", "Done"))),
+		)
+	}
+	list = append(list,
+		// This is the one piece of internals that the userland
+		// can potentially see.
+		l.typeDecl("__GraphNode", l.structType("Active", "bool", "ID", "int", "Parent", "int")),
+		l.typeDecl("__SearchStats", l.structType(
+			"Expanded", "int",
+			"Rejected", "int",
+			"Pruned", "int",
+			"Accepted", "int",
+			"MaxDepth", "int",
+			"Goroutines", "int",
+			"Lifetimes", l.sliceType("int64"),
+			"Limit", "string",
+		)),
+	)
+
+	var bound syntax.Expr
+	if s.Strategy != nil {
+		l.strategy, bound = searchStrategy(s.Strategy)
+	}
+	if s.Children == nil {
+		yyerrorpos(s.Pos(), "missing children clause in search statement")
+		return list
+	}
+	// The result becomes the form of the children once the clause is
+	// typechecked; see typecheckSearchChildren.
+	list = append(list, l.defClause(".children", l.clause(s.Children, l.interfaceType())))
+	if s.Accept != nil {
+		list = append(list, l.defClause(".accept", l.clause(s.Accept, l.name("bool"))))
+	}
+	if s.Reject != nil {
+		list = append(list, l.defClause(".reject", l.clause(s.Reject, l.name("bool"))))
+	}
+	if s.Key != nil {
+		list = append(list, l.defClause(".key", l.clause(s.Key, l.interfaceType())))
+	}
+	if s.Cost != nil {
+		if s.Accept == nil {
+			yyerrorpos(s.Cost.Pos(), "cost clause without accept clause in search statement")
+		}
+		list = append(list, l.defClause(".cost", l.clause(s.Cost, l.name("float64"))))
+	}
+	if s.Best != nil {
+		if s.Cost == nil {
+			yyerrorpos(s.Best.Pos(), "best clause without cost clause in search statement")
+		}
+		list = append(list, l.defClause(".best", l.clause(s.Best, nil)))
+	}
+	switch {
+	case l.strategy == searchBestFirst && s.Priority == nil:
+		yyerrorpos(s.Strategy.Pos(), "bestFirst search without priority clause")
+	case l.strategy != searchBestFirst && s.Priority != nil:
+		yyerrorpos(s.Priority.Pos(), "priority clause requires bestFirst strategy")
+	case s.Priority != nil:
+		list = append(list, l.defClause(".priority", l.clause(s.Priority, l.name("float64"))))
+	}
+	if s.Stats != nil {
+		list = append(list, l.defClause(".report", l.statsClause(s.Stats)))
+	}
+	if s.Results != nil {
+		label := "results"
+		if s.Ordered {
+			label = "ordered"
+		}
+		if s.Accept == nil {
+			yyerrorpos(s.Results.Pos(), "%s clause without accept clause in search statement", label)
+		}
+		if s.Ordered && l.strategy == searchBestFirst {
+			yyerrorpos(s.Results.Pos(), "ordered clause with bestFirst strategy")
+		}
+		l.ordered = s.Ordered
+	}
+	deterministic := s.Deterministic != nil
+	if deterministic {
+		// Only the depth-first engine has a deterministic mode.
+		if l.strategy != searchDepthFirst {
+			for name, strategy := range searchStrategies {
+				if strategy == l.strategy {
+					yyerrorpos(s.Strategy.Pos(), "deterministic search with %s strategy", name)
+				}
+			}
+			deterministic = false
+		}
+		if s.Key != nil {
+			yyerrorpos(s.Key.Pos(), "key clause in deterministic search")
+			deterministic = false
+		}
+		if l.ordered {
+			yyerrorpos(s.Results.Pos(), "ordered clause in deterministic search")
+			deterministic = false
+		}
+		if s.Cost != nil {
+			yyerrorpos(s.Cost.Pos(), "cost clause in deterministic search")
+			deterministic = false
+		}
+	}
+	if l.strategy == searchIterativeDeepening {
+		if bound == nil {
+			bound = l.lit(0)
+		} else {
+			l.pos = bound.Pos()
+		}
+		list = append(list,
+			l.varDecl(".bound", "int"),
+			l.assign(".bound", bound),
+		)
+	}
+
+	l.pos = s.Rbrace
+	list = append(list,
+		// .workers is the number of engine goroutines. A concurrency
+		// below 1 at run time runs the search on one of them.
+		l.def(".workers", l.call("int", ".max")),
+		l.ifStmt(l.op(syntax.Lss, ".workers", 1),
+			l.assign(".workers", 1)),
+		// .ticket hands out graph node IDs.
+		l.def(".ticket", l.call("make", l.chanType(0, "int"), ".workers")),
+		// .search identifies the search in the execution trace.
+		l.def(".search", l.call(".searchStart")),
+		// .done is closed by the first call of stop, at the latest
+		// once the search is complete. The search is stopped if .done
+		// or .ctxDone is closed.
+		l.def(".done", l.call("make", l.chanType(0, l.structType()))),
+		l.def(".stopping", l.call("make", l.chanType(0, l.structType()), 1)),
+		l.def(".stop", l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+			l.selectStmt(
+				l.commClause(l.send(".stopping", l.compositeLit(l.structType())),
+					l.exprStmt(l.call("close", ".done"))),
+				l.commClause(nil),
+			),
+		})),
+		l.def(".stopped", l.funcLit(l.funcType(nil, l.params(nil, "bool")), []syntax.Stmt{
+			l.selectStmt(
+				l.commClause(l.exprStmt(l.recv(".done")),
+					l.ret("true")),
+				l.commClause(l.exprStmt(l.recv(".ctxDone")),
+					l.ret("true")),
+				l.commClause(nil,
+					l.ret("false")),
+			),
+		})),
+	)
+	list = append(list, l.failing()...)
+	list = append(list, l.kids()...)
+	list = append(list, l.limits()...)
+	if s.Cost != nil {
+		list = append(list, l.incumbent()...)
+	}
+	if s.Results != nil {
+		list = append(list, l.deliver()...)
+	}
+	if s.Key != nil {
+		list = append(list, l.visited()...)
+	}
+	if s.Stats != nil {
+		list = append(list, l.varDecl(".stats", "__SearchStats"))
+	}
+	list = append(list,
+		l.def(".shutdown", l.call("make", l.chanType(0, l.structType()))),
+		l.goStmt(l.call(l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+			l.def(".id", 0),
+			l.forStmt(nil, nil, nil, l.selectStmt(
+				l.commClause(l.send(".ticket", ".id"),
+					l.incr(".id")),
+				l.commClause(l.exprStmt(l.recv(".shutdown")),
+					l.exprStmt(l.call("close", ".ticket")),
+					l.ret()),
+			)),
+		}))),
+	)
+
+	if s.Deterministic != nil && !deterministic {
+		// The option was reported above.
+		list = append(list, l.assign("_", ".deterministic"))
+	}
+	switch {
+	case deterministic:
+		list = append(list, l.ifElse(".deterministic", l.deterministic(), l.depthFirst()))
+	case l.strategy == searchDepthFirst, l.strategy == searchIterativeDeepening:
+		list = append(list, l.depthFirst()...)
+	case l.strategy == searchBreadthFirst, l.strategy == searchBestFirst:
+		list = append(list, l.frontier()...)
+	}
+	list = append(list,
+		// Close .done, so that the goroutines which would send
+		// children forever finish.
+		l.exprStmt(l.call(".stop")),
+		l.exprStmt(l.call("close", ".shutdown")),
+		l.ifStmt(l.op(syntax.Neq, ".panicked", "nil"),
+			l.exprStmt(l.call("panic", ".panicked"))),
+	)
+
+	if l.ordered {
+		// Deliver the solutions collected by an ordered clause. Calling
+		// stop in the clause ends the delivery.
+		list = append(list,
+			l.def(".halt", "false"),
+			l.def(".halting", l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+				l.assign(".halt", "true"),
+			})),
+			l.forStmt(l.rangeClause(l.list("_", ".f"), ".found"), nil, nil,
+				l.exprStmt(l.call(".results", l.sel(".f", "node"), l.sel(".f", "solution"), l.sel(".f", "gid"), ".halting", ".done")),
+				l.ifStmt(".halt", l.brk()),
+			),
+		)
+	}
+	if s.Best != nil {
+		if s.Cost != nil {
+			list = append(list, l.ifStmt(l.sel(".incumbent", "found"),
+				l.exprStmt(l.call(".best", l.sel(".incumbent", "node"), l.sel(".incumbent", "solution"), l.sel(".incumbent", "gid"), ".stop", ".done"))))
+		} else {
+			// The clause was reported above.
+			list = append(list, l.assign("_", ".best"))
+		}
+	}
+	if s.Stats != nil {
+		if s.MaxDepth != nil || s.MaxNodes != nil {
+			list = append(list, l.assign(l.sel(".stats", "Limit"), ".limited"))
+		}
+		list = append(list,
+			l.assign(l.sel(".stats", "Goroutines"), l.call("len", l.sel(".stats", "Lifetimes"))),
+			l.exprStmt(l.call(".report", ".stats")),
+		)
+	}
+	return list
+}
+
+// deliver returns the declaration of the function
+//
+//	.deliver(.node T, .solution []T, .gid *__GraphNode, .path []int)
+//
+// through which the engine passes an accepted solution to the results
+// clause. The solution is copied, and the results clause is never run
+// by two goroutines at once. For an ordered clause, the solutions are
+// instead collected in .found, sorted by their position .path in the
+// search tree, for delivery once the search is complete. The position
+// of a node is the list of the indexes of the node and its ancestors
+// among their siblings, so that a serial depth-first search accepts
+// solutions in lexical order of their positions. Breadth-first and
+// iterative-deepening searches accept shorter solutions first.
+func (l *searchLowering) deliver() []syntax.Stmt {
+	s := l.stmt
+	T := s.UType
+	copyOf := func(dst, src string, elem interface{}) []syntax.Stmt {
+		return []syntax.Stmt{
+			l.def(dst, l.call("make", l.sliceType(elem), l.call("len", src))),
+			l.exprStmt(l.call("copy", dst, src)),
+		}
+	}
+
+	var list, body []syntax.Stmt
+	list = append(list,
+		l.defClause(".results", l.clause(s.Results, nil)),
+		l.def(".resultsLock", l.call("make", l.chanType(0, l.structType()), 1)),
+	)
+	body = append(body, copyOf(".r", ".solution", T)...)
+	if l.ordered {
+		var less []syntax.Stmt
+		if l.strategy != searchDepthFirst {
+			less = append(less,
+				l.ifStmt(l.op(syntax.Neq, l.call("len", ".a"), l.call("len", ".b")),
+					l.ret(l.op(syntax.Lss, l.call("len", ".a"), l.call("len", ".b")))),
+			)
+		}
+		less = append(less,
+			l.forStmt(l.def(".k", 0), l.op(syntax.AndAnd, l.op(syntax.Lss, ".k", l.call("len", ".a")), l.op(syntax.Lss, ".k", l.call("len", ".b"))), l.incr(".k"),
+				l.ifStmt(l.op(syntax.Neq, l.index(".a", ".k"), l.index(".b", ".k")),
+					l.ret(l.op(syntax.Lss, l.index(".a", ".k"), l.index(".b", ".k")))),
+			),
+			l.ret(l.op(syntax.Lss, l.call("len", ".a"), l.call("len", ".b"))),
+		)
+		list = append(list,
+			l.typeDecl(".result", l.structType("path", l.sliceType("int"), "node", T, "solution", l.sliceType(T), "gid", l.ptrType("__GraphNode"))),
+			l.varDecl(".found", l.sliceType(".result")),
+			// .less reports whether position .a precedes position .b.
+			l.def(".less", l.funcLit(l.funcType(l.params(".a", l.sliceType("int"), ".b", l.sliceType("int")), l.params(nil, "bool")), less)),
+		)
+		body = append(body, copyOf(".p", ".path", "int")...)
+	}
+	body = append(body,
+		l.send(".resultsLock", l.compositeLit(l.structType())),
+		l.deferStmt(l.call(l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+			l.exprStmt(l.recv(".resultsLock")),
+		}))),
+	)
+	if l.ordered {
+		// Insert the solution at its position.
+		body = append(body,
+			l.def(".i", 0),
+			l.def(".j", l.call("len", ".found")),
+			l.forStmt(nil, l.op(syntax.Lss, ".i", ".j"), nil,
+				l.def(".h", l.op(syntax.Div, l.op(syntax.Add, ".i", ".j"), 2)),
+				l.ifElse(l.call(".less", l.sel(l.index(".found", ".h"), "path"), ".p"),
+					[]syntax.Stmt{l.assign(".i", l.op(syntax.Add, ".h", 1))},
+					[]syntax.Stmt{l.assign(".j", ".h")}),
+			),
+			l.assign(".found", l.call("append", ".found", l.compositeLit(".result"))),
+			l.exprStmt(l.call("copy", l.sliceExpr(".found", l.op(syntax.Add, ".i", 1), nil), l.sliceExpr(".found", ".i", nil))),
+			l.assign(l.index(".found", ".i"), l.compositeLit(".result",
+				l.keyValue("path", ".p"),
+				l.keyValue("node", ".node"),
+				l.keyValue("solution", ".r"),
+				l.keyValue("gid", ".gid"),
+			)),
+		)
+	} else {
+		body = append(body, l.exprStmt(l.call(".results", ".node", ".r", ".gid", ".stop", ".done")))
+	}
+
+	params := l.params(".node", T, ".solution", l.sliceType(T), ".gid", l.ptrType("__GraphNode"), ".path", l.sliceType("int"))
+	return append(list, l.def(".deliver", l.funcLit(l.funcType(params, nil), body)))
+}
+
+// visited returns the declarations of the set .visited of the keys
+// of the nodes seen by the search, which is shared by all engine
+// goroutines, and of the function
+//
+//	.visit(.k interface{}) bool
+//
+// which adds the key .k to the set and reports whether it is new.
+// Like a map key, a key which is not comparable causes a run-time
+// panic.
+func (l *searchLowering) visited() []syntax.Stmt {
+	return []syntax.Stmt{
+		l.def(".visited", l.call("make", l.mapType(l.interfaceType(), "bool"))),
+		l.def(".visitedLock", l.call("make", l.chanType(0, l.structType()), 1)),
+		l.def(".visit", l.funcLit(l.funcType(l.params(".k", l.interfaceType()), l.params(nil, "bool")), []syntax.Stmt{
+			l.send(".visitedLock", l.compositeLit(l.structType())),
+			l.def(".seen", l.index(".visited", ".k")),
+			l.assign(l.index(".visited", ".k"), "true"),
+			l.exprStmt(l.recv(".visitedLock")),
+			l.ret(l.not(".seen")),
+		})),
+	}
+}
+
+// visit returns the statement skipping the candidate .cand if the key
+// clause maps it to a key seen before. The candidate counts as rejected
+// in the statistics st.
+func (l *searchLowering) visit(st func() syntax.Expr) syntax.Stmt {
+	return l.ifStmt(l.not(l.call(".visit", l.callClause(".key", ".cand"))), l.skip(st)...)
+}
+
+// kids returns the declarations of the type .kids, an alias of the
+// form of the children returned by the children clause, and of the
+// functions
+//
+//	.abandon func(.k *.kids)
+//	.pull    func(.k *.kids) (.cand T, .ok bool)
+//
+// The form is only known once the clause is typechecked, so kids
+// declares .kids as <-chan T, with the functions for a channel, and
+// typecheckSearchEngine swaps in the form and its functions built by
+// kidsOps. nil means no children in every form.
+func (l *searchLowering) kids() []syntax.Stmt {
+	ops := l.kidsOps(TCHAN)
+	return []syntax.Stmt{
+		l.aliasDecl(".kids", l.chanType(syntax.RecvOnly, l.stmt.UType)),
+		l.def(".abandon", ops[0]),
+		l.def(".pull", ops[1]),
+	}
+}
+
+// kidsOps returns the functions .abandon and .pull for children of the
+// form with kind form: TCHAN, TSLICE, or TFUNC. .pull returns the next
+// child, if any. It gives up on a channel once stop is called or the
+// search context is done, even while nothing is sent on it. The engine
+// passes the children it gives up on to .abandon, which drains a channel
+// on a goroutine of its own until the goroutine sending on it closes it,
+// so that neither of them leaks and the search does not wait for them.
+// Slices and pull functions need no draining.
+func (l *searchLowering) kidsOps(form types.EType) [2]*syntax.FuncLit {
+	T := l.stmt.UType
+	k := func() syntax.Expr { return l.deref(".k") }
+	abandon := []syntax.Stmt{l.assign(k(), "nil")}
+	var pull syntax.Stmt
+	switch form {
+	case TCHAN:
+		abandon = []syntax.Stmt{
+			l.ifStmt(l.op(syntax.Neq, k(), "nil"),
+				l.goStmt(l.call(l.funcLit(l.funcType(l.params(".c", l.chanType(syntax.RecvOnly, T)), nil), []syntax.Stmt{
+					l.rangeStmt(nil, ".c"),
+				}), k())),
+				l.assign(k(), "nil")),
+		}
+		pull = l.ifStmt(l.op(syntax.Neq, k(), "nil"),
+			l.selectStmt(
+				l.commClause(l.assign(l.list(".cand", ".ok"), l.recv(k())),
+					l.ifStmt(l.not(".ok"),
+						l.assign(k(), "nil"))),
+				l.commClause(l.exprStmt(l.recv(".done")),
+					l.exprStmt(l.call(".abandon", ".k"))),
+				l.commClause(l.exprStmt(l.recv(".ctxDone")),
+					l.exprStmt(l.call(".abandon", ".k"))),
+			))
+	case TSLICE:
+		pull = l.ifStmt(l.op(syntax.Gtr, l.call("len", k()), 0),
+			l.assign(l.list(".cand", ".ok"), l.list(l.index(k(), 0), "true")),
+			l.assign(k(), l.sliceExpr(k(), 1, nil)))
+	case TFUNC:
+		pull = l.ifStmt(l.op(syntax.Neq, k(), "nil"),
+			l.assign(l.list(".cand", ".ok"), l.call(k())))
+	}
+	return [2]*syntax.FuncLit{
+		l.funcLit(l.funcType(l.params(".k", l.ptrType(".kids")), nil), abandon),
+		l.funcLit(l.funcType(l.params(".k", l.ptrType(".kids")), l.params(".cand", T, ".ok", "bool")), []syntax.Stmt{
+			pull,
+			l.ret(),
+		}),
+	}
+}
+
+// limits returns the declarations of the engine functions enforcing the
+// header options maxDepth and maxNodes, if any. A limit that is hit
+// stops the search by
+//
+//	.hit(.option string)
+//
+// which records the name of the option in .limited, unless the search
+// was stopped before. The budget of nodes left to expand is kept in
+// .budget, and
+//
+//	.spend() bool
+//
+// takes a node from it, reporting whether the budget allowed the
+// expansion; the engine calls it before requesting the children of a
+// node. The depth limit is checked by the engine as each candidate is
+// reached (see tooDeep).
+func (l *searchLowering) limits() []syntax.Stmt {
+	s := l.stmt
+	if s.MaxDepth == nil && s.MaxNodes == nil {
+		return nil
+	}
+	list := []syntax.Stmt{
+		l.varDecl(".limited", "string"),
+		l.def(".hit", l.funcLit(l.funcType(l.params(".option", "string"), nil), []syntax.Stmt{
+			l.selectStmt(
+				l.commClause(l.send(".stopping", l.compositeLit(l.structType())),
+					l.assign(".limited", ".option"),
+					l.exprStmt(l.call("close", ".done"))),
+				l.commClause(nil),
+			),
+		})),
+	}
+	if s.MaxNodes != nil {
+		list = append(list,
+			l.def(".budget", l.call("int64", ".maxNodes")),
+			l.def(".spend", l.funcLit(l.funcType(nil, l.params(nil, "bool")), []syntax.Stmt{
+				l.ifStmt(l.call(".searchBudget", l.addr(".budget")),
+					l.ret("true")),
+				l.exprStmt(l.call(".hit", l.strLit("maxNodes"))),
+				l.ret("false"),
+			})),
+		)
+	}
+	return list
+}
+
+// tooDeep returns the statements stopping the search with the maxDepth
+// option once the candidate completing solution is too deep, followed by
+// the statements stop.
+func (l *searchLowering) tooDeep(solution string, stop ...syntax.Stmt) []syntax.Stmt {
+	if l.stmt.MaxDepth == nil {
+		return nil
+	}
+	stop = append([]syntax.Stmt{l.exprStmt(l.call(".hit", l.strLit("maxDepth")))}, stop...)
+	return []syntax.Stmt{
+		l.ifStmt(l.op(syntax.Gtr, l.call("len", solution), l.call("int", ".maxDepth")), stop...),
+	}
+}
+
+// failing returns the declarations of the variable .panicked holding
+// the first panic recovered on an engine goroutine, as returned by
+// runtime.searchPanic, the channel .failed closed once it is set, and
+// the function
+//
+//	.fail(.v interface{})
+//
+// which records the panic value .v and stops the search.
+func (l *searchLowering) failing() []syntax.Stmt {
+	return []syntax.Stmt{
+		l.varDecl(".panicked", l.interfaceType()),
+		l.def(".failed", l.call("make", l.chanType(0, l.structType()))),
+		l.def(".failLock", l.call("make", l.chanType(0, l.structType()), 1)),
+		l.def(".fail", l.funcLit(l.funcType(l.params(".v", l.interfaceType()), nil), []syntax.Stmt{
+			l.def(".p", l.call(".searchPanic", ".v")),
+			l.send(".failLock", l.compositeLit(l.structType())),
+			l.ifStmt(l.op(syntax.Eql, ".panicked", "nil"),
+				l.assign(".panicked", ".p"),
+				l.exprStmt(l.call("close", ".failed"))),
+			l.exprStmt(l.recv(".failLock")),
+			l.exprStmt(l.call(".stop")),
+		})),
+	}
+}
+
+// recovering returns the statement deferring the recovery of a panic on
+// an engine goroutine, which passes the panic to .fail and then runs
+// list, so that the goroutine leaves the engine as if it were done.
+// Clauses are called with no engine lock held, except for the results
+// clause, whose locks are released by deferred calls.
+func (l *searchLowering) recovering(list ...syntax.Stmt) syntax.Stmt {
+	return l.deferStmt(l.call(l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+		l.def(".v", l.call("recover")),
+		l.ifStmt(l.op(syntax.Neq, ".v", "nil"),
+			append([]syntax.Stmt{l.exprStmt(l.call(".fail", ".v"))}, list...)...),
+	})))
+}
+
+// incumbent returns the declarations of the incumbent of a
+// branch-and-bound search, the cheapest solution accepted so far, which
+// is shared by all engine goroutines, and of the functions
+//
+//	.prunes(.c float64) bool
+//	.improve(.c float64, .node T, .solution []T, .gid *__GraphNode) bool
+//
+// .prunes reports whether a candidate whose cost clause returned .c
+// cannot lead to a solution cheaper than the incumbent. .improve, called
+// with .incumbentLock held, makes a copy of the accepted solution of cost
+// .c the incumbent if it is cheaper, and reports whether it did. Since
+// the bound of a candidate is also the cost of its solution, a candidate
+// as cheap as the incumbent is pruned; the first of several equally
+// cheap solutions is kept.
+func (l *searchLowering) incumbent() []syntax.Stmt {
+	T := l.stmt.UType
+	inc := func(field string) syntax.Expr { return l.sel(".incumbent", field) }
+	beaten := func() syntax.Expr {
+		return l.op(syntax.AndAnd, inc("found"), l.not(l.op(syntax.Lss, ".c", inc("cost"))))
+	}
+	return []syntax.Stmt{
+		l.typeDecl(".optimum", l.structType(
+			"found", "bool",
+			"cost", "float64",
+			"node", T,
+			"solution", l.sliceType(T),
+			"gid", l.ptrType("__GraphNode"),
+		)),
+		l.varDecl(".incumbent", ".optimum"),
+		l.def(".incumbentLock", l.call("make", l.chanType(0, l.structType()), 1)),
+		l.def(".prunes", l.funcLit(l.funcType(l.params(".c", "float64"), l.params(nil, "bool")), []syntax.Stmt{
+			l.send(".incumbentLock", l.compositeLit(l.structType())),
+			l.def(".p", beaten()),
+			l.exprStmt(l.recv(".incumbentLock")),
+			l.ret(".p"),
+		})),
+		l.def(".improve", l.funcLit(l.funcType(l.params(".c", "float64", ".node", T, ".solution", l.sliceType(T), ".gid", l.ptrType("__GraphNode")), l.params(nil, "bool")), []syntax.Stmt{
+			l.ifStmt(beaten(), l.ret("false")),
+			l.def(".s", l.call("make", l.sliceType(T), l.call("len", ".solution"))),
+			l.exprStmt(l.call("copy", ".s", ".solution")),
+			l.assign(".incumbent", l.compositeLit(".optimum",
+				l.keyValue("found", "true"),
+				l.keyValue("cost", ".c"),
+				l.keyValue("node", ".node"),
+				l.keyValue("solution", ".s"),
+				l.keyValue("gid", ".gid"),
+			)),
+			l.ret("true"),
+		})),
+	}
+}
+
+// prune returns the statements computing the cost .c of the candidate
+// .cand completing solution, and skipping the candidate if the cost
+// cannot beat the incumbent. The candidate counts as pruned in the
+// statistics st.
+func (l *searchLowering) prune(st func() syntax.Expr, solution string) []syntax.Stmt {
+	if l.stmt.Cost == nil {
+		return nil
+	}
+	return []syntax.Stmt{
+		l.def(".c", l.call(".cost", ".cand", solution, ".gid", ".stop", ".done")),
+		l.ifStmt(l.call(".prunes", ".c"), append(l.count(st, "Pruned"), l.cont())...),
+	}
+}
+
+// improving returns the statements list delivering the accepted
+// candidate .cand completing solution, which in a branch-and-bound
+// search run only if the solution becomes the incumbent. They run with
+// the incumbent locked, so that the results clause sees the incumbents
+// in decreasing order of cost; a deferred call releases the lock if the
+// results clause panics.
+func (l *searchLowering) improving(solution string, list ...syntax.Stmt) []syntax.Stmt {
+	if l.stmt.Cost == nil {
+		return list
+	}
+	return []syntax.Stmt{
+		l.exprStmt(l.call(l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+			l.send(".incumbentLock", l.compositeLit(l.structType())),
+			l.deferStmt(l.call(l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+				l.exprStmt(l.recv(".incumbentLock")),
+			}))),
+			l.ifStmt(l.call(".improve", ".c", ".cand", solution, ".gid"), list...),
+		}))),
+	}
+}
+
+// expanding returns the statements expanding a node by list, which
+// are skipped once the maxNodes budget is spent.
+func (l *searchLowering) expanding(list ...syntax.Stmt) []syntax.Stmt {
+	if l.stmt.MaxNodes == nil {
+		return list
+	}
+	return []syntax.Stmt{l.ifStmt(l.call(".spend"), list...)}
+}
+
+// depthFirst returns the statements running a depth-first or an
+// iterative-deepening search on a pool of .workers engine goroutines.
+//
+// The search tree is explored in frames: a frame holds the children
+// channel of a node along with the solution and position leading to the
+// node. Every worker owns a deque of the frames of the ancestors of the
+// node it is expanding. The owner pushes and pops frames at the end of
+// its deque as it descends into a child and returns from it, and idle
+// workers steal the oldest frame, the one closest to the root, from the
+// front of another deque. Stealing the oldest frame hands over the
+// largest pending subtree and keeps the thieves out of the owner's way.
+// A worker without frames waits for a push to signal .work; the search
+// is complete once all workers are waiting.
+func (l *searchLowering) depthFirst() []syntax.Stmt {
+	T := l.stmt.UType
+	frame := func() syntax.Expr { return l.ptrType(".frame") }
+	deque := func() syntax.Expr { return l.ptrType(".deque") }
+
+	fields := []interface{}{
+		"kids", ".kids",
+		"solution", l.sliceType(T),
+	}
+	if l.ordered {
+		// n is the number of children received from kids.
+		fields = append(fields, "path", l.sliceType("int"), "n", "int")
+	}
+	fields = append(fields, "gid", l.ptrType("__GraphNode"))
+	dequeFields := []interface{}{
+		"lock", l.chanType(0, l.structType()),
+		"frames", l.sliceType(frame()),
+	}
+	if l.stmt.Stats != nil {
+		// The statistics of the worker owning the deque.
+		dequeFields = append(dequeFields, "stats", "__SearchStats")
+	}
+	list := []syntax.Stmt{
+		l.typeDecl(".frame", l.structType(fields...)),
+		l.typeDecl(".deque", l.structType(dequeFields...)),
+		l.def(".deques", l.call("make", l.sliceType(deque()), ".workers")),
+		l.forStmt(l.rangeClause(".i", ".deques"), nil, nil,
+			l.assign(l.index(".deques", ".i"), l.addr(l.compositeLit(".deque",
+				l.keyValue("lock", l.call("make", l.chanType(0, l.structType()), 1))))),
+		),
+		// .work signals idle workers that a frame was pushed.
+		l.def(".work", l.call("make", l.chanType(0, l.structType()), ".workers")),
+	}
+	if l.strategy == searchIterativeDeepening {
+		list = append(list,
+			l.def(".limit", 0),
+			// .cutoff is ready if the depth limit cut off a node.
+			l.def(".cutoff", l.call("make", l.chanType(0, l.structType()), 1)),
+		)
+	}
+	list = append(list,
+		l.def(".steal", l.funcLit(l.funcType(l.params(".w", "int"), l.params(nil, frame())), l.stealBody())),
+		l.def(".run", l.funcLit(l.funcType(l.params(".d", deque(), ".f", frame()), nil), l.runBody())),
+	)
+
+	// The workers of one search; with iterative deepening, of one
+	// iteration.
+	idle := []syntax.Stmt{
+		l.send(".idleLock", l.compositeLit(l.structType())),
+		l.incr(".idle"),
+		l.ifStmt(l.op(syntax.Eql, ".idle", ".workers"),
+			l.exprStmt(l.call("close", ".finished"))),
+		l.exprStmt(l.recv(".idleLock")),
+		l.selectStmt(
+			l.commClause(l.exprStmt(l.recv(".work"))),
+			l.commClause(l.exprStmt(l.recv(".finished"))),
+		),
+		l.send(".idleLock", l.compositeLit(l.structType())),
+		l.ifStmt(l.op(syntax.Eql, ".idle", ".workers"), append(append(
+			[]syntax.Stmt{l.exprStmt(l.recv(".idleLock"))},
+			l.lifetime(func() syntax.Expr { return l.sel(l.index(".deques", ".w"), "stats") })...),
+			l.send(".exited", l.compositeLit(l.structType())),
+			l.ret())...),
+		l.assign(".idle", l.op(syntax.Sub, ".idle", 1)),
+		l.exprStmt(l.recv(".idleLock")),
+	}
+	worker := []syntax.Stmt{
+		l.ifStmt(l.op(syntax.Eql, ".f", "nil"),
+			l.assign(".f", l.call(".steal", ".w"))),
+		l.ifStmt(l.op(syntax.Neq, ".f", "nil"),
+			l.exprStmt(l.call(".run", l.index(".deques", ".w"), ".f")),
+			l.assign(".f", "nil"),
+			l.cont()),
+	}
+	worker = append(worker, idle...)
+	// A worker whose clause panicked remains idle for good.
+	recovered := l.recovering(
+		l.send(".idleLock", l.compositeLit(l.structType())),
+		l.incr(".idle"),
+		l.ifStmt(l.op(syntax.Eql, ".idle", ".workers"),
+			l.exprStmt(l.call("close", ".finished"))),
+		l.exprStmt(l.recv(".idleLock")),
+		l.send(".exited", l.compositeLit(l.structType())),
+	)
+
+	elems := []*syntax.KeyValueExpr{
+		l.keyValue("solution", ".solution"),
+		l.keyValue("gid", ".gid"),
+	}
+	run := []syntax.Stmt{
+		l.def(".idle", 0),
+		l.def(".idleLock", l.call("make", l.chanType(0, l.structType()), 1)),
+		l.def(".finished", l.call("make", l.chanType(0, l.structType()))),
+		l.def(".exited", l.call("make", l.chanType(0, l.structType()), ".workers")),
+		l.def(".worker", l.funcLit(l.funcType(l.params(".w", "int", ".f", frame()), nil), append(append([]syntax.Stmt{recovered}, l.clock()...),
+			l.forStmt(nil, nil, nil, worker...),
+		))),
+		// The first worker starts with the children of the root.
+		l.def(".solution", l.call("make", l.sliceType(T), 0)),
+		l.def(".gid", l.graphNode(0)),
+		l.def(".f", l.addr(l.compositeLit(".frame", elems...))),
+	}
+	if l.stmt.Key != nil {
+		run = append(run, l.exprStmt(l.call(".visit", l.callClause(".key", ".root"))))
+	}
+	expandRoot := l.count(func() syntax.Expr { return l.name(".stats") }, "Expanded")
+	expandRoot = append(expandRoot, l.assign(l.sel(".f", "kids"), l.callClause(".children", ".root")))
+	run = append(run, l.expanding(expandRoot...)...)
+	run = append(run,
+		l.goStmt(l.call(".worker", 0, ".f")),
+		l.forStmt(l.def(".w", 1), l.op(syntax.Lss, ".w", ".workers"), l.incr(".w"),
+			l.goStmt(l.call(".worker", ".w", "nil"))),
+		l.forStmt(l.rangeClause(nil, ".deques"), nil, nil,
+			l.exprStmt(l.recv(".exited"))),
+	)
+	if l.stmt.Stats != nil {
+		// Collect the statistics of the workers.
+		stats := func() syntax.Expr { return l.sel(".v", "stats") }
+		run = append(run, l.forStmt(l.rangeClause(l.list("_", ".v"), ".deques"), nil, nil,
+			append(l.mergeStats(stats), l.assign(stats(), l.compositeLit("__SearchStats")))...))
+	}
+	if l.strategy == searchIterativeDeepening {
+		// Deepen the search while the limit cuts off nodes. Every
+		// iteration starts with no node visited.
+		deepen := []syntax.Stmt{l.incr(".limit")}
+		if l.stmt.Key != nil {
+			deepen = append(deepen, l.assign(".visited", l.call("make", l.mapType(l.interfaceType(), "bool"))))
+		}
+		run = append(deepen, run...)
+		run = append(run,
+			l.def(".deeper", "false"),
+			l.selectStmt(
+				l.commClause(l.exprStmt(l.recv(".cutoff")),
+					l.assign(".deeper", "true")),
+				l.commClause(nil),
+			),
+			l.ifStmt(l.op(syntax.OrOr, l.op(syntax.OrOr, l.not(".deeper"), l.call(".stopped")), l.op(syntax.Eql, ".limit", ".bound")),
+				l.brk()),
+		)
+		return append(list, l.forStmt(nil, nil, nil, run...))
+	}
+	return append(list, run...)
+}
+
+// stealBody returns the body of the function
+//
+//	func(.w int) *.frame
+//
+// which takes the oldest frame from the deque of a worker other than .w,
+// or returns nil if all of them are empty. The thief copies the solution
+// and position of the frame, since the owner continues to extend them
+// beyond the frame's length, and expands the frame under a new graph
+// node whose parent is the graph node of the owner.
+func (l *searchLowering) stealBody() []syntax.Stmt {
+	T := l.stmt.UType
+	frames := func() syntax.Expr { return l.sel(".v", "frames") }
+	body := []syntax.Stmt{
+		l.def(".v", l.index(".deques", l.op(syntax.Rem, l.op(syntax.Add, ".w", ".k"), ".workers"))),
+		l.send(l.sel(".v", "lock"), l.compositeLit(l.structType())),
+		l.ifStmt(l.op(syntax.Eql, l.call("len", frames()), 0),
+			l.exprStmt(l.recv(l.sel(".v", "lock"))),
+			l.cont()),
+		l.def(".f", l.index(frames(), 0)),
+		l.assign(frames(), l.sliceExpr(frames(), 1, nil)),
+		l.exprStmt(l.recv(l.sel(".v", "lock"))),
+		l.def(".s", l.call("make", l.sliceType(T), l.call("len", l.sel(".f", "solution")))),
+		l.exprStmt(l.call("copy", ".s", l.sel(".f", "solution"))),
+		l.assign(l.sel(".f", "solution"), ".s"),
+	}
+	if l.ordered {
+		body = append(body,
+			l.def(".p", l.call("make", l.sliceType("int"), l.call("len", l.sel(".f", "path")))),
+			l.exprStmt(l.call("copy", ".p", l.sel(".f", "path"))),
+			l.assign(l.sel(".f", "path"), ".p"),
+		)
+	}
+	body = append(body,
+		l.assign(l.sel(".f", "gid"), l.graphNode(l.sel(l.sel(".f", "gid"), "ID"))),
+		l.ret(".f"),
+	)
+	return []syntax.Stmt{
+		l.forStmt(l.def(".k", 1), l.op(syntax.Lss, ".k", ".workers"), l.incr(".k"), body...),
+		l.ret("nil"),
+	}
+}
+
+// runBody returns the body of the function
+//
+//	func(.d *.deque, .f *.frame)
+//
+// with which a worker explores the subtree of frame .f depth first,
+// using its deque .d as the stack of the frames of the ancestors of the
+// current node. It returns once the deque is empty. When the search is
+// stopped, or a clause panics, the children of the current frame and of
+// the frames left in the deque are abandoned.
+func (l *searchLowering) runBody() []syntax.Stmt {
+	s := l.stmt
+	frames := func() syntax.Expr { return l.sel(".d", "frames") }
+	lock := func() syntax.Stmt { return l.send(l.sel(".d", "lock"), l.compositeLit(l.structType())) }
+	unlock := func() syntax.Stmt { return l.exprStmt(l.recv(l.sel(".d", "lock"))) }
+	last := func() syntax.Expr { return l.op(syntax.Sub, l.call("len", frames()), 1) }
+
+	// pop resumes the last frame on the deque, if any.
+	pop := func(done syntax.Stmt) []syntax.Stmt {
+		return []syntax.Stmt{
+			lock(),
+			l.ifStmt(l.op(syntax.Eql, l.call("len", frames()), 0),
+				unlock(),
+				done),
+			l.assign(".f", l.index(frames(), last())),
+			l.assign(frames(), l.sliceExpr(frames(), nil, last())),
+			unlock(),
+		}
+	}
+
+	stats := func() syntax.Expr { return l.sel(".d", "stats") }
+
+	var loop []syntax.Stmt
+	loop = append(loop,
+		l.def(l.list(".cand", ".ok"), l.call(".pull", l.addr(l.sel(".f", "kids")))),
+		l.ifStmt(l.not(".ok"), append(pop(l.brk()), l.cont())...),
+		l.def(".solution", l.sel(".f", "solution")),
+	)
+	path := syntax.Expr(l.name("nil"))
+	if l.ordered {
+		loop = append(loop,
+			l.def(".idx", l.sel(".f", "n")),
+			l.incr(l.sel(".f", "n")),
+		)
+		path = l.name(".path")
+	}
+	if s.Reject != nil {
+		loop = append(loop,
+			l.ifStmt(l.callClause(".reject", ".cand"), l.skip(stats)...))
+	}
+	if s.Key != nil {
+		loop = append(loop, l.visit(stats))
+	}
+	loop = append(loop, l.assign(".solution", l.call("append", ".solution", ".cand")))
+	loop = append(loop, l.tooDeep(".solution", l.brk())...)
+	loop = append(loop, l.depth(stats, ".solution")...)
+	loop = append(loop, l.prune(stats, ".solution")...)
+	if l.ordered {
+		loop = append(loop, l.def(".path", l.call("append", l.sel(".f", "path"), ".idx")))
+	}
+	if s.Accept != nil {
+		accepted := l.count(stats, "Accepted")
+		var deliver []syntax.Stmt
+		if s.Results != nil {
+			deliver = append(deliver, l.exprStmt(l.call(".deliver", ".cand", ".solution", ".gid", path)))
+			if l.strategy == searchIterativeDeepening {
+				// Shorter solutions were delivered by earlier iterations.
+				deliver = []syntax.Stmt{l.ifStmt(l.op(syntax.Eql, l.call("len", ".solution"), ".limit"), deliver...)}
+			}
+		}
+		if deliver != nil || s.Cost != nil {
+			accepted = append(accepted, l.improving(".solution", deliver...)...)
+		}
+		accepted = append(accepted, l.cont())
+		loop = append(loop, l.ifStmt(l.callClause(".accept", ".cand"), accepted...))
+	}
+	if l.strategy == searchIterativeDeepening {
+		loop = append(loop, l.ifStmt(l.op(syntax.Geq, l.call("len", ".solution"), ".limit"),
+			l.selectStmt(
+				l.commClause(l.send(".cutoff", l.compositeLit(l.structType()))),
+				l.commClause(nil),
+			),
+			l.cont(),
+		))
+	}
+
+	// Descend into the candidate, leaving the current frame to thieves.
+	elems := []*syntax.KeyValueExpr{l.keyValue("solution", ".solution")}
+	if l.ordered {
+		elems = append(elems, l.keyValue("path", ".path"))
+	}
+	elems = append(elems, l.keyValue("gid", ".gid"))
+	loop = append(loop, l.ifStmt(l.call(".stopped"), l.brk()))
+	if s.MaxNodes != nil {
+		loop = append(loop, l.ifStmt(l.not(l.call(".spend")), l.brk()))
+	}
+	loop = append(loop,
+		lock(),
+		l.assign(frames(), l.call("append", frames(), ".f")),
+		unlock(),
+		l.selectStmt(
+			l.commClause(l.send(".work", l.compositeLit(l.structType()))),
+			l.commClause(nil),
+		),
+		l.assign(".f", l.addr(l.compositeLit(".frame", elems...))),
+	)
+	loop = append(loop, l.count(stats, "Expanded")...)
+	loop = append(loop, l.assign(l.sel(".f", "kids"), l.callClause(".children", ".cand")))
+
+	return []syntax.Stmt{
+		l.deferStmt(l.call(l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+			lock(),
+			l.forStmt(l.rangeClause(l.list("_", ".g"), frames()), nil, nil,
+				l.exprStmt(l.call(".abandon", l.addr(l.sel(".g", "kids"))))),
+			l.assign(frames(), "nil"),
+			unlock(),
+			l.exprStmt(l.call(".abandon", l.addr(l.sel(".f", "kids")))),
+		}))),
+		l.def(".gid", l.sel(".f", "gid")),
+		l.exprStmt(l.call(".searchTaskStart", ".search", l.sel(".gid", "ID"), l.sel(".gid", "Parent"))),
+		l.forStmt(nil, l.not(l.call(".stopped")), nil, loop...),
+		l.assign(l.sel(".gid", "Active"), "false"),
+		l.exprStmt(l.call(".searchTaskEnd", ".search", l.sel(".gid", "ID"))),
+	}
+}
+
+// searchUnits is the number of units a deterministic search splits its
+// tree into; see deterministic.
+const searchUnits = 256
+
+// deterministic returns the statements running a depth-first search
+// whose results do not depend on the schedule, on a pool of .workers
+// engine goroutines.
+//
+// The calling goroutine first splits the search tree, level by level, into
+// a list of .units in depth-first order: subtrees rooted at candidates
+// to be explored, and leaves holding accepted solutions. The split goes
+// on until there are searchUnits units or nothing left to split, so that
+// it does not depend on the concurrency. The workers then take the
+// subtrees in order and explore each depth first, buffering its accepted
+// solutions, while the calling goroutine passes the leaves and buffered
+// solutions to the results clause in the order of the units. Unit i is
+// expanded under the graph node with ID i+1, whose parent is the root.
+//
+// Calling stop in a clause stops the search at the position of the call
+// rather than at once: the unit calling it is cut off there, units after
+// it are skipped, and units before it still run to completion, as in a
+// serial search. Clause calls of later units may have run by then. The
+// results clause, the limits, and the search context stop the search at
+// once; which solutions a limit or the context cuts off depends on the
+// schedule.
+func (l *searchLowering) deterministic() []syntax.Stmt {
+	s := l.stmt
+	T := s.UType
+	unit := func() syntax.Expr { return l.ptrType(".unit") }
+	u := func(field string) syntax.Expr { return l.sel(".u", field) }
+	stats := func() syntax.Expr { return l.name(".stats") }
+
+	list := []syntax.Stmt{
+		l.typeDecl(".sol", l.structType("node", T, "solution", l.sliceType(T))),
+		// A unit with leaf set is an accepted solution. Otherwise, done
+		// is closed once the subtree is explored and its solutions are
+		// in found.
+		l.typeDecl(".unit", l.structType(
+			"node", T,
+			"solution", l.sliceType(T),
+			"leaf", "bool",
+			"gid", l.ptrType("__GraphNode"),
+			"found", l.sliceType(".sol"),
+			"done", l.chanType(0, l.structType()),
+		)),
+		l.typeDecl(".level", l.structType("kids", ".kids", "solution", l.sliceType(T))),
+		l.varDecl(".units", l.sliceType(unit())),
+	}
+
+	// The split; stop only ends it, since the units split off before
+	// the call still have to be explored.
+	var split []syntax.Stmt
+	split = append(split,
+		l.def(".halted", "false"),
+		l.def(".stop", l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+			l.assign(".halted", "true"),
+		})),
+		l.def(".stopped", l.funcLit(l.funcType(nil, l.params(nil, "bool")), []syntax.Stmt{
+			l.ret(l.op(syntax.OrOr, ".halted", l.call(".stopped"))),
+		})),
+		l.def(".gid", l.graphNode(0)),
+		l.exprStmt(l.call(".searchTaskStart", ".search", l.sel(".gid", "ID"), l.sel(".gid", "Parent"))),
+		l.assign(".units", l.compositeLit(l.sliceType(unit()), l.keyValue(0, l.addr(l.compositeLit(".unit",
+			l.keyValue("node", ".root"),
+			l.keyValue("solution", l.call("make", l.sliceType(T), 0))))))),
+	)
+	var cand []syntax.Stmt
+	cand = append(cand,
+		l.def(l.list(".cand", ".ok"), l.call(".pull", l.addr(".kids"))),
+		l.ifStmt(l.not(".ok"), l.brk()),
+	)
+	if s.Reject != nil {
+		cand = append(cand, l.ifStmt(l.callClause(".reject", ".cand"), l.skip(stats)...))
+	}
+	cand = append(cand,
+		l.def(".s", l.call("make", l.sliceType(T), l.op(syntax.Add, l.call("len", ".solution"), 1))),
+		l.exprStmt(l.call("copy", ".s", ".solution")),
+		l.assign(l.index(".s", l.call("len", ".solution")), ".cand"),
+	)
+	cand = append(cand, l.tooDeep(".s", l.brk())...)
+	cand = append(cand, l.depth(stats, ".s")...)
+	if s.Accept != nil {
+		accepted := l.count(stats, "Accepted")
+		accepted = append(accepted,
+			l.assign(".next", l.call("append", ".next", l.addr(l.compositeLit(".unit",
+				l.keyValue("node", ".cand"),
+				l.keyValue("solution", ".s"),
+				l.keyValue("leaf", "true"),
+				l.keyValue("gid", ".gid"))))),
+			l.cont(),
+		)
+		cand = append(cand, l.ifStmt(l.call(".accept", ".cand", ".s", ".gid", ".stop", ".done"), accepted...))
+	}
+	cand = append(cand,
+		l.ifStmt(l.call(".stopped"), l.brk()),
+		l.assign(".next", l.call("append", ".next", l.addr(l.compositeLit(".unit",
+			l.keyValue("node", ".cand"),
+			l.keyValue("solution", ".s"))))),
+		l.assign(".more", "true"),
+	)
+	expand := l.count(stats, "Expanded")
+	expand = append(expand,
+		l.def(".solution", u("solution")),
+		l.def(".kids", l.callClause(".children", u("node"))),
+		l.forStmt(nil, l.not(l.call(".stopped")), nil, cand...),
+		l.exprStmt(l.call(".abandon", l.addr(".kids"))),
+	)
+	level := []syntax.Stmt{
+		l.ifStmt(l.call(".stopped"), l.brk()),
+		l.ifStmt(u("leaf"),
+			l.assign(".next", l.call("append", ".next", ".u")),
+			l.cont()),
+	}
+	if s.MaxNodes != nil {
+		level = append(level, l.ifStmt(l.not(l.call(".spend")), l.brk()))
+	}
+	level = append(level, expand...)
+	split = append(split,
+		l.def(".more", "true"),
+		l.forStmt(nil, l.op(syntax.AndAnd, l.op(syntax.AndAnd, ".more", l.op(syntax.Lss, l.call("len", ".units"), searchUnits)), l.not(l.call(".stopped"))), nil,
+			l.assign(".more", "false"),
+			l.varDecl(".next", l.sliceType(unit())),
+			l.forStmt(l.rangeClause(l.list("_", ".u"), ".units"), nil, nil, level...),
+			l.assign(".units", ".next"),
+		),
+		l.assign(l.sel(".gid", "Active"), "false"),
+		l.exprStmt(l.call(".searchTaskEnd", ".search", l.sel(".gid", "ID"))),
+	)
+	list = append(list, l.block(split...))
+
+	list = append(list,
+		// Units after .cut are skipped.
+		l.def(".cut", l.call("len", ".units")),
+		l.def(".cutLock", l.call("make", l.chanType(0, l.structType()), 1)),
+		l.def(".queue", l.call("make", l.chanType(0, "int"), l.call("len", ".units"))),
+		l.forStmt(l.rangeClause(l.list(".i", ".u"), ".units"), nil, nil,
+			l.ifStmt(u("leaf"), l.cont()),
+			l.assign(u("gid"), l.addr(l.compositeLit("__GraphNode",
+				l.keyValue("Active", "true"),
+				l.keyValue("ID", l.op(syntax.Add, ".i", 1)),
+				l.keyValue("Parent", 0)))),
+			l.assign(u("done"), l.call("make", l.chanType(0, l.structType()))),
+			l.send(".queue", ".i"),
+		),
+		l.exprStmt(l.call("close", ".queue")),
+	)
+	params := l.params(".i", "int", ".u", unit())
+	if s.Stats != nil {
+		params = l.params(".i", "int", ".u", unit(), ".st", l.ptrType("__SearchStats"))
+	}
+	list = append(list, l.def(".explore", l.funcLit(l.funcType(params, nil), l.exploreBody())))
+
+	wstats := func() syntax.Expr { return l.index(".wstats", ".w") }
+	explore := l.call(".explore", ".i", l.index(".units", ".i"))
+	if s.Stats != nil {
+		explore.ArgList = append(explore.ArgList, l.addr(wstats()))
+		list = append(list, l.def(".wstats", l.call("make", l.sliceType("__SearchStats"), ".workers")))
+	}
+	worker := l.clock()
+	worker = append(worker, l.forStmt(l.rangeClause(".i", ".queue"), nil, nil, l.exprStmt(explore)))
+	worker = append(worker, l.lifetime(wstats)...)
+	worker = append(worker, l.send(".exited", l.compositeLit(l.structType())))
+	list = append(list,
+		l.def(".exited", l.call("make", l.chanType(0, l.structType()), ".workers")),
+		l.forStmt(l.def(".w", 0), l.op(syntax.Lss, ".w", ".workers"), l.incr(".w"),
+			l.goStmt(l.call(l.funcLit(l.funcType(l.params(".w", "int"), nil), worker), ".w"))),
+	)
+
+	if s.Results != nil {
+		// Deliver the solutions in the order of the units. Calling stop
+		// in the results clause ends the delivery, as does a panic on a
+		// worker. A panic of the results clause stops the workers before
+		// it goes on.
+		deliver := func(r string, gid interface{}) syntax.Stmt {
+			return l.exprStmt(l.call(".results", l.sel(r, "node"), l.sel(r, "solution"), gid, ".halting", ".done"))
+		}
+		list = append(list,
+			l.def(".halt", "false"),
+			l.def(".halting", l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+				l.assign(".halt", "true"),
+				l.exprStmt(l.call(".stop")),
+			})),
+			l.exprStmt(l.call(l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+				l.deferStmt(l.call(l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+					l.def(".v", l.call("recover")),
+					l.ifStmt(l.op(syntax.Neq, ".v", "nil"),
+						l.exprStmt(l.call(".stop")),
+						l.exprStmt(l.call("panic", ".v"))),
+				}))),
+				l.forStmt(l.rangeClause(l.list(".i", ".u"), ".units"), nil, nil,
+					l.send(".cutLock", l.compositeLit(l.structType())),
+					l.def(".skip", l.op(syntax.Gtr, ".i", ".cut")),
+					l.exprStmt(l.recv(".cutLock")),
+					l.selectStmt(
+						l.commClause(l.exprStmt(l.recv(".failed")),
+							l.assign(".skip", "true")),
+						l.commClause(nil),
+					),
+					l.ifStmt(l.op(syntax.OrOr, ".halt", ".skip"), l.brk()),
+					l.ifStmt(u("leaf"),
+						deliver(".u", u("gid")),
+						l.cont()),
+					l.exprStmt(l.recv(u("done"))),
+					l.forStmt(l.rangeClause(l.list("_", ".r"), u("found")), nil, nil,
+						l.ifStmt(".halt", l.brk()),
+						deliver(".r", u("gid"))),
+				),
+			}))),
+		)
+	}
+	list = append(list, l.forStmt(l.def(".w", 0), l.op(syntax.Lss, ".w", ".workers"), l.incr(".w"),
+		l.exprStmt(l.recv(".exited"))))
+	if s.Stats != nil {
+		list = append(list, l.forStmt(l.rangeClause(".w", ".wstats"), nil, nil, l.mergeStats(wstats)...))
+	}
+	return list
+}
+
+// exploreBody returns the body of the function
+//
+//	func(.i int, .u *.unit)
+//
+// with which a worker of a deterministic search explores the subtree of
+// unit .i depth first, using .stack as the stack of the children of the
+// ancestors of the current node. The accepted solutions are copied to
+// .u.found. The worker shadows stop so that a clause calling it cuts the
+// search off after the unit (see deterministic). The children left on
+// the stack of a stopped search are abandoned.
+func (l *searchLowering) exploreBody() []syntax.Stmt {
+	s := l.stmt
+	T := s.UType
+	level := func() syntax.Expr { return l.ptrType(".level") }
+	u := func(field string) syntax.Expr { return l.sel(".u", field) }
+	stats := func() syntax.Expr { return l.name(".st") }
+	lock := func() syntax.Stmt { return l.send(".cutLock", l.compositeLit(l.structType())) }
+	unlock := func() syntax.Stmt { return l.exprStmt(l.recv(".cutLock")) }
+
+	abandon := l.forStmt(l.rangeClause(l.list("_", ".f"), ".stack"), nil, nil,
+		l.exprStmt(l.call(".abandon", l.addr(l.sel(".f", "kids")))))
+	list := []syntax.Stmt{
+		l.varDecl(".stack", l.sliceType(level())),
+		l.recovering(abandon, l.exprStmt(l.call("close", u("done")))),
+		l.def(".gid", u("gid")),
+		l.exprStmt(l.call(".searchTaskStart", ".search", l.sel(".gid", "ID"), l.sel(".gid", "Parent"))),
+		l.def(".halted", "false"),
+		l.def(".stop", l.funcLit(l.funcType(nil, nil), []syntax.Stmt{
+			l.assign(".halted", "true"),
+			lock(),
+			l.ifStmt(l.op(syntax.Lss, ".i", ".cut"),
+				l.assign(".cut", ".i")),
+			unlock(),
+		})),
+		l.def(".stopped", l.funcLit(l.funcType(nil, l.params(nil, "bool")), []syntax.Stmt{
+			l.ifStmt(l.op(syntax.OrOr, ".halted", l.call(".stopped")),
+				l.ret("true")),
+			lock(),
+			l.def(".skip", l.op(syntax.Gtr, ".i", ".cut")),
+			unlock(),
+			l.ret(".skip"),
+		})),
+		l.def(".solution", u("solution")),
+	}
+	push := func(node interface{}) []syntax.Stmt {
+		list := l.count(stats, "Expanded")
+		return append(list, l.assign(".stack", l.call("append", ".stack", l.addr(l.compositeLit(".level",
+			l.keyValue("kids", l.callClause(".children", node)),
+			l.keyValue("solution", ".solution"))))))
+	}
+	list = append(list, l.ifStmt(l.not(l.call(".stopped")), l.expanding(push(u("node"))...)...))
+
+	loop := []syntax.Stmt{
+		l.def(".f", l.last(".stack")),
+		l.ifStmt(l.call(".stopped"), l.brk()),
+		l.def(l.list(".cand", ".ok"), l.call(".pull", l.addr(l.sel(".f", "kids")))),
+		l.ifStmt(l.not(".ok"),
+			l.trim(".stack"),
+			l.cont()),
+		l.def(".solution", l.sel(".f", "solution")),
+	}
+	if s.Reject != nil {
+		loop = append(loop, l.ifStmt(l.callClause(".reject", ".cand"), l.skip(stats)...))
+	}
+	loop = append(loop, l.assign(".solution", l.call("append", ".solution", ".cand")))
+	loop = append(loop, l.tooDeep(".solution", l.brk())...)
+	loop = append(loop, l.depth(stats, ".solution")...)
+	if s.Accept != nil {
+		accepted := l.count(stats, "Accepted")
+		if s.Results != nil {
+			accepted = append(accepted,
+				l.def(".r", l.call("make", l.sliceType(T), l.call("len", ".solution"))),
+				l.exprStmt(l.call("copy", ".r", ".solution")),
+				l.assign(u("found"), l.call("append", u("found"), l.compositeLit(".sol",
+					l.keyValue("node", ".cand"),
+					l.keyValue("solution", ".r")))),
+			)
+		}
+		accepted = append(accepted, l.cont())
+		loop = append(loop, l.ifStmt(l.callClause(".accept", ".cand"), accepted...))
+	}
+	loop = append(loop, l.ifStmt(l.call(".stopped"), l.brk()))
+	if s.MaxNodes != nil {
+		loop = append(loop, l.ifStmt(l.not(l.call(".spend")), l.brk()))
+	}
+	loop = append(loop, push(".cand")...)
+
+	return append(list,
+		l.forStmt(nil, l.op(syntax.Gtr, l.call("len", ".stack"), 0), nil, loop...),
+		abandon,
+		l.assign(l.sel(".gid", "Active"), "false"),
+		l.exprStmt(l.call(".searchTaskEnd", ".search", l.sel(".gid", "ID"))),
+		l.exprStmt(l.call("close", u("done"))),
+	)
+}
+
+// frontier returns the statements running a breadth-first or a
+// best-first search. The nodes to be expanded form the frontier .queue,
+// a FIFO queue for a breadth-first search and a heap ordered by priority
+// for a best-first search. Up to .workers nodes are taken from the queue
+// at a time, all of the same depth for a breadth-first search, and
+// expanded concurrently, each by its own engine goroutine; their
+// children are then added to the queue in the order of the nodes.
+// Ties in priority are broken in favor of the node added first, so that
+// the order of expansion does not depend on the scheduler.
+func (l *searchLowering) frontier() []syntax.Stmt {
+	s := l.stmt
+	T := s.UType
+	best := l.strategy == searchBestFirst
+	entry := func() syntax.Expr { return l.ptrType(".entry") }
+	enqueue := func(e interface{}) syntax.Stmt {
+		if best {
+			return l.exprStmt(l.call(".push", e))
+		}
+		return l.assign(".queue", l.call("append", ".queue", e))
+	}
+
+	list := []syntax.Stmt{
+		l.typeDecl(".entry", l.structType(
+			"node", T,
+			"solution", l.sliceType(T),
+			"path", l.sliceType("int"),
+			"parent", "int",
+			"priority", "float64",
+			"cost", "float64",
+			"seq", "int",
+		)),
+		l.varDecl(".queue", l.sliceType(entry())),
+	}
+	if best {
+		at := func(i interface{}) syntax.Expr { return l.index(".queue", i) }
+		swap := func(i, j string) syntax.Stmt {
+			return l.assign(l.list(at(i), at(j)), l.list(at(j), at(i)))
+		}
+		list = append(list,
+			l.def(".seq", 0),
+			// .before reports whether .a is expanded before .b.
+			l.def(".before", l.funcLit(l.funcType(l.params(".a", entry(), ".b", entry()), l.params(nil, "bool")), []syntax.Stmt{
+				l.ret(l.op(syntax.OrOr,
+					l.op(syntax.Lss, l.sel(".a", "priority"), l.sel(".b", "priority")),
+					l.op(syntax.AndAnd,
+						l.op(syntax.Eql, l.sel(".a", "priority"), l.sel(".b", "priority")),
+						l.op(syntax.Lss, l.sel(".a", "seq"), l.sel(".b", "seq"))))),
+			})),
+			l.def(".push", l.funcLit(l.funcType(l.params(".e", entry()), nil), []syntax.Stmt{
+				l.assign(l.sel(".e", "seq"), ".seq"),
+				l.incr(".seq"),
+				l.assign(".queue", l.call("append", ".queue", ".e")),
+				l.forStmt(l.def(".i", l.op(syntax.Sub, l.call("len", ".queue"), 1)), l.op(syntax.Gtr, ".i", 0), nil,
+					l.def(".p", l.op(syntax.Div, l.op(syntax.Sub, ".i", 1), 2)),
+					l.ifStmt(l.not(l.call(".before", at(".i"), at(".p"))), l.brk()),
+					swap(".i", ".p"),
+					l.assign(".i", ".p"),
+				),
+			})),
+			l.def(".pop", l.funcLit(l.funcType(nil, l.params(nil, entry())), []syntax.Stmt{
+				l.def(".e", at(0)),
+				l.def(".n", l.op(syntax.Sub, l.call("len", ".queue"), 1)),
+				l.assign(at(0), at(".n")),
+				l.assign(".queue", l.sliceExpr(".queue", nil, ".n")),
+				l.forStmt(l.def(".i", 0), nil, nil,
+					l.def(".m", ".i"),
+					l.def(".c", l.op(syntax.Add, l.op(syntax.Mul, 2, ".i"), 1)),
+					l.ifStmt(l.op(syntax.AndAnd, l.op(syntax.Lss, ".c", ".n"), l.call(".before", at(".c"), at(".m"))),
+						l.assign(".m", ".c")),
+					l.ifStmt(l.op(syntax.AndAnd, l.op(syntax.Lss, l.op(syntax.Add, ".c", 1), ".n"), l.call(".before", at(l.op(syntax.Add, ".c", 1)), at(".m"))),
+						l.assign(".m", l.op(syntax.Add, ".c", 1))),
+					l.ifStmt(l.op(syntax.Eql, ".m", ".i"), l.brk()),
+					swap(".i", ".m"),
+					l.assign(".i", ".m"),
+				),
+				l.ret(".e"),
+			})),
+		)
+	}
+	params := l.params(".e", entry())
+	if s.Stats != nil {
+		params = l.params(".e", entry(), ".st", l.ptrType("__SearchStats"))
+	}
+	list = append(list,
+		l.def(".expand", l.funcLit(l.funcType(params, l.params(nil, l.sliceType(entry()))), l.expandBody())),
+		enqueue(l.addr(l.compositeLit(".entry", l.keyValue("node", ".root"), l.keyValue("solution", l.call("make", l.sliceType(T), 0))))),
+	)
+
+	var take []syntax.Stmt
+	if best {
+		take = append(take,
+			l.forStmt(l.rangeClause(".b", ".batch"), nil, nil,
+				l.assign(l.index(".batch", ".b"), l.call(".pop"))),
+		)
+	} else {
+		take = append(take,
+			l.exprStmt(l.call("copy", ".batch", ".queue")),
+			l.assign(".queue", l.sliceExpr(".queue", ".n", nil)),
+		)
+	}
+	loop := []syntax.Stmt{
+		l.def(".n", l.call("len", ".queue")),
+		l.ifStmt(l.op(syntax.Gtr, ".n", ".workers"),
+			l.assign(".n", ".workers")),
+	}
+	if !best {
+		// Expand one level at a time.
+		depth := func(i interface{}) syntax.Expr {
+			return l.call("len", l.sel(l.index(".queue", i), "solution"))
+		}
+		loop = append(loop,
+			l.forStmt(l.def(".k", 1), l.op(syntax.Lss, ".k", ".n"), l.incr(".k"),
+				l.ifStmt(l.op(syntax.Neq, depth(".k"), depth(0)),
+					l.assign(".n", ".k"),
+					l.brk()),
+			),
+		)
+	}
+	loop = append(loop, l.def(".batch", l.call("make", l.sliceType(entry()), ".n")))
+	loop = append(loop, take...)
+	expand := l.call(".expand", ".e")
+	var collect []syntax.Stmt
+	batchStats := func() syntax.Expr { return l.index(".batchStats", ".b") }
+	if s.Stats != nil {
+		// Each goroutine of the batch has its own statistics.
+		loop = append(loop, l.def(".batchStats", l.call("make", l.sliceType("__SearchStats"), ".n")))
+		expand.ArgList = append(expand.ArgList, l.addr(batchStats()))
+		collect = append(collect, l.forStmt(l.rangeClause(".b", ".batchStats"), nil, nil, l.mergeStats(batchStats)...))
+	}
+	body := []syntax.Stmt{l.recovering(l.send(".finished", l.compositeLit(l.structType())))}
+	body = append(body, l.clock()...)
+	body = append(body, l.assign(l.index(".next", ".b"), expand))
+	body = append(body, l.lifetime(batchStats)...)
+	body = append(body, l.send(".finished", l.compositeLit(l.structType())))
+	loop = append(loop,
+		l.def(".next", l.call("make", l.sliceType(l.sliceType(entry())), ".n")),
+		l.def(".finished", l.call("make", l.chanType(0, l.structType()), ".n")),
+		l.forStmt(l.rangeClause(l.list(".b", ".e"), ".batch"), nil, nil,
+			l.goStmt(l.call(l.funcLit(l.funcType(l.params(".b", "int", ".e", entry()), nil), body), ".b", ".e")),
+		),
+		l.forStmt(l.rangeClause(nil, ".batch"), nil, nil,
+			l.exprStmt(l.recv(".finished"))),
+	)
+	loop = append(loop, collect...)
+	loop = append(loop,
+		l.forStmt(l.rangeClause(l.list("_", ".out"), ".next"), nil, nil,
+			l.forStmt(l.rangeClause(l.list("_", ".e"), ".out"), nil, nil,
+				enqueue(".e"))),
+	)
+	// The nodes left in the queue when the search is stopped are
+	// dropped; their children were not requested yet.
+	return append(list,
+		l.forStmt(nil, l.op(syntax.AndAnd, l.op(syntax.Gtr, l.call("len", ".queue"), 0), l.not(l.call(".stopped"))), nil, loop...),
+	)
+}
+
+// expandBody returns the body of the function
+//
+//	func(.e *.entry) []*.entry
+//
+// which receives the children of .e.node and returns the entries for
+// the children to be expanded later. The children left when the search
+// is stopped, or a clause panics, are abandoned.
+func (l *searchLowering) expandBody() []syntax.Stmt {
+	s := l.stmt
+	T := s.UType
+	call := func(fn string) syntax.Expr {
+		return l.call(fn, ".cand", ".s", ".gid", ".stop", ".done")
+	}
+
+	stats := func() syntax.Expr { return l.name(".st") }
+
+	list := []syntax.Stmt{
+		l.def(".gid", l.graphNode(l.sel(".e", "parent"))),
+		l.exprStmt(l.call(".searchTaskStart", ".search", l.sel(".gid", "ID"), l.sel(".gid", "Parent"))),
+		l.def(".solution", l.sel(".e", "solution")),
+	}
+	if s.Key != nil {
+		// Only the root has an empty solution.
+		list = append(list, l.ifStmt(l.op(syntax.Eql, l.call("len", ".solution"), 0),
+			l.exprStmt(l.call(".visit", l.callClause(".key", l.sel(".e", "node"))))))
+	}
+	list = append(list,
+		l.varDecl(".kids", ".kids"),
+		l.deferStmt(l.call(".abandon", l.addr(".kids"))),
+	)
+	expand := l.count(stats, "Expanded")
+	expand = append(expand, l.assign(".kids", l.callClause(".children", l.sel(".e", "node"))))
+	expand = l.expanding(expand...)
+	if s.Cost != nil {
+		// The incumbent may have improved since the node was queued.
+		expand = []syntax.Stmt{l.ifElse(l.call(".prunes", l.sel(".e", "cost")), l.count(stats, "Pruned"), expand)}
+	}
+	list = append(list, expand...)
+	list = append(list, l.varDecl(".out", l.sliceType(l.ptrType(".entry"))))
+	if l.ordered {
+		list = append(list, l.def(".n", 0))
+	}
+
+	loop := []syntax.Stmt{
+		// Discard the children of a stopped search.
+		l.ifStmt(l.call(".stopped"), l.brk()),
+	}
+	if l.ordered {
+		loop = append(loop, l.def(".idx", ".n"), l.incr(".n"))
+	}
+	if s.Reject != nil {
+		loop = append(loop, l.ifStmt(l.callClause(".reject", ".cand"), l.skip(stats)...))
+	}
+	if s.Key != nil {
+		loop = append(loop, l.visit(stats))
+	}
+	loop = append(loop,
+		l.def(".s", l.call("make", l.sliceType(T), l.op(syntax.Add, l.call("len", ".solution"), 1))),
+		l.exprStmt(l.call("copy", ".s", ".solution")),
+		l.assign(l.index(".s", l.call("len", ".solution")), ".cand"),
+	)
+	loop = append(loop, l.tooDeep(".s", l.brk())...)
+	loop = append(loop, l.depth(stats, ".s")...)
+	loop = append(loop, l.prune(stats, ".s")...)
+	path := syntax.Expr(l.name("nil"))
+	if l.ordered {
+		loop = append(loop,
+			l.def(".p", l.call("make", l.sliceType("int"), l.op(syntax.Add, l.call("len", l.sel(".e", "path")), 1))),
+			l.exprStmt(l.call("copy", ".p", l.sel(".e", "path"))),
+			l.assign(l.index(".p", l.call("len", l.sel(".e", "path"))), ".idx"),
+		)
+		path = l.name(".p")
+	}
+	if s.Accept != nil {
+		accepted := l.count(stats, "Accepted")
+		var deliver []syntax.Stmt
+		if s.Results != nil {
+			deliver = append(deliver, l.exprStmt(l.call(".deliver", ".cand", ".s", ".gid", path)))
+		}
+		if deliver != nil || s.Cost != nil {
+			accepted = append(accepted, l.improving(".s", deliver...)...)
+		}
+		accepted = append(accepted, l.cont())
+		loop = append(loop, l.ifStmt(call(".accept"), accepted...))
+	}
+	elems := []*syntax.KeyValueExpr{
+		l.keyValue("node", ".cand"),
+		l.keyValue("solution", ".s"),
+		l.keyValue("path", path),
+		l.keyValue("parent", l.sel(".gid", "ID")),
+	}
+	if l.strategy == searchBestFirst {
+		elems = append(elems, l.keyValue("priority", call(".priority")))
+	}
+	if s.Cost != nil {
+		elems = append(elems, l.keyValue("cost", ".c"))
+	}
+	loop = append(loop, l.assign(".out", l.call("append", ".out", l.addr(l.compositeLit(".entry", elems...)))))
+
+	return append(list,
+		l.forStmt(l.def(l.list(".cand", ".ok"), l.call(".pull", l.addr(".kids"))), ".ok", l.assign(l.list(".cand", ".ok"), l.call(".pull", l.addr(".kids"))), loop...),
+		l.assign(l.sel(".gid", "Active"), "false"),
+		l.exprStmt(l.call(".searchTaskEnd", ".search", l.sel(".gid", "ID"))),
+		l.ret(".out"),
+	)
+}
+
+// clause returns the closure for a search clause with the given body
+// and result type, if any. The closure is positioned at the clause label.
+func (l *searchLowering) clause(body *syntax.BlockStmt, result interface{}) *syntax.FuncLit {
+	defer func(pos src.Pos) { l.pos = pos }(l.pos)
+	l.pos = body.Pos()
+	T := l.stmt.UType
+	params := l.params("node", T, "solution", l.sliceType(T), "gid", l.ptrType("__GraphNode"), "stop", l.funcType(nil, nil), "done", l.chanType(syntax.RecvOnly, l.structType()))
+	var results []*syntax.Field
+	if result != nil {
+		results = l.params(nil, result)
+	}
+	f := &syntax.FuncLit{Type: l.funcType(params, results), Body: body}
+	f.SetPos(l.pos)
+	searchClauseLits[f] = true
+	return f
+}
+
+// defClause returns the statement name := f for the closure f of a
+// clause. The statement is positioned at the clause, where the noder
+// then declares the implicit parameters.
+func (l *searchLowering) defClause(name string, f *syntax.FuncLit) *syntax.AssignStmt {
+	defer func(pos src.Pos) { l.pos = pos }(l.pos)
+	l.pos = f.Pos()
+	return l.def(name, f)
+}
+
+// callClause returns the call of the clause closure fn for node
+// in the engine.
+func (l *searchLowering) callClause(fn string, node interface{}) *syntax.CallExpr {
+	return l.call(fn, node, ".solution", ".gid", ".stop", ".done")
+}
+
+// graphNode returns the expression &__GraphNode{...} describing a new
+// engine goroutine with the given parent ID.
+func (l *searchLowering) graphNode(parent interface{}) syntax.Expr {
+	return l.addr(l.compositeLit("__GraphNode",
+		l.keyValue("Active", "true"),
+		l.keyValue("ID", l.recv(".ticket")),
+		l.keyValue("Parent", parent),
+	))
+}
+
+// statsClause returns the closure for the stats clause with the given
+// body, whose only parameter is
+//
+//	stats __SearchStats
+func (l *searchLowering) statsClause(body *syntax.BlockStmt) *syntax.FuncLit {
+	defer func(pos src.Pos) { l.pos = pos }(l.pos)
+	l.pos = body.Pos()
+	f := &syntax.FuncLit{Type: l.funcType(l.params("stats", "__SearchStats"), nil), Body: body}
+	f.SetPos(l.pos)
+	searchClauseLits[f] = true
+	return f
+}
+
+// ----------------------------------------------------------------------------
+// Statistics
+//
+// Without a stats clause, the engine keeps no statistics and the
+// following functions return no statements. Every engine goroutine
+// counts into statistics of its own, which are merged into .stats once
+// the goroutine is done.
+
+// count returns the statement incrementing the given field of the
+// statistics st.
+func (l *searchLowering) count(st func() syntax.Expr, field string) []syntax.Stmt {
+	if l.stmt.Stats == nil {
+		return nil
+	}
+	return []syntax.Stmt{l.incr(l.sel(st(), field))}
+}
+
+// skip returns the statements skipping a rejected candidate.
+func (l *searchLowering) skip(st func() syntax.Expr) []syntax.Stmt {
+	return append(l.count(st, "Rejected"), l.cont())
+}
+
+// depth returns the statement raising the maximum depth of the
+// statistics st to the length of solution.
+func (l *searchLowering) depth(st func() syntax.Expr, solution string) []syntax.Stmt {
+	if l.stmt.Stats == nil {
+		return nil
+	}
+	return []syntax.Stmt{
+		l.ifStmt(l.op(syntax.Gtr, l.call("len", solution), l.sel(st(), "MaxDepth")),
+			l.assign(l.sel(st(), "MaxDepth"), l.call("len", solution))),
+	}
+}
+
+// clock returns the statement recording the start .start of an engine
+// goroutine.
+func (l *searchLowering) clock() []syntax.Stmt {
+	if l.stmt.Stats == nil {
+		return nil
+	}
+	return []syntax.Stmt{l.def(".start", l.call(".searchNanotime"))}
+}
+
+// lifetime returns the statement adding the lifetime of an engine
+// goroutine, started at .start, to the statistics st.
+func (l *searchLowering) lifetime(st func() syntax.Expr) []syntax.Stmt {
+	if l.stmt.Stats == nil {
+		return nil
+	}
+	lifetimes := func() syntax.Expr { return l.sel(st(), "Lifetimes") }
+	return []syntax.Stmt{
+		l.assign(lifetimes(), l.call("append", lifetimes(), l.op(syntax.Sub, l.call(".searchNanotime"), ".start"))),
+	}
+}
+
+// mergeStats returns the statements adding the statistics st to .stats.
+func (l *searchLowering) mergeStats(st func() syntax.Expr) []syntax.Stmt {
+	var list []syntax.Stmt
+	for _, field := range []string{"Expanded", "Rejected", "Pruned", "Accepted"} {
+		list = append(list, l.opAssign(syntax.Add, l.sel(".stats", field), l.sel(st(), field)))
+	}
+	lifetimes := l.call("append", l.sel(".stats", "Lifetimes"), l.sel(st(), "Lifetimes"))
+	lifetimes.HasDots = true
+	return append(list,
+		l.ifStmt(l.op(syntax.Gtr, l.sel(st(), "MaxDepth"), l.sel(".stats", "MaxDepth")),
+			l.assign(l.sel(".stats", "MaxDepth"), l.sel(st(), "MaxDepth"))),
+		l.assign(l.sel(".stats", "Lifetimes"), lifetimes),
+	)
+}
+
+// ----------------------------------------------------------------------------
+// Expressions
+//
+// Arguments of type interface{} accept a syntax.Expr, a string (denoting
+// a name), or an int (denoting an integer literal).
+
+func (l *searchLowering) expr(x interface{}) syntax.Expr {
+	switch x := x.(type) {
+	case syntax.Expr:
+		return x
+	case string:
+		return l.name(x)
+	case int:
+		if x < 0 {
+			return l.neg(-x)
+		}
+		return l.lit(x)
+	}
+	Fatalf("searchLowering: unexpected expression %v", x)
+	return nil
+}
+
+func (l *searchLowering) exprs(list []interface{}) []syntax.Expr {
+	res := make([]syntax.Expr, len(list))
+	for i, x := range list {
+		res[i] = l.expr(x)
+	}
+	return res
+}
+
+func (l *searchLowering) name(value string) *syntax.Name {
+	if strings.HasPrefix(value, ".") {
+		searchEngineNames[value] = true
+	}
+	n := &syntax.Name{Value: value}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) lit(x int) *syntax.BasicLit {
+	n := &syntax.BasicLit{Value: strconv.Itoa(x), Kind: syntax.IntLit}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) strLit(x string) *syntax.BasicLit {
+	n := &syntax.BasicLit{Value: strconv.Quote(x), Kind: syntax.StringLit}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) list(elems ...interface{}) *syntax.ListExpr {
+	n := &syntax.ListExpr{ElemList: l.exprs(elems)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) op(op syntax.Operator, x, y interface{}) *syntax.Operation {
+	n := &syntax.Operation{Op: op, X: l.expr(x)}
+	if y != nil {
+		n.Y = l.expr(y)
+	}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) not(x interface{}) *syntax.Operation   { return l.op(syntax.Not, x, nil) }
+func (l *searchLowering) neg(x interface{}) *syntax.Operation   { return l.op(syntax.Sub, x, nil) }
+func (l *searchLowering) recv(x interface{}) *syntax.Operation  { return l.op(syntax.Recv, x, nil) }
+func (l *searchLowering) addr(x interface{}) *syntax.Operation  { return l.op(syntax.And, x, nil) }
+func (l *searchLowering) deref(x interface{}) *syntax.Operation { return l.op(syntax.Mul, x, nil) }
+
+func (l *searchLowering) call(fun interface{}, args ...interface{}) *syntax.CallExpr {
+	n := &syntax.CallExpr{Fun: l.expr(fun), ArgList: l.exprs(args)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) sel(x interface{}, sel string) *syntax.SelectorExpr {
+	n := &syntax.SelectorExpr{X: l.expr(x), Sel: l.name(sel)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) index(x, index interface{}) *syntax.IndexExpr {
+	n := &syntax.IndexExpr{X: l.expr(x), Index: l.expr(index)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) sliceExpr(x, lo, hi interface{}) *syntax.SliceExpr {
+	n := &syntax.SliceExpr{X: l.expr(x)}
+	if lo != nil {
+		n.Index[0] = l.expr(lo)
+	}
+	if hi != nil {
+		n.Index[1] = l.expr(hi)
+	}
+	n.SetPos(l.pos)
+	return n
+}
+
+// last returns the expression x[len(x)-1].
+func (l *searchLowering) last(x string) *syntax.IndexExpr {
+	return l.index(x, l.op(syntax.Sub, l.call("len", x), 1))
+}
+
+func (l *searchLowering) keyValue(key, value interface{}) *syntax.KeyValueExpr {
+	n := &syntax.KeyValueExpr{Key: l.expr(key), Value: l.expr(value)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) compositeLit(typ interface{}, elems ...*syntax.KeyValueExpr) *syntax.CompositeLit {
+	n := &syntax.CompositeLit{Type: l.expr(typ), NKeys: len(elems), Rbrace: l.pos}
+	for _, e := range elems {
+		n.ElemList = append(n.ElemList, e)
+	}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) funcLit(typ *syntax.FuncType, body []syntax.Stmt) *syntax.FuncLit {
+	n := &syntax.FuncLit{Type: typ, Body: l.block(body...)}
+	n.SetPos(l.pos)
+	return n
+}
+
+// ----------------------------------------------------------------------------
+// Types
+
+func (l *searchLowering) sliceType(elem interface{}) *syntax.SliceType {
+	n := &syntax.SliceType{Elem: l.expr(elem)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) mapType(key, value interface{}) *syntax.MapType {
+	n := &syntax.MapType{Key: l.expr(key), Value: l.expr(value)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) chanType(dir syntax.ChanDir, elem interface{}) *syntax.ChanType {
+	n := &syntax.ChanType{Dir: dir, Elem: l.expr(elem)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) ptrType(elem interface{}) *syntax.Operation {
+	return l.op(syntax.Mul, elem, nil)
+}
+
+func (l *searchLowering) field(name interface{}, typ interface{}) *syntax.Field {
+	n := &syntax.Field{Type: l.expr(typ)}
+	if name != nil {
+		n.Name = l.name(name.(string))
+	}
+	n.SetPos(l.pos)
+	return n
+}
+
+// params returns the fields for a list of name, type pairs.
+func (l *searchLowering) params(nameTypes ...interface{}) []*syntax.Field {
+	var list []*syntax.Field
+	for i := 0; i < len(nameTypes); i += 2 {
+		list = append(list, l.field(nameTypes[i], nameTypes[i+1]))
+	}
+	return list
+}
+
+func (l *searchLowering) structType(nameTypes ...interface{}) *syntax.StructType {
+	n := &syntax.StructType{FieldList: l.params(nameTypes...)}
+	n.SetPos(l.pos)
+	return n
+}
+
+// interfaceType returns an interface type with the given methods,
+// specified as name, function type pairs.
+func (l *searchLowering) interfaceType(nameTypes ...interface{}) *syntax.InterfaceType {
+	n := &syntax.InterfaceType{MethodList: l.params(nameTypes...)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) funcType(params, results []*syntax.Field) *syntax.FuncType {
+	n := &syntax.FuncType{ParamList: params, ResultList: results}
+	n.SetPos(l.pos)
+	return n
+}
+
+// ----------------------------------------------------------------------------
+// Statements
+
+func (l *searchLowering) block(list ...syntax.Stmt) *syntax.BlockStmt {
+	n := &syntax.BlockStmt{List: list, Rbrace: l.pos}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) exprStmt(x interface{}) *syntax.ExprStmt {
+	n := &syntax.ExprStmt{X: l.expr(x)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) send(ch, value interface{}) *syntax.SendStmt {
+	n := &syntax.SendStmt{Chan: l.expr(ch), Value: l.expr(value)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) opAssign(op syntax.Operator, lhs, rhs interface{}) *syntax.AssignStmt {
+	n := &syntax.AssignStmt{Op: op, Lhs: l.expr(lhs), Rhs: l.expr(rhs)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) def(lhs, rhs interface{}) *syntax.AssignStmt {
+	return l.opAssign(syntax.Def, lhs, rhs)
+}
+
+func (l *searchLowering) assign(lhs, rhs interface{}) *syntax.AssignStmt {
+	return l.opAssign(0, lhs, rhs)
+}
+
+// trim returns the statement x = x[:len(x)-1].
+func (l *searchLowering) trim(x string) *syntax.AssignStmt {
+	return l.assign(x, l.sliceExpr(x, nil, l.op(syntax.Sub, l.call("len", x), 1)))
+}
+
+func (l *searchLowering) incr(x interface{}) *syntax.AssignStmt {
+	return l.opAssign(syntax.Add, x, syntax.ImplicitOne)
+}
+
+func (l *searchLowering) varDecl(name string, typ interface{}) *syntax.DeclStmt {
+	d := &syntax.VarDecl{NameList: []*syntax.Name{l.name(name)}, Type: l.expr(typ)}
+	d.SetPos(l.pos)
+	n := &syntax.DeclStmt{DeclList: []syntax.Decl{d}}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) typeDecl(name string, typ interface{}) *syntax.DeclStmt {
+	d := &syntax.TypeDecl{Name: l.name(name), Type: l.expr(typ)}
+	d.SetPos(l.pos)
+	n := &syntax.DeclStmt{DeclList: []syntax.Decl{d}}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) aliasDecl(name string, typ interface{}) *syntax.DeclStmt {
+	n := l.typeDecl(name, typ)
+	n.DeclList[0].(*syntax.TypeDecl).Alias = true
+	return n
+}
+
+func (l *searchLowering) brk() *syntax.BranchStmt {
+	n := &syntax.BranchStmt{Tok: syntax.Break}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) cont() *syntax.BranchStmt {
+	n := &syntax.BranchStmt{Tok: syntax.Continue}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) goStmt(call *syntax.CallExpr) *syntax.CallStmt {
+	n := &syntax.CallStmt{Tok: syntax.Go, Call: call}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) deferStmt(call *syntax.CallExpr) *syntax.CallStmt {
+	n := &syntax.CallStmt{Tok: syntax.Defer, Call: call}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) ret(results ...interface{}) *syntax.ReturnStmt {
+	n := new(syntax.ReturnStmt)
+	switch len(results) {
+	case 0:
+	case 1:
+		n.Results = l.expr(results[0])
+	default:
+		n.Results = l.list(results...)
+	}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) ifStmt(cond interface{}, then ...syntax.Stmt) *syntax.IfStmt {
+	n := &syntax.IfStmt{Cond: l.expr(cond), Then: l.block(then...)}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) ifElse(cond interface{}, then, els []syntax.Stmt) *syntax.IfStmt {
+	n := l.ifStmt(cond, then...)
+	n.Else = l.block(els...)
+	return n
+}
+
+func (l *searchLowering) forStmt(init syntax.SimpleStmt, cond interface{}, post syntax.SimpleStmt, body ...syntax.Stmt) *syntax.ForStmt {
+	n := &syntax.ForStmt{Init: init, Post: post, Body: l.block(body...)}
+	if cond != nil {
+		n.Cond = l.expr(cond)
+	}
+	n.SetPos(l.pos)
+	return n
+}
+
+// rangeStmt returns the statement "for lhs := range x { body }".
+// If lhs is nil, it returns "for range x { body }".
+func (l *searchLowering) rangeStmt(lhs, x interface{}, body ...syntax.Stmt) *syntax.ForStmt {
+	return l.forStmt(l.rangeClause(lhs, x), nil, nil, body...)
+}
+
+func (l *searchLowering) rangeClause(lhs, x interface{}) *syntax.RangeClause {
+	n := &syntax.RangeClause{X: l.expr(x)}
+	if lhs != nil {
+		n.Lhs = l.expr(lhs)
+		n.Def = true
+	}
+	n.SetPos(l.pos)
+	return n
+}
+
+func (l *searchLowering) selectStmt(body ...*syntax.CommClause) *syntax.SelectStmt {
+	n := &syntax.SelectStmt{Body: body, Rbrace: l.pos}
+	n.SetPos(l.pos)
+	return n
+}
+
+// commClause returns a select case; comm == nil means default clause.
+func (l *searchLowering) commClause(comm syntax.SimpleStmt, body ...syntax.Stmt) *syntax.CommClause {
+	n := &syntax.CommClause{Comm: comm, Body: body, Colon: l.pos}
+	n.SetPos(l.pos)
+	return n
+}
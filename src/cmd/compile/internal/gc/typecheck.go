@@ -2073,7 +2073,11 @@ func typecheck1(n *Node, top int) *Node {
 
 	case OSEARCH:
 		ok |= Etop
-		typecheckslice(n.Nbody.Slice(), Etop)
+		for _, v := range n.List.Slice() {
+			typecheckSearchHeader(v)
+		}
+		n.List.Set(nil)
+		typecheckSearchEngine(n)
 
 	case OSELECT:
 		ok |= Etop
@@ -275,15 +275,6 @@ func walkstmt(n *Node) *Node {
 
 	case OSEARCH:
 		walkstmtlist(n.Nbody.Slice())
-		// @TODO maybe someday, but otherwise delete this
-		// e := n.Nbody.Slice()
-		// targetType := e[8].Left.Type
-		// log.Println(targetType)
-		// log.Println(e[4])
-		// log.Println(e[4].Left.Type)
-		// params := e[4].Left.Type.Extra.(*types.Func).Params
-		// log.Println(reflect.TypeOf(params.Extra))
-		// params.Extra.(*types.FuncArgs)
 
 	case OPROC:
 		switch n.Left.Op {
@@ -19,12 +19,24 @@ import (
 //    - invalid, unused, duplicate, and missing labels
 //    - gotos jumping over variable declarations and into blocks
 func checkBranches(body *BlockStmt, errh ErrorHandler) {
+	checkBodyBranches(body, &labelScope{errh: errh})
+}
+
+// checkClauseBranches is like checkBranches for the body of a search
+// clause. A label named after a clause cannot label a statement directly
+// in the body, as it starts that clause instead, so uses of such a label
+// are reported with an explanation.
+func checkClauseBranches(body *BlockStmt, errh ErrorHandler) {
+	checkBodyBranches(body, &labelScope{errh: errh, clause: true})
+}
+
+// checkBodyBranches checks body with the label scope ls of all labels
+// in it.
+func checkBodyBranches(body *BlockStmt, ls *labelScope) {
 	if body == nil {
 		return
 	}
 
-	// scope of all labels in this body
-	ls := &labelScope{errh: errh}
 	fwdGotos := ls.blockBranches(nil, targets{}, nil, body.Pos(), body.List)
 
 	// If there are any forward gotos left, no matching label was
@@ -36,7 +48,7 @@ func checkBranches(body *BlockStmt, errh ErrorHandler) {
 			l.used = true // avoid "defined and not used" error
 			ls.err(fwd.Label.Pos(), "goto %s jumps into block starting at %s", name, l.parent.start)
 		} else {
-			ls.err(fwd.Label.Pos(), "label %s not defined", name)
+			ls.undefined(fwd.Label.Pos(), "label %s not defined", name)
 		}
 	}
 
@@ -52,6 +64,7 @@ func checkBranches(body *BlockStmt, errh ErrorHandler) {
 type labelScope struct {
 	errh   ErrorHandler
 	labels map[string]*label // all label declarations inside the function; allocated lazily
+	clause bool              // checking the body of a search clause
 }
 
 type label struct {
@@ -70,6 +83,16 @@ func (ls *labelScope) err(pos src.Pos, format string, args ...interface{}) {
 	ls.errh(Error{pos, fmt.Sprintf(format, args...)})
 }
 
+// undefined reports the use of the undefined label name at pos with the
+// given format.
+func (ls *labelScope) undefined(pos src.Pos, format, name string) {
+	if ls.clause && isSearchClause(name) {
+		ls.err(pos, format+" (%s: directly in a search statement starts the %s clause)", name, name, name)
+		return
+	}
+	ls.err(pos, format, name)
+}
+
 // declare declares the label introduced by s in block b and returns
 // the new label. If the label was already declared, declare reports
 // and error and the existing label is returned instead.
@@ -249,7 +272,7 @@ func (ls *labelScope) blockBranches(parent *block, ctxt targets, lstmt *LabeledS
 						ls.err(s.Label.Pos(), "invalid break label %s", name)
 					}
 				} else {
-					ls.err(s.Label.Pos(), "break label not defined: %s", name)
+					ls.undefined(s.Label.Pos(), "break label not defined: %s", name)
 				}
 
 			case _Continue:
@@ -262,7 +285,7 @@ func (ls *labelScope) blockBranches(parent *block, ctxt targets, lstmt *LabeledS
 						ls.err(s.Label.Pos(), "invalid continue label %s", name)
 					}
 				} else {
-					ls.err(s.Label.Pos(), "continue label not defined: %s", name)
+					ls.undefined(s.Label.Pos(), "continue label not defined: %s", name)
 				}
 
 			case _Goto:
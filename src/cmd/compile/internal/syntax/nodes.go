@@ -19,6 +19,7 @@ type Node interface {
 	//    associated with that production; usually the left-most one
 	//    ('[' for IndexExpr, 'if' for IfStmt, etc.)
 	Pos() src.Pos
+	SetPos(src.Pos)
 	aNode()
 }
 
@@ -28,8 +29,9 @@ type node struct {
 	pos src.Pos
 }
 
-func (n *node) Pos() src.Pos { return n.pos }
-func (*node) aNode()         {}
+func (n *node) Pos() src.Pos       { return n.pos }
+func (n *node) SetPos(pos src.Pos) { n.pos = pos }
+func (*node) aNode()               {}
 
 // ----------------------------------------------------------------------------
 // Files
@@ -407,12 +409,25 @@ type (
 	}
 
 	SearchStmt struct {
-		Root        Expr
-		UType       Expr
-		Concurrency Expr
-		Children    *BlockStmt
-		Accept      *BlockStmt
-		Reject      *BlockStmt
+		Root          Expr
+		UType         Expr
+		Concurrency   Expr // or nil
+		Context       Expr // or nil
+		MaxDepth      Expr // maxDepth option; or nil
+		MaxNodes      Expr // maxNodes option; or nil
+		Deterministic Expr // deterministic option; or nil
+		Children      *BlockStmt
+		Accept        *BlockStmt
+		Reject        *BlockStmt
+		Results       *BlockStmt // results or ordered clause; or nil
+		Ordered       bool       // results clause labeled "ordered"
+		Strategy      *BlockStmt // or nil
+		Priority      *BlockStmt // or nil
+		Key           *BlockStmt // or nil
+		Cost          *BlockStmt // or nil
+		Best          *BlockStmt // or nil
+		Stats         *BlockStmt // or nil
+		Rbrace        src.Pos
 		stmt
 	}
 )
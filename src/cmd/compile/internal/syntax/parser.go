@@ -1739,163 +1739,211 @@ func (p *parser) searchStmt() *SearchStmt {
 
 	s.UType = p.typeOrNil()
 
-	if p.tok == _Semi {
-		p.want(_Semi)
-		s.Concurrency = p.searchConcurrencypexpr(false)
+	// The concurrency may be omitted if a context or an option follows.
+	// Options of the form name = x follow the other header expressions.
+	for i := 0; p.got(_Semi); i++ {
+		if i == 0 && p.tok == _Semi {
+			continue
+		}
+		x := p.searchHeaderExpr()
+		if name, ok := x.(*Name); ok && p.got(_Assign) {
+			p.setSearchOption(s, name, p.searchHeaderExpr())
+			continue
+		}
+		switch {
+		case s.MaxDepth != nil || s.MaxNodes != nil || s.Deterministic != nil:
+			p.syntax_error_at(x.Pos(), "search header expression after option")
+		case i == 0:
+			s.Concurrency = x
+		case i == 1:
+			s.Context = x
+		default:
+			p.syntax_error_at(x.Pos(), "too many expressions in search header")
+		}
 	}
 
 	if !p.got(_Lbrace) {
 		p.syntax_error(fmt.Sprintf("unexpected %s, expected {", p.tok))
 	}
 
-	foundChildren := false
-	// foundAccept := false
-	// foundReject := false
-
-	for p.tok != _Rbrace {
-		switch p.tok {
-		case _Children:
-			if foundChildren {
-				p.syntax_error(fmt.Sprintf("duplicate children block at %i:%i, first seen at %s", p.line, p.col, s.Children.Pos()))
-				p.next()
+	// The clause names are not keywords: they are only recognized as
+	// labels of statements directly inside the search body, where they
+	// start the respective clause. Nested statements may use them as
+	// labels of their own.
+	errcnt := p.errcnt
+	var clause *BlockStmt
+	var clauses []*BlockStmt
+	for p.tok != _EOF && p.tok != _Rbrace {
+		var stmt Stmt
+		if p.tok == _Name {
+			lhs := p.exprList()
+			label, ok := lhs.(*Name)
+			if ok && p.tok == _Colon && isSearchClause(label.Value) {
+				p.want(_Colon)
+				if clause != nil {
+					clause.Rbrace = label.Pos()
+				}
+				clause = new(BlockStmt)
+				clause.pos = label.Pos()
+				clauses = append(clauses, clause)
+				p.setSearchClause(s, label, clause)
 				continue
 			}
-			foundChildren = true
-			p.want(_Children)
-			if !p.got(_Colon) {
-				p.syntax_error(fmt.Sprintf("unexpected %s, expected :", p.tok))
-			}
-			s.Children = new(BlockStmt)
-			s.Children.List = p.stmtList()
-		case _Accept:
-			p.want(_Accept)
-			if !p.got(_Colon) {
-				p.syntax_error(fmt.Sprintf("unexpected %s, expected :", p.tok))
-			}
-			s.Accept = new(BlockStmt)
-			s.Accept.List = p.stmtList()
-		case _Reject:
-			p.want(_Reject)
-			if !p.got(_Colon) {
-				p.syntax_error(fmt.Sprintf("unexpected %s, expected :", p.tok))
+			if ok && p.tok == _Colon {
+				stmt = p.labeledStmtOrNil(label)
+			} else {
+				stmt = p.simpleStmt(lhs, false)
 			}
-			s.Reject = new(BlockStmt)
-			s.Reject.List = p.stmtList()
-		default:
+		} else {
+			stmt = p.stmtOrNil()
+		}
+
+		if stmt == nil {
 			p.syntax_error(fmt.Sprintf("unexpected %s, expecting a search directive block", p.tok))
+			p.advance(_Name, _Rbrace)
+			continue
+		}
+		if clause == nil {
+			p.syntax_error_at(stmt.Pos(), "statement outside of a search clause")
+		} else {
+			clause.List = append(clause.List, stmt)
+		}
+
+		// ";" is optional before "}"
+		if !p.got(_Semi) && p.tok != _Rbrace {
+			p.syntax_error("at end of statement")
+			p.advance(_Semi, _Rbrace)
+			p.got(_Semi) // avoid spurious empty statement
 		}
 	}
 
+	s.Rbrace = p.pos()
+	if clause != nil {
+		clause.Rbrace = s.Rbrace
+	}
 	if !p.got(_Rbrace) {
 		p.syntax_error(fmt.Sprintf("unexpected %s, expected }", p.tok))
 	}
 
+	// Each clause is the body of a function of its own; see funcBody.
+	if p.mode&CheckBranches != 0 && errcnt == p.errcnt {
+		for _, c := range clauses {
+			checkClauseBranches(c, p.errh)
+		}
+	}
+
 	return s
 }
 
-// This is a copy of the pexpr function that does not allow
-// the presensce of a composit literal.
-func (p *parser) searchConcurrencypexpr(keep_parens bool) Expr {
-	if trace {
-		defer p.trace("pexpr")()
+func isSearchClause(name string) bool {
+	switch name {
+	case "children", "accept", "reject", "results", "ordered", "strategy", "priority", "key", "cost", "best", "stats":
+		return true
 	}
+	return false
+}
 
-	x := p.operand(keep_parens)
-
-loop:
-	for {
-		pos := p.pos()
-		switch p.tok {
-		case _Dot:
-			p.next()
-			switch p.tok {
-			case _Name:
-				// pexpr '.' sym
-				t := new(SelectorExpr)
-				t.pos = pos
-				t.X = x
-				t.Sel = p.name()
-				x = t
-
-			case _Lparen:
-				p.next()
-				if p.got(_Type) {
-					t := new(TypeSwitchGuard)
-					t.pos = pos
-					t.X = x
-					x = t
-				} else {
-					t := new(AssertExpr)
-					t.pos = pos
-					t.X = x
-					t.Type = p.expr()
-					x = t
-				}
-				p.want(_Rparen)
-
-			default:
-				p.syntax_error("expecting name or (")
-				p.advance(_Semi, _Rparen)
-			}
-
-		case _Lbrack:
-			p.next()
-			p.xnest++
-
-			var i Expr
-			if p.tok != _Colon {
-				i = p.expr()
-				if p.got(_Rbrack) {
-					// x[i]
-					t := new(IndexExpr)
-					t.pos = pos
-					t.X = x
-					t.Index = i
-					x = t
-					p.xnest--
-					break
-				}
-			}
-
-			// x[i:...
-			t := new(SliceExpr)
-			t.pos = pos
-			t.X = x
-			t.Index[0] = i
-			p.want(_Colon)
-			if p.tok != _Colon && p.tok != _Rbrack {
-				// x[i:j...
-				t.Index[1] = p.expr()
-			}
-			if p.got(_Colon) {
-				t.Full = true
-				// x[i:j:...]
-				if t.Index[1] == nil {
-					p.error("middle index required in 3-index slice")
-				}
-				if p.tok != _Rbrack {
-					// x[i:j:k...
-					t.Index[2] = p.expr()
-				} else {
-					p.error("final index required in 3-index slice")
-				}
-			}
-			p.want(_Rbrack)
-
-			x = t
-			p.xnest--
+// setSearchClause sets the search statement block for the clause label.
+func (p *parser) setSearchClause(s *SearchStmt, label *Name, clause *BlockStmt) {
+	switch label.Value {
+	case "children":
+		if s.Children != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate children block, first seen at %s", s.Children.Pos()))
+			return
+		}
+		s.Children = clause
+	case "accept":
+		if s.Accept != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate accept block, first seen at %s", s.Accept.Pos()))
+			return
+		}
+		s.Accept = clause
+	case "reject":
+		if s.Reject != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate reject block, first seen at %s", s.Reject.Pos()))
+			return
+		}
+		s.Reject = clause
+	case "results", "ordered":
+		if s.Results != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate results block, first seen at %s", s.Results.Pos()))
+			return
+		}
+		s.Results = clause
+		s.Ordered = label.Value == "ordered"
+	case "strategy":
+		if s.Strategy != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate strategy block, first seen at %s", s.Strategy.Pos()))
+			return
+		}
+		s.Strategy = clause
+	case "priority":
+		if s.Priority != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate priority block, first seen at %s", s.Priority.Pos()))
+			return
+		}
+		s.Priority = clause
+	case "key":
+		if s.Key != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate key block, first seen at %s", s.Key.Pos()))
+			return
+		}
+		s.Key = clause
+	case "cost":
+		if s.Cost != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate cost block, first seen at %s", s.Cost.Pos()))
+			return
+		}
+		s.Cost = clause
+	case "best":
+		if s.Best != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate best block, first seen at %s", s.Best.Pos()))
+			return
+		}
+		s.Best = clause
+	case "stats":
+		if s.Stats != nil {
+			p.syntax_error_at(label.Pos(), fmt.Sprintf("duplicate stats block, first seen at %s", s.Stats.Pos()))
+			return
+		}
+		s.Stats = clause
+	}
+}
 
-		case _Lparen:
-			t := new(CallExpr)
-			t.pos = pos
-			t.Fun = x
-			t.ArgList, t.HasDots = p.argList()
-			x = t
-		default:
-			break loop
+// setSearchOption sets the search header option name to x.
+func (p *parser) setSearchOption(s *SearchStmt, name *Name, x Expr) {
+	switch name.Value {
+	case "maxDepth":
+		if s.MaxDepth != nil {
+			p.syntax_error_at(name.Pos(), fmt.Sprintf("duplicate maxDepth option, first seen at %s", s.MaxDepth.Pos()))
+			return
 		}
+		s.MaxDepth = x
+	case "maxNodes":
+		if s.MaxNodes != nil {
+			p.syntax_error_at(name.Pos(), fmt.Sprintf("duplicate maxNodes option, first seen at %s", s.MaxNodes.Pos()))
+			return
+		}
+		s.MaxNodes = x
+	case "deterministic":
+		if s.Deterministic != nil {
+			p.syntax_error_at(name.Pos(), fmt.Sprintf("duplicate deterministic option, first seen at %s", s.Deterministic.Pos()))
+			return
+		}
+		s.Deterministic = x
+	default:
+		p.syntax_error_at(name.Pos(), fmt.Sprintf("unknown search option %s", name.Value))
 	}
+}
 
+// searchHeaderExpr parses an expression following the search node type.
+// As in the headers of if, for, and switch statements, a composite literal
+// must be parenthesized there.
+func (p *parser) searchHeaderExpr() Expr {
+	outer := p.xnest
+	p.xnest = -1
+	x := p.expr()
+	p.xnest = outer
 	return x
 }
 
@@ -2247,7 +2295,7 @@ func (p *parser) stmtList() (l []Stmt) {
 		defer p.trace("stmtList")()
 	}
 
-	for p.tok != _EOF && p.tok != _Rbrace && p.tok != _Case && p.tok != _Default && p.tok != _Children {
+	for p.tok != _EOF && p.tok != _Rbrace && p.tok != _Case && p.tok != _Default {
 		s := p.stmtOrNil()
 		if s == nil {
 			break
@@ -81,6 +81,69 @@ func TestStdLib(t *testing.T) {
 	fmt.Printf("allocated %.3fMb (%.3fMb/s)\n", dm, dm/dt.Seconds())
 }
 
+// Only search is a reserved word; the search clause labels children,
+// accept and reject are ordinary identifiers outside of a search body.
+// Make sure packages using them as names (e.g., net.Listener.Accept)
+// still parse.
+func TestSearchClauseNames(t *testing.T) {
+	for _, dir := range []string{"net", "container"} {
+		walkDirs(t, filepath.Join(runtime.GOROOT(), "src", dir), func(filename string) {
+			if _, err := ParseFile(filename, nil, nil, 0); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSearchHeader(t *testing.T) {
+	for _, test := range []struct {
+		header                                                  string
+		concurrency, context, maxDepth, maxNodes, deterministic string // expected expressions, or ""
+	}{
+		{"root; T", "", "", "", "", ""},
+		{"root; T; 4", "4", "", "", "", ""},
+		{"root; T; n + 1", "n + 1", "", "", "", ""},
+		{"root; T; n; ctx", "n", "ctx", "", "", ""},
+		{"root; T; ; r.Context()", "", "r.Context()", "", "", ""},
+		{"T{}; T; (T{}).n; ctx", "(T{}).n", "ctx", "", "", ""},
+		{"root; T; maxDepth = 10", "", "", "10", "", ""},
+		{"root; T; 4; maxNodes = 1 << 20; maxDepth = d + 1", "4", "", "d + 1", "1 << 20", ""},
+		{"root; T; ; ctx; maxNodes = n", "", "ctx", "", "n", ""},
+		{"root; T; 4; deterministic = true", "4", "", "", "", "true"},
+		{"root; T; maxDepth = 3; deterministic = !replay", "", "", "3", "", "!replay"},
+	} {
+		src := "package p; func _() { search " + test.header + " {\nchildren:\nreturn nil\n} }"
+		f, err := ParseBytes(nil, []byte(src), nil, nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %v", test.header, err)
+			continue
+		}
+		s := f.DeclList[0].(*FuncDecl).Body.List[0].(*SearchStmt)
+		if got := exprString(s.Concurrency); got != test.concurrency {
+			t.Errorf("%s: got concurrency %q, want %q", test.header, got, test.concurrency)
+		}
+		if got := exprString(s.Context); got != test.context {
+			t.Errorf("%s: got context %q, want %q", test.header, got, test.context)
+		}
+		if got := exprString(s.MaxDepth); got != test.maxDepth {
+			t.Errorf("%s: got maxDepth %q, want %q", test.header, got, test.maxDepth)
+		}
+		if got := exprString(s.MaxNodes); got != test.maxNodes {
+			t.Errorf("%s: got maxNodes %q, want %q", test.header, got, test.maxNodes)
+		}
+		if got := exprString(s.Deterministic); got != test.deterministic {
+			t.Errorf("%s: got deterministic %q, want %q", test.header, got, test.deterministic)
+		}
+	}
+}
+
+func exprString(x Expr) string {
+	if x == nil {
+		return ""
+	}
+	return String(x)
+}
+
 func walkDirs(t *testing.T, dir string, action func(string)) {
 	fis, err := ioutil.ReadDir(dir)
 	if err != nil {
@@ -557,6 +557,30 @@ func (p *printer) printRawNode(n Node) {
 		}
 		p.printSwitchBody(n.Body)
 
+	case *SearchStmt:
+		p.print(_Search, blank, n.Root, _Semi, blank, n.UType)
+		if n.Concurrency != nil || n.Context != nil {
+			// the concurrency may be omitted if a context follows
+			p.print(_Semi, blank)
+			if n.Concurrency != nil {
+				p.print(n.Concurrency)
+			}
+			if n.Context != nil {
+				p.print(_Semi, blank, n.Context)
+			}
+		}
+		if n.MaxDepth != nil {
+			p.print(_Semi, blank, _Name, "maxDepth", blank, _Assign, blank, n.MaxDepth)
+		}
+		if n.MaxNodes != nil {
+			p.print(_Semi, blank, _Name, "maxNodes", blank, _Assign, blank, n.MaxNodes)
+		}
+		if n.Deterministic != nil {
+			p.print(_Semi, blank, _Name, "deterministic", blank, _Assign, blank, n.Deterministic)
+		}
+		p.print(blank)
+		p.printSearchBody(n)
+
 	case *TypeSwitchGuard:
 		if n.Lhs != nil {
 			p.print(n.Lhs, blank, _Define, blank)
@@ -914,6 +938,66 @@ func (p *printer) printSelectBody(list []*CommClause) {
 	p.print(_Rbrace)
 }
 
+// A searchClause is a clause of a search statement with its label.
+type searchClause struct {
+	label string
+	body  *BlockStmt
+}
+
+// searchClauses returns the clauses of s in source order.
+func searchClauses(s *SearchStmt) []searchClause {
+	results := "results"
+	if s.Ordered {
+		results = "ordered"
+	}
+	var list []searchClause
+	for _, c := range []searchClause{
+		{"children", s.Children},
+		{"accept", s.Accept},
+		{"reject", s.Reject},
+		{results, s.Results},
+		{"strategy", s.Strategy},
+		{"priority", s.Priority},
+		{"key", s.Key},
+		{"cost", s.Cost},
+		{"best", s.Best},
+		{"stats", s.Stats},
+	} {
+		if c.body == nil {
+			continue
+		}
+		// insert c in order of position; there are only a few clauses
+		i := len(list)
+		list = append(list, c)
+		for ; i > 0 && c.body.Pos().Before(list[i-1].body.Pos()); i-- {
+			list[i] = list[i-1]
+		}
+		list[i] = c
+	}
+	return list
+}
+
+func (p *printer) printSearchBody(s *SearchStmt) {
+	p.print(_Lbrace)
+	if list := searchClauses(s); len(list) > 0 {
+		p.print(newline)
+		for i, c := range list {
+			p.printSearchClause(c, i+1 == len(list))
+			p.print(newline)
+		}
+	}
+	p.print(_Rbrace)
+}
+
+func (p *printer) printSearchClause(c searchClause, braces bool) {
+	p.print(&Name{Value: c.label}, _Colon)
+	if len(c.body.List) > 0 {
+		p.print(newline, indent)
+		p.printStmtList(c.body.List, braces)
+		p.print(outdent)
+	}
+}
+
 func (p *printer) printCaseClause(c *CaseClause, braces bool) {
 	if c.Cases != nil {
 		p.print(_Case, blank, c.Cases)
@@ -27,6 +27,14 @@ func TestPrintString(t *testing.T) {
 	for _, want := range []string{
 		"package p",
 		"package p; type _ = int; type T1 = struct{}; type ( _ = *struct{}; T2 = float32 )",
+		"package p; func _() { search 0; int {} }",
+		"package p; func _() { search (T{}); T; n + 1 { children: return nil; accept: return len(solution) == n; reject: } }",
+		"package p; func _() { search 0; int; ; ctx { strategy: bestFirst; priority: return 0; children: return nil; stats: println(stats.Expanded) } }",
+		"package p; func _() { search 0; int; 4; ctx { children: return nil; accept: return true; ordered: println(solution); key: return node } }",
+		"package p; func _() { search 0; int; maxDepth = 10; maxNodes = n { children: return nil } }",
+		"package p; func _() { search 0; int; 4; ctx; maxNodes = 1 << 20 { children: return nil } }",
+		"package p; func _() { search 0; int; 4; maxDepth = 10; deterministic = true { children: return nil } }",
+		"package p; func _() { search 0; int { children: return nil; accept: return true; best: println(solution); cost: return 0 } }",
 		// TODO(gri) expand
 	} {
 		ast, err := ParseBytes(nil, []byte(want), nil, nil, nil, 0)
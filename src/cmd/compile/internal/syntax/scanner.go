@@ -369,18 +369,11 @@ func (s *scanner) isIdentRune(c rune, first bool) bool {
 // It assumes that s has at least length 2.
 func hash(s []byte) uint {
 	// This was assuming that no two keywords share the same first
-	// two letters. No longer true (E.G. select:search). So let's
-	// snipe our knew keywords in order to make this work.
-	var second uint
-	switch string(s) {
-	case "search":
+	// two letters. No longer true (select and search), so use the
+	// fourth letter of search to keep the hash perfect.
+	second := uint(s[1])
+	if string(s) == "search" {
 		second = uint(s[3])
-	case "children":
-		second = uint(s[6])
-	case "reject":
-		second = uint(s[2])
-	default:
-		second = uint(s[1])
 	}
 	return (uint(s[0])<<4 ^ second + uint(len(s))) & uint(len(keywordMap)-1)
 }
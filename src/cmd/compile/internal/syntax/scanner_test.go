@@ -132,6 +132,9 @@ var sampleTokens = [...]struct {
 	{_Name, "bar９８７６", 0, 0},
 	{_Name, "ŝ", 0, 0},
 	{_Name, "ŝfoo", 0, 0},
+	{_Name, "children", 0, 0}, // search clause labels are not keywords
+	{_Name, "accept", 0, 0},
+	{_Name, "reject", 0, 0},
 
 	// literal samples
 	{_Literal, "0", 0, 0},
@@ -258,6 +261,7 @@ var sampleTokens = [...]struct {
 	{_Select, "select", 0, 0},
 	{_Struct, "struct", 0, 0},
 	{_Switch, "switch", 0, 0},
+	{_Search, "search", 0, 0},
 	{_Type, "type", 0, 0},
 	{_Var, "var", 0, 0},
 }
@@ -63,9 +63,6 @@ const (
 	_Struct
 	_Switch
 	_Search
-	_Children
-	_Accept
-	_Reject
 	_Type
 	_Var
 
@@ -139,9 +136,6 @@ var tokstrings = [...]string{
 	_Struct:      "struct",
 	_Switch:      "switch",
 	_Search:      "search",
-	_Children:    "children",
-	_Accept:      "accept",
-	_Reject:      "reject",
 	_Type:        "type",
 	_Var:         "var",
 }
@@ -265,6 +265,14 @@ func (f *File) Visit(node ast.Node) ast.Visitor {
 		}
 		ast.Walk(f, n.Else)
 		return nil
+	case *ast.SearchStmt:
+		// Each clause is a block of its own, like a case clause.
+		// The strategy clause is not code; it names the strategy.
+		for _, clause := range n.Clauses() {
+			if clause != n.Strategy {
+				f.addCounters(clause.Colon+1, clause.Colon+1, clause.End(), clause.Body, false)
+			}
+		}
 	case *ast.SelectStmt:
 		// Don't annotate an empty select - creates a syntax error.
 		if n.Body == nil || len(n.Body.List) == 0 {
@@ -515,6 +523,14 @@ func (f *File) statementBoundary(s ast.Stmt) token.Pos {
 		return s.Body.Lbrace
 	case *ast.SelectStmt:
 		return s.Body.Lbrace
+	case *ast.SearchStmt:
+		for _, x := range []ast.Expr{s.Root, s.Concurrency, s.Context, s.MaxDepth, s.MaxNodes, s.Deterministic} {
+			found, pos := hasFuncLiteral(x)
+			if found {
+				return pos
+			}
+		}
+		return s.Lbrace
 	case *ast.TypeSwitchStmt:
 		found, pos := hasFuncLiteral(s.Init)
 		if found {
@@ -555,6 +571,8 @@ func (f *File) endsBasicSourceBlock(s ast.Stmt) bool {
 		return true
 	case *ast.SelectStmt:
 		return true
+	case *ast.SearchStmt:
+		return true
 	case *ast.TypeSwitchStmt:
 		return true
 	case *ast.ExprStmt:
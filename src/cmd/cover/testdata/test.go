@@ -30,6 +30,7 @@ func testAll() {
 	testEmptySwitches()
 	testFunctionLiteral()
 	testGoto()
+	testSearch()
 }
 
 // The indexes of the counters in testPanic are known to main.go
@@ -293,3 +294,31 @@ func haha() {
 //go:nosplit
 func someFunction() {
 }
+
+func testSearch() {
+	check(LINE, 1)
+	search 0; int; 1 {
+	strategy:
+		breadthFirst
+	children:
+		check(LINE, 2)
+		c := make(chan int, 2)
+		if node == 0 {
+			check(LINE, 1)
+			c <- 1
+			c <- 2
+		}
+		close(c)
+		check(LINE, 2)
+		return c
+	accept:
+		check(LINE, 2)
+		return node == 2
+	reject:
+		check(LINE, 2)
+		return false
+	stats:
+		check(LINE, 1)
+	}
+	check(LINE, 1)
+}
@@ -209,6 +209,19 @@ func walkBeforeAfter(x interface{}, before, after func(interface{})) {
 		walkBeforeAfter(&n.Init, before, after)
 		walkBeforeAfter(&n.Tag, before, after)
 		walkBeforeAfter(&n.Body, before, after)
+	case *ast.SearchClause:
+		walkBeforeAfter(&n.Body, before, after)
+	case *ast.SearchStmt:
+		walkBeforeAfter(&n.Root, before, after)
+		walkBeforeAfter(&n.UType, before, after)
+		walkBeforeAfter(&n.Concurrency, before, after)
+		walkBeforeAfter(&n.Context, before, after)
+		walkBeforeAfter(&n.MaxDepth, before, after)
+		walkBeforeAfter(&n.MaxNodes, before, after)
+		walkBeforeAfter(&n.Deterministic, before, after)
+		for _, c := range n.Clauses() {
+			walkBeforeAfter(c, before, after)
+		}
 	case *ast.TypeSwitchStmt:
 		walkBeforeAfter(&n.Init, before, after)
 		walkBeforeAfter(&n.Assign, before, after)
@@ -38,6 +38,37 @@ func f() net.Addr {
 	e := &net.TCPAddr{IP: ip4, Port: p}
 	return &net.TCPAddr{IP: ip5}, nil
 }
+`,
+	},
+	{
+		Name: "netipv6zone.1",
+		In: `package main
+
+import "net"
+
+func f() {
+	search net.TCPAddr{ip1, 0}; net.TCPAddr {
+	children:
+		c := make(chan net.TCPAddr, 1)
+		c <- net.TCPAddr{ip2, node.Port + 1}
+		close(c)
+		return c
+	}
+}
+`,
+		Out: `package main
+
+import "net"
+
+func f() {
+	search net.TCPAddr{IP: ip1}; net.TCPAddr {
+	children:
+		c := make(chan net.TCPAddr, 1)
+		c <- net.TCPAddr{IP: ip2, Port: node.Port + 1}
+		close(c)
+		return c
+	}
+}
 `,
 	},
 }
@@ -24,6 +24,7 @@
 // 	get         download and install packages and dependencies
 // 	install     compile and install packages and dependencies
 // 	list        list packages
+// 	mod         module maintenance
 // 	run         compile and run Go program
 // 	test        test packages
 // 	tool        run specified go tool
@@ -38,9 +39,12 @@
 // 	buildmode   build modes
 // 	cache       build and test caching
 // 	filetype    file types
+// 	go.mod      the go.mod file
 // 	gopath      GOPATH environment variable
 // 	environment environment variables
 // 	importpath  import path syntax
+// 	modules     modules, module versions, and more
+// 	module-get  module-aware go get
 // 	packages    package lists
 // 	testflag    testing flags
 // 	testfunc    testing functions
@@ -175,7 +179,7 @@
 //
 // Usage:
 //
-// 	go clean [-i] [-r] [-n] [-x] [-cache] [-testcache] [build flags] [packages]
+// 	go clean [-i] [-r] [-n] [-x] [-cache] [-testcache] [-modcache] [build flags] [packages]
 //
 // Clean removes object files from package source directories.
 // The go command builds most objects in a temporary directory,
@@ -218,6 +222,10 @@
 // The -testcache flag causes clean to expire all test results in the
 // go build cache.
 //
+// The -modcache flag causes clean to remove the entire module
+// download cache, including unpacked source code of versioned
+// dependencies.
+//
 // For more about build flags, see 'go help build'.
 //
 // For more about specifying packages, see 'go help packages'.
@@ -579,7 +587,7 @@
 //
 // Usage:
 //
-// 	go list [-e] [-f format] [-json] [build flags] [packages]
+// 	go list [-e] [-f format] [-json] [-m] [build flags] [packages]
 //
 // List lists the packages named by the import paths, one per line.
 //
@@ -609,6 +617,7 @@
 //         Root          string // Go root or Go path dir containing this package
 //         ConflictDir   string // this directory shadows Dir in $GOPATH
 //         BinaryOnly    bool   // binary-only package: cannot be recompiled from sources
+//         Module        *Module // info about package's containing module, if any (can be nil)
 //
 //         // Source files
 //         GoFiles        []string // .go source files (excluding CgoFiles, TestGoFiles, XTestGoFiles)
@@ -693,11 +702,112 @@
 // a non-nil Error field; other information may or may not be missing
 // (zeroed).
 //
+// The -m flag causes list to list modules instead of packages.
+// It is only valid in module-aware mode (see 'go help modules').
+//
+// When listing modules, the -f flag still specifies a format template
+// applied to a Go struct, but now a Module struct:
+//
+//     type Module struct {
+//         Path     string       // module path
+//         Version  string       // module version
+//         Replace  *Module      // replaced by this module
+//         Time     *time.Time   // time version was created
+//         Main     bool         // is this the main module?
+//         Dir      string       // directory holding files for this module, if any
+//         GoMod    string       // path to go.mod file for this module, if any
+//         Error    *ModuleError // error loading module
+//     }
+//
+//     type ModuleError struct {
+//         Err string // the error itself
+//     }
+//
+// The default output is to print the module path and then
+// information about the version and replacement if any.
+// For example, 'go list -m all' might print:
+//
+//     my/main/module
+//     golang.org/x/text v0.3.0 => /tmp/text
+//     rsc.io/pdf v0.1.1
+//
+// The arguments to list -m are interpreted as a list of modules,
+// not packages. The main module is the module containing the
+// current directory. The build list is the main module and its
+// dependencies, selected by minimal version selection.
+// With no arguments, list -m lists the main module.
+// The special pattern "all" specifies the build list. A pattern
+// containing "..." specifies the modules in the build list whose
+// paths match the pattern. A query of the form path@version
+// specifies the result of that query, which need not be in
+// the build list. If a named module is not found, the -e flag
+// reports it through the Error field rather than failing.
+//
 // For more about build flags, see 'go help build'.
 //
 // For more about specifying packages, see 'go help packages'.
 //
 //
+// Module maintenance
+//
+// Usage:
+//
+// 	go mod [-v] [maintenance flags]
+//
+// Mod performs module maintenance operations as specified by the
+// following flags, which may be combined.
+//
+// The -v flag enables additional output about operations performed.
+//
+// The first group of operations provide low-level editing operations
+// for manipulating go.mod from the command line or in scripts and
+// other tools. They read only go.mod itself; they do not look up any
+// information about the modules involved.
+//
+// The -init flag initializes and writes a new go.mod to the current directory,
+// in effect creating a new module rooted at the current directory.
+// The file go.mod must not already exist.
+// If possible, mod will guess the module path from import comments
+// (see 'go help importpath') or from the directory's location in GOPATH.
+//
+// The -module flag changes (or, with -init, sets) the module's path
+// (the go.mod file's module line).
+//
+// The -require=path@version and -droprequire=path flags
+// add and drop a requirement on the given module path and version.
+// Note that -require overrides any existing requirements on path.
+// These flags are mainly for tools that understand the module graph.
+// Users should prefer 'go get path@version' or 'go get path@none',
+// which make other go.mod adjustments as needed to satisfy
+// constraints imposed by other modules.
+// Both flags may be repeated.
+//
+// The -fmt flag reformats the go.mod file without making other changes.
+// This reformatting is also implied by any other modifications that use or
+// rewrite the go.mod file. The only time this flag is needed is if no other
+// flags are specified, as in 'go mod -fmt'.
+//
+// The -graph flag prints the module requirement graph (with replacements applied)
+// in text form. Each line in the output has two space-separated fields: a module
+// and one of its requirements. Each module is identified as a string of the form
+// path@version, except for the main module, which has no @version suffix.
+//
+// The next group of operations provide higher-level editing and maintenance
+// of a module, beyond the go.mod file.
+//
+// The -sync flag synchronizes go.mod with the source code in the module.
+// It adds any missing modules necessary to build the current module's
+// packages and dependencies, and it removes unused modules that
+// don't provide any relevant packages.
+//
+// The -verify flag checks that the dependencies of the main module
+// stored in the module download cache have not been modified since
+// they were downloaded. If all the modules are unmodified,
+// -verify prints "all modules verified". Otherwise it reports which
+// modules have been changed and causes 'go mod' to exit with a
+// non-zero status.
+//
+//
 // Compile and run Go program
 //
 // Usage:
@@ -740,8 +850,8 @@
 //
 // 'Go test' recompiles each package along with any files with names matching
 // the file pattern "*_test.go".
-// These additional files can contain test functions, benchmark functions, and
-// example functions. See 'go help testfunc' for more.
+// These additional files can contain test functions, benchmark functions,
+// fuzz targets, and example functions. See 'go help testfunc' for more.
 // Each listed package causes the execution of a separate test binary.
 // Files whose names begin with "_" (including "_test.go") or "." are ignored.
 //
@@ -1036,6 +1146,47 @@
 // command.
 //
 //
+// The go.mod file
+//
+// A module version is defined by a tree of source files, with a go.mod
+// file in its root. When the go command is run, it looks in the current
+// directory and then successive parent directories to find the go.mod
+// marking the root of the main (current) module.
+//
+// The go.mod file itself is line-oriented, with // comments but
+// no /* */ comments. Each line holds a single directive, made up of a
+// verb followed by arguments. For example:
+//
+// 	module example.com/my/thing
+// 	require example.com/other/thing v1.0.2
+// 	require example.com/new/thing/v2 v2.3.4
+// 	exclude example.com/old/thing v1.2.3
+// 	replace example.com/bad/thing v1.4.5 => example.com/good/thing v1.4.5
+//
+// The verbs are module, to define the module path; require, to require
+// a particular module at a given version or later; exclude, to exclude
+// a particular module version from use; and replace, to replace a module
+// version with a different module version or with a directory, such as
+// ../thing. A replace directive without a version on the left side
+// applies to all versions of the module.
+//
+// The leading verb can be factored out of adjacent lines to create a block,
+// like in Go imports:
+//
+// 	require (
+// 		example.com/new/thing/v2 v2.3.4
+// 		example.com/old/thing v1.2.3
+// 	)
+//
+// The go.mod file is designed both to be edited directly and to be
+// easily updated by tools. The go command updates go.mod automatically
+// when it adds a module to satisfy an import, and 'go get' and 'go mod'
+// edit it as well. In particular, versions such as branch names or
+// commit hashes are rewritten to the corresponding semantic version
+// or pseudo-version. Otherwise, edits preserve comments and formatting;
+// 'go mod -fmt' reformats the file.
+//
+//
 // GOPATH environment variable
 //
 // The Go path is used to resolve import statements.
@@ -1201,11 +1352,17 @@
 // 		Examples are amd64, 386, arm, ppc64.
 // 	GOBIN
 // 		The directory where 'go install' will install a command.
+// 	GO111MODULE
+// 		Controls whether the go command uses modules: on, off, or auto.
+// 		See 'go help modules'.
 // 	GOOS
 // 		The operating system for which to compile code.
 // 		Examples are linux, darwin, windows, netbsd.
 // 	GOPATH
 // 		For more details see: 'go help gopath'.
+// 	GOPROXY
+// 		URL of the module proxy, or "off" to disallow downloading modules.
+// 		See 'go help modules'.
 // 	GORACE
 // 		Options for the race detector.
 // 		See https://golang.org/doc/articles/race_detector.html.
@@ -1454,6 +1611,188 @@
 // See https://golang.org/s/go14customimport for details.
 //
 //
+// Modules, module versions, and more
+//
+// A module is a collection of related Go packages, versioned together
+// as a single unit. Modules record precise dependency requirements
+// and create reproducible builds, without GOPATH.
+//
+// Module support
+//
+// The go command is module-aware when the current directory, or one of
+// its parents, holds a go.mod file and is outside GOPATH/src.
+// The environment variable GO111MODULE changes that default:
+// GO111MODULE=on makes the go command module-aware everywhere,
+// and GO111MODULE=off disables module support entirely.
+// In module-aware mode, GOPATH no longer defines the meaning of imports
+// during a build, but it still stores downloaded dependencies
+// (in GOPATH/pkg/mod) and installed commands (in GOPATH/bin,
+// unless GOBIN is set).
+//
+// Defining a module
+//
+// A module is defined by a tree of Go source files with a go.mod file
+// in the tree's root directory. The directory holding the go.mod file
+// is the module root. The go.mod file declares the module path, which
+// is the import path prefix for all the packages in the module,
+// and the modules it requires, each at a minimum version:
+//
+// 	module example.com/m
+//
+// 	require (
+// 		golang.org/x/text v0.3.0
+// 		gopkg.in/yaml.v2 v2.1.0
+// 	)
+//
+// The module's root package is the one in the module root,
+// imported as example.com/m, and its other packages are in
+// subdirectories, such as example.com/m/sub in the directory sub.
+// A subdirectory holding its own go.mod file starts a separate module.
+//
+// To start a new module, run 'go mod -init' in its root directory.
+// The module path is taken from the directory's location in GOPATH
+// or from an import comment in the package there; the -module flag
+// sets it explicitly. See 'go help go.mod' for the file format.
+//
+// Module versions
+//
+// Modules are versioned using semantic version tags such as v1.2.3
+// in their version control repositories. Tagged versions of a module
+// in a repository subdirectory are prefixed by the subdirectory, as in
+// sub/v1.2.3. Untagged commits are named by pseudo-versions of the form
+// v0.0.0-yyyymmddhhmmss-abcdefabcdef, giving the UTC commit time and
+// a prefix of the commit hash.
+//
+// Following semantic import versioning, a module at major version 2 or
+// higher must include the major version in its path, as in example.com/m/v2,
+// so that different major versions are different modules. The code for
+// such a module is either at the repository path root, with a go.mod file
+// declaring the /v2 path, or in the v2 subdirectory.
+//
+// Minimal version selection
+//
+// The set of modules providing packages to a build is the build list.
+// It starts with the main module, the module holding the current
+// directory, and adds the modules it requires, and the modules
+// those modules require, and so on. When more than one version of a
+// module is required, the build list holds only the highest of them:
+// the minimum version that satisfies every requirement.
+// The build list changes only when a go.mod file changes, never because
+// a newer version of a dependency was published.
+//
+// The main module's go.mod file may also exclude specific module
+// versions, which are then skipped in favor of the next higher version,
+// and replace module versions with other modules or with local
+// directories. Exclusions and replacements in the go.mod files of
+// other modules are ignored.
+//
+// When a package being built imports a package that no module in the
+// build list provides, the go command looks up the latest version of
+// a module providing it and adds that module to go.mod.
+// 'go list -m all' prints the build list, and 'go mod -graph' prints
+// the requirement graph behind it.
+//
+// Downloading and verifying modules
+//
+// The go command downloads modules into the module cache, in
+// GOPATH/pkg/mod, where their files are made read-only.
+// 'go clean -modcache' removes the cache.
+//
+// By default, modules are downloaded directly from their version control
+// repositories (only Git is supported). If GOPROXY is set to a URL,
+// modules are instead fetched from that module proxy, which serves
+// for each module path M the files M/@v/list (the versions of M, one
+// per line), and M/@v/V.info, M/@v/V.mod, and M/@v/V.zip (the commit
+// information, go.mod file, and file archive of version V). A file://
+// URL names a directory tree in the same layout; the download cache,
+// GOPATH/pkg/mod/cache/download, is such a tree.
+// GOPROXY=off disallows downloading modules.
+//
+// The go.sum file, next to go.mod, holds the expected cryptographic
+// checksums of the content of specific module versions and of their
+// go.mod files:
+//
+// 	golang.org/x/text v0.3.0 h1:g61tztE5qeGQ89tm6NTjjM9VPIm088od1l6aSorWRWg=
+// 	golang.org/x/text v0.3.0/go.mod h1:NqM8EUOU14njkJ3fqMW+pc6Ldnwhi/IjpwHt7yyuwOQ=
+//
+// Each time a dependency is used, its checksum is added to go.sum if
+// missing, or else required to match the existing entry. A mismatch is
+// reported as an error. Both go.mod and go.sum should be checked in
+// to version control. 'go mod -verify' checks that the module cache
+// still holds the files recorded in go.sum.
+//
+// Packages and patterns in module mode
+//
+// In module mode, the pattern "all" means the packages in the main module
+// and all the packages they import, including in tests. A pattern with
+// "..." matches packages in the main module and the other modules of the
+// build list. Directories outside the main module cannot be named on the
+// command line, and vendor directories are ignored, except in the
+// standard library.
+//
+//
+// Module-aware go get
+//
+// The 'go get' command changes behavior depending on whether the
+// go command is running in module-aware mode or legacy GOPATH mode.
+// This help text, accessible as 'go help module-get' even in legacy GOPATH mode,
+// describes 'go get' as it operates in module-aware mode.
+//
+// Usage: get [-d] [-u] [build flags] [packages]
+//
+// Get resolves and adds dependencies to the current development module
+// and then builds and installs them.
+//
+// The first step is to resolve which dependencies to add.
+//
+// For each named package or package pattern, get must decide which version of
+// the corresponding module to use. By default, get chooses the latest tagged
+// release version, such as v0.4.5 or v1.2.3. If there are no tagged release
+// versions, get chooses the latest commit on the repository's default branch,
+// named by a pseudo-version.
+//
+// This default version selection can be overridden by adding an @version
+// suffix to the package argument, as in 'go get golang.org/x/text@v0.3.0'.
+// For modules stored in source control repositories, the version suffix can
+// also be a commit hash, branch identifier, or other syntax known to the
+// source control system, as in 'go get golang.org/x/text@master'.
+// The version suffix @latest explicitly requests the default behavior
+// described above.
+//
+// If a module under consideration is already a dependency of the current
+// development module, then get will update the required version.
+// Specifying a version earlier than the current required version is valid and
+// downgrades the dependency, although the build may still select a higher
+// version if another module in the build requires it. The version suffix
+// @none indicates that the dependency should be removed entirely.
+//
+// The -u flag instructs get to update the dependencies of the modules
+// providing the named packages to use newer minor or patch releases when
+// available. With no package arguments, -u updates all the dependencies
+// of the main module.
+//
+// In general, adding a new dependency may require upgrading
+// existing dependencies to keep a working build, and 'go get' does
+// this automatically, by minimal version selection.
+//
+// The second step is to download (if needed), build, and install
+// the named packages. With no package arguments, get installs the
+// package in the current directory.
+//
+// The -d flag instructs get to download the source code needed to build
+// the named packages, including downloading necessary dependencies,
+// but not to build and install them.
+//
+// Get also accepts build flags to control the installation.
+// See 'go help build'.
+//
+// For more about modules, see 'go help modules'.
+//
+// For more about specifying packages, see 'go help packages'.
+//
+// See also: go build, go install, go clean, go mod.
+//
+//
 // Package lists
 //
 // Many commands apply to a set of packages:
@@ -1604,6 +1943,26 @@
 // 	-failfast
 // 	    Do not start new tests after the first test failure.
 //
+// 	-fuzz regexp
+// 	    Fuzz the fuzz target matching the regular expression, after
+// 	    running the tests. The regular expression must match exactly one
+// 	    fuzz target, and the flag can only be used with a single package.
+// 	    The package under test (or the packages selected by -coverpkg) is
+// 	    instrumented for coverage, which guides the generation of inputs.
+// 	    A failing input is minimized and written to testdata/fuzz/FuzzXxx
+// 	    in the package directory. Inputs that reach new coverage are kept
+// 	    in the build cache for the next run. Unless -timeout is given,
+// 	    the test binary is not timed out. See 'go help testfunc'.
+//
+// 	-fuzzminimizetime t
+// 	    Spend at most t minimizing a failing input found by -fuzz.
+// 	    The default is 60 seconds (60s). 0 disables minimization.
+//
+// 	-fuzztime t
+// 	    Fuzz for at most t, specified as a time.Duration (for example,
+// 	    -fuzztime 1h30s). The default is to fuzz until a failing input
+// 	    is found or the go command is interrupted.
+//
 // 	-list regexp
 // 	    List tests, benchmarks, or examples matching the regular expression.
 // 	    No tests, benchmarks or examples will be run. This will only
@@ -1765,8 +2124,8 @@
 //
 // Testing functions
 //
-// The 'go test' command expects to find test, benchmark, and example functions
-// in the "*_test.go" files corresponding to the package under test.
+// The 'go test' command expects to find test, benchmark, fuzz target, and example
+// functions in the "*_test.go" files corresponding to the package under test.
 //
 // A test function is one named TestXxx (where Xxx does not start with a
 // lower case letter) and should have the signature,
@@ -1777,6 +2136,14 @@
 //
 // 	func BenchmarkXxx(b *testing.B) { ... }
 //
+// A fuzz target is one named FuzzXxx and should have the signature,
+//
+// 	func FuzzXxx(f *testing.F) { ... }
+//
+// A fuzz target runs as a test of its seed inputs and of the inputs stored in
+// testdata/fuzz/FuzzXxx; with the -fuzz flag, go test also generates new inputs.
+// See the documentation of the testing package for more.
+//
 // An example function is similar to a test function but, instead of using
 // *testing.T to report success or failure, prints output to os.Stdout.
 // If the last comment in the function starts with "Output:" then the output
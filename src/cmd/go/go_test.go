@@ -5972,3 +5972,69 @@ echo $* >>`+tg.path("pkg-config.out"))
 		t.Errorf("got %q want %q", out, want)
 	}
 }
+
+func TestGoTestFuzz(t *testing.T) {
+	tooSlow(t)
+	tg := testgo(t)
+	defer tg.cleanup()
+	tg.parallel()
+	tg.makeTempdir()
+	tg.setenv("GOCACHE", tg.path("cache"))
+	tg.setenv("GOPATH", tg.path("."))
+	tg.tempFile("src/p/p.go", `package p
+
+// Sum returns the sum of the first four bytes of b,
+// but forgets to check inputs of two or three bytes.
+func Sum(b []byte) int {
+	if len(b) < 2 {
+		return 0
+	}
+	return int(b[0]) + int(b[1]) + int(b[2]) + int(b[3])
+}
+`)
+	tg.tempFile("src/p/p_test.go", `package p
+
+import "testing"
+
+func FuzzSum(f *testing.F) {
+	f.Add([]byte("abcdef"))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		Sum(b)
+	})
+}
+`)
+
+	// Without -fuzz, the fuzz target runs on its seed corpus.
+	tg.run("test", "-v", "p")
+	tg.grepStdout(`--- PASS: FuzzSum/seed#0`, "go test did not run the seed corpus")
+
+	// Only a fuzzing test binary handles interrupts.
+	tg.run("test", "-n", "p")
+	tg.grepStderrNot(`testdeps/interrupt`, "go test linked the interrupt handler without -fuzz")
+	tg.run("test", "-n", "-fuzz=Sum", "p")
+	tg.grepStderr(`testdeps/interrupt`, "go test -fuzz did not link the interrupt handler")
+
+	tg.runFail("test", "-fuzz=Sum", "p", "fmt")
+	tg.grepStderr("cannot use -fuzz flag with multiple packages", "go test -fuzz did not reject multiple packages")
+
+	tg.runFail("test", "-fuzz=Sum", "-fuzztime=2m", "p")
+	tg.grepStdout(`Failing input written to testdata[/\\]fuzz[/\\]FuzzSum[/\\][0-9a-f]{16}`, "go test -fuzz did not report the failing input")
+	files, err := ioutil.ReadDir(tg.path("src/p/testdata/fuzz/FuzzSum"))
+	tg.must(err)
+	if len(files) != 1 {
+		t.Fatalf("go test -fuzz wrote %d inputs, want 1", len(files))
+	}
+	data, err := ioutil.ReadFile(tg.path("src/p/testdata/fuzz/FuzzSum/" + files[0].Name()))
+	tg.must(err)
+	m := regexp.MustCompile(`^go test fuzz v1\n\[\]byte\((".*")\)\n$`).FindSubmatch(data)
+	if m == nil {
+		t.Fatalf("go test -fuzz wrote %q, want a []byte input", data)
+	}
+	if b, err := strconv.Unquote(string(m[1])); err != nil || len(b) < 2 || len(b) > 3 {
+		t.Errorf("go test -fuzz wrote %q, want a minimized input of 2 or 3 bytes", data)
+	}
+
+	// The failing input is now part of the corpus.
+	tg.runFail("test", "-v", "p")
+	tg.grepStdout(`=== RUN   FuzzSum/`+files[0].Name(), "go test did not run the failing input")
+}
@@ -40,6 +40,8 @@ var (
 	CmdName string // "build", "install", "list", etc.
 
 	DebugActiongraph string // -debug-actiongraph flag (undocumented, unstable)
+
+	ModulesEnabled bool // set by modload.Init: resolve imports using go.mod, not GOPATH
 )
 
 func init() {
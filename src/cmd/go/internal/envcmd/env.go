@@ -16,6 +16,7 @@ import (
 	"cmd/go/internal/cache"
 	"cmd/go/internal/cfg"
 	"cmd/go/internal/load"
+	"cmd/go/internal/modload"
 	"cmd/go/internal/work"
 )
 
@@ -56,6 +57,7 @@ func MkEnv() []cfg.EnvVar {
 		{Name: "GOHOSTOS", Value: runtime.GOOS},
 		{Name: "GOOS", Value: cfg.Goos},
 		{Name: "GOPATH", Value: cfg.BuildContext.GOPATH},
+		{Name: "GOPROXY", Value: os.Getenv("GOPROXY")},
 		{Name: "GORACE", Value: os.Getenv("GORACE")},
 		{Name: "GOROOT", Value: cfg.GOROOT},
 		{Name: "GOTMPDIR", Value: os.Getenv("GOTMPDIR")},
@@ -129,6 +131,7 @@ func ExtraEnvVars() []cfg.EnvVar {
 		{Name: "CGO_LDFLAGS", Value: strings.Join(ldflags, " ")},
 		{Name: "PKG_CONFIG", Value: b.PkgconfigCmd()},
 		{Name: "GOGCCFLAGS", Value: strings.Join(cmd[3:], " ")},
+		{Name: "GOMOD", Value: modload.ModFilePath()},
 	}
 }
 
@@ -149,7 +152,8 @@ func runEnv(cmd *base.Command, args []string) {
 				"CGO_FFLAGS",
 				"CGO_LDFLAGS",
 				"PKG_CONFIG",
-				"GOGCCFLAGS":
+				"GOGCCFLAGS",
+				"GOMOD":
 				needExtra = true
 			}
 		}
@@ -640,6 +640,24 @@ type repoRoot struct {
 	isCustom bool
 }
 
+// A RepoRoot describes the repository holding an import path,
+// for use by code outside this package.
+type RepoRoot struct {
+	VCS  string // name of the version control command, such as "git"
+	Repo string // repository URL, including scheme
+	Root string // import path corresponding to the root of the repository
+}
+
+// RepoRootForImportPath analyzes importPath to determine the
+// version control system, and code repository to use.
+func RepoRootForImportPath(importPath string, security web.SecurityMode) (*RepoRoot, error) {
+	rr, err := repoRootForImportPath(importPath, security)
+	if err != nil {
+		return nil, err
+	}
+	return &RepoRoot{VCS: rr.vcs.cmd, Repo: rr.repo, Root: rr.root}, nil
+}
+
 var httpPrefixRE = regexp.MustCompile(`^https?:`)
 
 // repoRootForImportPath analyzes importPath to determine the
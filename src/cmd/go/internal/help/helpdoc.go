@@ -461,11 +461,17 @@ General-purpose environment variables:
 		Examples are amd64, 386, arm, ppc64.
 	GOBIN
 		The directory where 'go install' will install a command.
+	GO111MODULE
+		Controls whether the go command uses modules: on, off, or auto.
+		See 'go help modules'.
 	GOOS
 		The operating system for which to compile code.
 		Examples are linux, darwin, windows, netbsd.
 	GOPATH
 		For more details see: 'go help gopath'.
+	GOPROXY
+		URL of the module proxy, or "off" to disallow downloading modules.
+		See 'go help modules'.
 	GORACE
 		Options for the race detector.
 		See https://golang.org/doc/articles/race_detector.html.
@@ -20,11 +20,24 @@ import (
 
 	"cmd/go/internal/base"
 	"cmd/go/internal/cfg"
+	"cmd/go/internal/modinfo"
+	"cmd/go/internal/module"
 	"cmd/go/internal/str"
 )
 
 var IgnoreImports bool // control whether we ignore imports in packages
 
+// Module hooks, installed by package modload when modules are enabled
+// (see cfg.ModulesEnabled). They are function variables because
+// modload itself depends on this package.
+var (
+	ModBinDir            func() string                             // return effective bin directory
+	ModLookup            func(path string) (dir string, err error) // return directory holding import path
+	ModPackageModuleInfo func(path string) *modinfo.ModulePublic   // return module info for Package struct
+	ModImportPaths       func(args []string) []string              // expand import paths on command line
+	ModDirImportPath     func(dir string) (path string, err error) // return import path for directory
+)
+
 // A Package describes a single package found in a directory.
 type Package struct {
 	PackagePublic                 // visible in 'go list'
@@ -48,6 +61,8 @@ type PackagePublic struct {
 	ConflictDir   string `json:",omitempty"` // Dir is hidden by this other directory
 	BinaryOnly    bool   `json:",omitempty"` // package cannot be recompiled
 
+	Module *modinfo.ModulePublic `json:",omitempty"` // info about package's containing module, if any
+
 	// Stale and StaleReason remain here *only* for the list command.
 	// They are only initialized in preparation for list execution.
 	// The regular build determines staleness on the fly during action execution.
@@ -413,6 +428,8 @@ func LoadImport(path, srcDir string, parent *Package, stk *ImportStack, importPo
 	origPath := path
 	isLocal := build.IsLocalImport(path)
 	var debugDeprecatedImportcfgDir string
+	var modDir string
+	var modErr error
 	if isLocal {
 		importPath = dirToImportPath(filepath.Join(srcDir, path))
 	} else if DebugDeprecatedImportcfg.enabled {
@@ -420,6 +437,14 @@ func LoadImport(path, srcDir string, parent *Package, stk *ImportStack, importPo
 			debugDeprecatedImportcfgDir = d
 			importPath = i
 		}
+	} else if cfg.ModulesEnabled {
+		// Only the standard library still uses vendor directories;
+		// everything else is found through the build list.
+		if mode&UseVendor != 0 && parent != nil && parent.Goroot {
+			path = VendoredImportPath(parent, path)
+			importPath = path
+		}
+		modDir, modErr = ModLookup(path)
 	} else if mode&UseVendor != 0 {
 		// We do our own vendor resolution, because we want to
 		// find out the key to use in packageCache without the
@@ -448,6 +473,17 @@ func LoadImport(path, srcDir string, parent *Package, stk *ImportStack, importPo
 		} else if DebugDeprecatedImportcfg.enabled {
 			bp = new(build.Package)
 			err = fmt.Errorf("unknown import path %q: not in import cfg", importPath)
+		} else if cfg.ModulesEnabled && !isLocal {
+			if modErr != nil {
+				bp = new(build.Package)
+				err = modErr
+			} else {
+				bp, err = cfg.BuildContext.ImportDir(modDir, 0)
+			}
+			if !bp.Goroot {
+				bp.BinDir = ModBinDir()
+				p.Module = ModPackageModuleInfo(importPath)
+			}
 		} else {
 			buildMode := build.ImportComment
 			if mode&UseVendor == 0 || path != origPath {
@@ -479,7 +515,7 @@ func LoadImport(path, srcDir string, parent *Package, stk *ImportStack, importPo
 	}
 
 	// Checked on every import because the rules depend on the code doing the importing.
-	if perr := disallowInternal(srcDir, p, stk); perr != p {
+	if perr := disallowInternal(srcDir, parent, p, stk); perr != p {
 		return setErrorPos(perr, importPos)
 	}
 	if mode&UseVendor != 0 {
@@ -662,10 +698,11 @@ func reusePackage(p *Package, stk *ImportStack) *Package {
 	return p
 }
 
-// disallowInternal checks that srcDir is allowed to import p.
+// disallowInternal checks that srcDir (containing package importer, if non-nil)
+// is allowed to import p.
 // If the import is allowed, disallowInternal returns the original package p.
 // If not, it returns a new package containing just an appropriate error.
-func disallowInternal(srcDir string, p *Package, stk *ImportStack) *Package {
+func disallowInternal(srcDir string, importer *Package, p *Package, stk *ImportStack) *Package {
 	// golang.org/s/go14internal:
 	// An import of a path containing the element “internal”
 	// is disallowed if the importing code is outside the tree
@@ -708,16 +745,26 @@ func disallowInternal(srcDir string, p *Package, stk *ImportStack) *Package {
 	if i > 0 {
 		i-- // rewind over slash in ".../internal"
 	}
-	parent := p.Dir[:i+len(p.Dir)-len(p.ImportPath)]
-	if str.HasFilePathPrefix(filepath.Clean(srcDir), filepath.Clean(parent)) {
-		return p
-	}
 
-	// Look for symlinks before reporting error.
-	srcDir = expandPath(srcDir)
-	parent = expandPath(parent)
-	if str.HasFilePathPrefix(filepath.Clean(srcDir), filepath.Clean(parent)) {
-		return p
+	if p.Module != nil {
+		// The directories of module packages do not mirror their
+		// import paths, so apply the rule to the importer's
+		// import path instead.
+		if importer != nil && hasPathPrefix(importer.ImportPath, p.ImportPath[:i]) {
+			return p
+		}
+	} else {
+		parent := p.Dir[:i+len(p.Dir)-len(p.ImportPath)]
+		if str.HasFilePathPrefix(filepath.Clean(srcDir), filepath.Clean(parent)) {
+			return p
+		}
+
+		// Look for symlinks before reporting error.
+		srcDir = expandPath(srcDir)
+		parent = expandPath(parent)
+		if str.HasFilePathPrefix(filepath.Clean(srcDir), filepath.Clean(parent)) {
+			return p
+		}
 	}
 
 	// Internal is present, and srcDir is outside parent's tree. Not allowed.
@@ -947,6 +994,15 @@ func (p *Package) load(stk *ImportStack, bp *build.Package, err error) {
 			return
 		}
 		_, elem := filepath.Split(p.Dir)
+		if p.Module != nil {
+			// The directory of a module package may be named
+			// path@version; name the binary after the import path,
+			// leaving out any major version suffix.
+			elem = pathpkg.Base(p.ImportPath)
+			if prefix, _, ok := module.SplitPathVersion(p.ImportPath); ok && prefix != p.ImportPath {
+				elem = pathpkg.Base(prefix)
+			}
+		}
 		full := cfg.BuildContext.GOOS + "_" + cfg.BuildContext.GOARCH + "/" + elem
 		if cfg.BuildContext.GOOS != base.ToolGOOS || cfg.BuildContext.GOARCH != base.ToolGOARCH {
 			// Install cross-compiled binaries to subdirectories of bin.
@@ -969,8 +1025,9 @@ func (p *Package) load(stk *ImportStack, bp *build.Package, err error) {
 		if p.Target != "" && cfg.BuildContext.GOOS == "windows" {
 			p.Target += ".exe"
 		}
-	} else if p.Internal.Local {
-		// Local import turned into absolute path.
+	} else if p.Internal.Local || p.Module != nil {
+		// Local import turned into absolute path,
+		// or a package in a module, which is cached by version.
 		// No permanent install target.
 		p.Target = ""
 	} else {
@@ -1355,6 +1412,24 @@ func ClearCmdCache() {
 // loadPackage accepts pseudo-paths beginning with cmd/ to denote commands
 // in the Go command directory, as well as paths to those directories.
 func LoadPackage(arg string, stk *ImportStack) *Package {
+	if cfg.ModulesEnabled && build.IsLocalImport(arg) {
+		// In module mode, a directory names the package
+		// at the corresponding import path in its module.
+		path, err := ModDirImportPath(filepath.Join(base.Cwd, arg))
+		if err != nil {
+			stk.Push(arg)
+			defer stk.Pop()
+			p := new(Package)
+			p.ImportPath = arg
+			p.Error = &PackageError{
+				ImportStack: stk.Copy(),
+				Err:         err.Error(),
+			}
+			p.Incomplete = true
+			return p
+		}
+		arg = path
+	}
 	if build.IsLocalImport(arg) {
 		dir := arg
 		if !filepath.IsAbs(dir) {
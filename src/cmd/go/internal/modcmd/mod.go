@@ -0,0 +1,248 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package modcmd implements the ``go mod'' command.
+package modcmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"cmd/go/internal/base"
+	"cmd/go/internal/dirhash"
+	"cmd/go/internal/modfetch"
+	"cmd/go/internal/modfile"
+	"cmd/go/internal/modload"
+	"cmd/go/internal/module"
+	"cmd/go/internal/mvs"
+	"cmd/go/internal/semver"
+)
+
+var CmdMod = &base.Command{
+	UsageLine: "mod [-v] [maintenance flags]",
+	Short:     "module maintenance",
+	Long: `
+Mod performs module maintenance operations as specified by the
+following flags, which may be combined.
+
+The -v flag enables additional output about operations performed.
+
+The first group of operations provide low-level editing operations
+for manipulating go.mod from the command line or in scripts and
+other tools. They read only go.mod itself; they do not look up any
+information about the modules involved.
+
+The -init flag initializes and writes a new go.mod to the current directory,
+in effect creating a new module rooted at the current directory.
+The file go.mod must not already exist.
+If possible, mod will guess the module path from import comments
+(see 'go help importpath') or from the directory's location in GOPATH.
+
+The -module flag changes (or, with -init, sets) the module's path
+(the go.mod file's module line).
+
+The -require=path@version and -droprequire=path flags
+add and drop a requirement on the given module path and version.
+Note that -require overrides any existing requirements on path.
+These flags are mainly for tools that understand the module graph.
+Users should prefer 'go get path@version' or 'go get path@none',
+which make other go.mod adjustments as needed to satisfy
+constraints imposed by other modules.
+Both flags may be repeated.
+
+The -fmt flag reformats the go.mod file without making other changes.
+This reformatting is also implied by any other modifications that use or
+rewrite the go.mod file. The only time this flag is needed is if no other
+flags are specified, as in 'go mod -fmt'.
+
+The -graph flag prints the module requirement graph (with replacements applied)
+in text form. Each line in the output has two space-separated fields: a module
+and one of its requirements. Each module is identified as a string of the form
+path@version, except for the main module, which has no @version suffix.
+
+The next group of operations provide higher-level editing and maintenance
+of a module, beyond the go.mod file.
+
+The -sync flag synchronizes go.mod with the source code in the module.
+It adds any missing modules necessary to build the current module's
+packages and dependencies, and it removes unused modules that
+don't provide any relevant packages.
+
+The -verify flag checks that the dependencies of the main module
+stored in the module download cache have not been modified since
+they were downloaded. If all the modules are unmodified,
+-verify prints "all modules verified". Otherwise it reports which
+modules have been changed and causes 'go mod' to exit with a
+non-zero status.
+	`,
+}
+
+var (
+	modV      = CmdMod.Flag.Bool("v", false, "")
+	modFmt    = CmdMod.Flag.Bool("fmt", false, "")
+	modGraph  = CmdMod.Flag.Bool("graph", false, "")
+	modInit   = CmdMod.Flag.Bool("init", false, "")
+	modModule = CmdMod.Flag.String("module", "", "")
+	modSync   = CmdMod.Flag.Bool("sync", false, "")
+	modVerify = CmdMod.Flag.Bool("verify", false, "")
+
+	modEdits []func(*modfile.File) // edits specified in flags
+)
+
+// A flagFunc is a flag.Value that calls a function for each use of the flag.
+type flagFunc func(string)
+
+func (f flagFunc) String() string     { return "" }
+func (f flagFunc) Set(s string) error { f(s); return nil }
+
+func init() {
+	CmdMod.Run = runMod // break init cycle
+
+	CmdMod.Flag.Var(flagFunc(flagRequire), "require", "")
+	CmdMod.Flag.Var(flagFunc(flagDropRequire), "droprequire", "")
+}
+
+func runMod(cmd *base.Command, args []string) {
+	if len(args) != 0 {
+		base.Fatalf("go mod: mod takes no arguments")
+	}
+
+	anyFlags := *modInit ||
+		*modModule != "" ||
+		*modFmt ||
+		*modGraph ||
+		*modSync ||
+		*modVerify ||
+		len(modEdits) > 0
+
+	if !anyFlags {
+		base.Fatalf("go mod: no flags specified (see 'go help mod').")
+	}
+
+	if *modInit || *modModule != "" {
+		modload.CmdModInit = *modInit
+		modload.CmdModModule = *modModule
+		modload.Init()
+	}
+	if !modload.Enabled() {
+		base.Fatalf("go mod: cannot use outside module")
+	}
+	modload.InitMod()
+
+	if len(modEdits) > 0 {
+		f := modload.ModFile()
+		for _, edit := range modEdits {
+			edit(f)
+		}
+	}
+	if *modSync {
+		modload.Sync(*modV)
+	}
+	modload.WriteGoMod()
+
+	if *modGraph {
+		modGraphCmd()
+	}
+	if *modVerify {
+		modVerifyCmd()
+	}
+}
+
+// parsePathVersion parses -flag=arg expecting arg to be path@version.
+func parsePathVersion(flag, arg string) (path, version string) {
+	i := strings.Index(arg, "@")
+	if i < 0 {
+		base.Fatalf("go mod: -%s=%s: need path@version", flag, arg)
+	}
+	path, version = strings.TrimSpace(arg[:i]), strings.TrimSpace(arg[i+1:])
+	if err := module.CheckPath(path); err != nil {
+		base.Fatalf("go mod: -%s=%s: invalid path: %v", flag, arg, err)
+	}
+	if !semver.IsValid(version) || version != semver.Canonical(version) {
+		base.Fatalf("go mod: -%s=%s: invalid module version %q", flag, arg, version)
+	}
+	return path, version
+}
+
+// flagRequire implements the -require flag.
+func flagRequire(arg string) {
+	path, version := parsePathVersion("require", arg)
+	modEdits = append(modEdits, func(f *modfile.File) {
+		f.AddRequire(path, version)
+	})
+}
+
+// flagDropRequire implements the -droprequire flag.
+func flagDropRequire(arg string) {
+	if err := module.CheckPath(arg); err != nil {
+		base.Fatalf("go mod: -droprequire=%s: invalid path: %v", arg, err)
+	}
+	modEdits = append(modEdits, func(f *modfile.File) {
+		f.DropRequire(arg)
+	})
+}
+
+// modGraphCmd implements the -graph flag.
+func modGraphCmd() {
+	edges, err := mvs.Graph(modload.LoadBuildList(), modload.Reqs())
+	if err != nil {
+		base.Fatalf("go mod -graph: %v", err)
+	}
+	for _, e := range edges {
+		fmt.Println(e)
+	}
+}
+
+// modVerifyCmd implements the -verify flag.
+func modVerifyCmd() {
+	ok := true
+	for _, mod := range modload.LoadBuildList()[1:] {
+		ok = verifyMod(mod) && ok
+	}
+	if ok {
+		fmt.Printf("all modules verified\n")
+	}
+}
+
+// verifyMod reports whether the downloaded copy of mod,
+// both the zip file and the extracted tree, matches go.sum.
+func verifyMod(mod module.Version) bool {
+	ok := true
+	zip, zipErr := modfetch.CachePath(mod, "zip")
+	if zipErr == nil {
+		_, zipErr = os.Stat(zip)
+	}
+	dir, dirErr := modfetch.DownloadDir(mod)
+	if dirErr == nil {
+		_, dirErr = os.Stat(dir)
+	}
+	data, err := ioutil.ReadFile(strings.TrimSuffix(zip, ".zip") + ".ziphash")
+	if err != nil {
+		if zipErr != nil && os.IsNotExist(zipErr) && dirErr != nil && os.IsNotExist(dirErr) {
+			// Nothing downloaded yet. Nothing to verify.
+			return true
+		}
+		base.Errorf("%s %s: missing ziphash: %v", mod.Path, mod.Version, err)
+		return false
+	}
+	h := string(data)
+	if sum := modfetch.Sum(mod); sum != "" && h != sum {
+		base.Errorf("%s %s: zip has been modified (%v)", mod.Path, mod.Version, zip)
+		ok = false
+	}
+	if dirErr == nil {
+		hD, err := dirhash.HashDir(dir, mod.Path+"@"+mod.Version, dirhash.DefaultHash)
+		if err != nil {
+			base.Errorf("%s %s: %v", mod.Path, mod.Version, err)
+			return false
+		}
+		if hD != h {
+			base.Errorf("%s %s: dir has been modified (%v)", mod.Path, mod.Version, dir)
+			ok = false
+		}
+	}
+	return ok
+}
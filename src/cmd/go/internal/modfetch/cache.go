@@ -0,0 +1,228 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfetch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"cmd/go/internal/module"
+	"cmd/go/internal/semver"
+)
+
+// PkgMod is the root of the module cache, $GOPATH/pkg/mod.
+// It is set by the module loader before any download begins.
+var PkgMod string
+
+// CachePath returns the name of the file in the download cache
+// holding the given suffix ("info", "mod", "zip", or "ziphash")
+// for module version m.
+func CachePath(m module.Version, suffix string) (string, error) {
+	dir, err := cacheDir(m.Path)
+	if err != nil {
+		return "", err
+	}
+	if !semver.IsValid(m.Version) {
+		return "", fmt.Errorf("non-semver module version %q", m.Version)
+	}
+	if semver.Canonical(m.Version) != m.Version {
+		return "", fmt.Errorf("non-canonical module version %q", m.Version)
+	}
+	enc, err := module.EncodeVersion(m.Version)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, enc+"."+suffix), nil
+}
+
+// cacheDir returns the download cache directory for the module path.
+func cacheDir(path string) (string, error) {
+	if PkgMod == "" {
+		return "", fmt.Errorf("internal error: modfetch.PkgMod not set")
+	}
+	enc, err := module.EncodePath(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(PkgMod, "cache/download", enc, "@v"), nil
+}
+
+// A cachingRepo is a Repo that caches the results of Stat and GoMod,
+// both in memory and in the download cache on disk, so that builds
+// need no network access once a module version has been downloaded.
+type cachingRepo struct {
+	r    Repo
+	path string
+
+	mu   sync.Mutex
+	stat map[string]*RevInfo
+	mod  map[string][]byte
+}
+
+func newCachingRepo(r Repo) *cachingRepo {
+	return &cachingRepo{
+		r:    r,
+		path: r.ModulePath(),
+		stat: make(map[string]*RevInfo),
+		mod:  make(map[string][]byte),
+	}
+}
+
+func (r *cachingRepo) ModulePath() string {
+	return r.path
+}
+
+func (r *cachingRepo) Versions(prefix string) ([]string, error) {
+	return r.r.Versions(prefix)
+}
+
+func (r *cachingRepo) Stat(rev string) (*RevInfo, error) {
+	r.mu.Lock()
+	info := r.stat[rev]
+	r.mu.Unlock()
+	if info != nil {
+		return info, nil
+	}
+
+	file, info, err := readDiskStat(r.path, rev)
+	if err != nil {
+		info, err = r.r.Stat(rev)
+		if err != nil {
+			return nil, err
+		}
+		if file, _, err = readDiskStat(r.path, info.Version); err != nil {
+			writeDiskStat(file, info)
+		}
+	}
+
+	r.mu.Lock()
+	r.stat[rev] = info
+	r.stat[info.Version] = info
+	r.mu.Unlock()
+	return info, nil
+}
+
+func (r *cachingRepo) Latest() (*RevInfo, error) {
+	info, err := r.r.Latest()
+	if err != nil {
+		return nil, err
+	}
+	if file, _, err := readDiskStat(r.path, info.Version); err != nil {
+		writeDiskStat(file, info)
+	}
+	return info, nil
+}
+
+func (r *cachingRepo) GoMod(version string) ([]byte, error) {
+	r.mu.Lock()
+	data, ok := r.mod[version]
+	r.mu.Unlock()
+	if ok {
+		return data, nil
+	}
+
+	file, data, err := readDiskGoMod(r.path, version)
+	if err != nil {
+		data, err = r.r.GoMod(version)
+		if err != nil {
+			return nil, err
+		}
+		checkGoMod(r.path, version, data)
+		if file != "" {
+			writeDiskCache(file, data)
+		}
+	}
+
+	r.mu.Lock()
+	r.mod[version] = data
+	r.mu.Unlock()
+	return data, nil
+}
+
+func (r *cachingRepo) Zip(version, tmpdir string) (string, error) {
+	return r.r.Zip(version, tmpdir)
+}
+
+// readDiskStat reads the cached revision information for path@rev,
+// returning the name of the cache file and the information.
+// If rev is not a canonical version, or the information is not
+// cached, readDiskStat returns a non-nil error.
+func readDiskStat(path, rev string) (file string, info *RevInfo, err error) {
+	file, data, err := readDiskCache(path, rev, "info")
+	if err != nil {
+		return file, nil, err
+	}
+	info = new(RevInfo)
+	if err := json.Unmarshal(data, info); err != nil || info.Version != rev {
+		return file, nil, errNotCached
+	}
+	return file, info, nil
+}
+
+// readDiskGoMod reads the cached go.mod file for path@rev,
+// returning the name of the cache file and the content.
+func readDiskGoMod(path, rev string) (file string, data []byte, err error) {
+	file, data, err = readDiskCache(path, rev, "mod")
+	if err == nil {
+		checkGoMod(path, rev, data)
+	}
+	return file, data, err
+}
+
+var errNotCached = fmt.Errorf("not in cache")
+
+// readDiskCache reads the cache file for path@rev with the given suffix.
+// If rev is not a canonical version, file is empty.
+func readDiskCache(path, rev, suffix string) (file string, data []byte, err error) {
+	file, err = CachePath(module.Version{Path: path, Version: rev}, suffix)
+	if err != nil {
+		return "", nil, errNotCached
+	}
+	data, err = ioutil.ReadFile(file)
+	if err != nil {
+		return file, nil, errNotCached
+	}
+	return file, data, nil
+}
+
+// writeDiskStat writes the revision information to the cache file.
+func writeDiskStat(file string, info *RevInfo) error {
+	if file == "" {
+		return nil
+	}
+	js, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return writeDiskCache(file, js)
+}
+
+// writeDiskCache writes data to the cache file, creating its
+// directory if necessary. The file is written to a temporary name
+// first, so that concurrent readers never observe a partial file.
+func writeDiskCache(file string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0777); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(file), filepath.Base(file)+".tmp-")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), file)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
@@ -0,0 +1,321 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfetch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"cmd/go/internal/base"
+	"cmd/go/internal/dirhash"
+	"cmd/go/internal/module"
+)
+
+var downloadCache struct {
+	mu sync.Mutex
+	m  map[module.Version]*downloadResult
+}
+
+type downloadResult struct {
+	once sync.Once
+	dir  string
+	err  error
+}
+
+// DownloadDir returns the directory in the module cache
+// holding the extracted files of module version mod.
+func DownloadDir(mod module.Version) (string, error) {
+	if PkgMod == "" {
+		return "", fmt.Errorf("internal error: modfetch.PkgMod not set")
+	}
+	enc, err := module.EncodePath(mod.Path)
+	if err != nil {
+		return "", err
+	}
+	encVer, err := module.EncodeVersion(mod.Version)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(PkgMod, enc+"@"+encVer), nil
+}
+
+// Download downloads the given module version to its directory
+// in the module cache, verifying it against go.sum, and returns
+// the name of the directory.
+func Download(mod module.Version) (dir string, err error) {
+	downloadCache.mu.Lock()
+	if downloadCache.m == nil {
+		downloadCache.m = make(map[module.Version]*downloadResult)
+	}
+	r := downloadCache.m[mod]
+	if r == nil {
+		r = new(downloadResult)
+		downloadCache.m[mod] = r
+	}
+	downloadCache.mu.Unlock()
+
+	r.once.Do(func() {
+		r.dir, r.err = download(mod)
+	})
+	return r.dir, r.err
+}
+
+func download(mod module.Version) (dir string, err error) {
+	dir, err = DownloadDir(mod)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(dir); err == nil {
+		checkMod(mod)
+		return dir, nil
+	}
+
+	zipfile, err := CachePath(mod, "zip")
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(zipfile); err == nil {
+		checkMod(mod)
+	} else if err := downloadZip(mod, zipfile); err != nil {
+		return "", err
+	}
+	if err := Unzip(dir, zipfile, mod.Path+"@"+mod.Version+"/", 0); err != nil {
+		return "", fmt.Errorf("unzip %v: %v", zipfile, err)
+	}
+	return dir, nil
+}
+
+// downloadZip downloads the zip file for mod into the download cache
+// as target, recording its hash alongside it in target's .ziphash file.
+func downloadZip(mod module.Version, target string) error {
+	repo, err := Lookup(mod.Path)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "go: downloading %s %s\n", mod.Path, mod.Version)
+	tmpfile, err := repo.Zip(mod.Version, os.TempDir())
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpfile)
+
+	hash, err := dirhash.HashZip(tmpfile, dirhash.DefaultHash)
+	if err != nil {
+		return err
+	}
+	checkOneSum(mod, hash)
+
+	data, err := ioutil.ReadFile(tmpfile)
+	if err != nil {
+		return err
+	}
+	if err := writeDiskCache(target, data); err != nil {
+		return err
+	}
+	return writeDiskCache(strings.TrimSuffix(target, ".zip")+".ziphash", []byte(hash))
+}
+
+// Unzip extracts the module zip file zipfile into the directory dir,
+// which must not exist yet. Every file in the zip must have a name
+// beginning with prefix, which is removed. If maxSize is positive,
+// it limits the total uncompressed size of the files; otherwise
+// the limit is 500 MB.
+// The extracted files are made read-only, to discourage
+// accidental edits to shared module code.
+func Unzip(dir, zipfile, prefix string, maxSize int64) error {
+	if maxSize <= 0 {
+		maxSize = 500 << 20
+	}
+	if err := os.MkdirAll(filepath.Dir(dir), 0777); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), filepath.Base(dir)+".tmp-")
+	if err != nil {
+		return err
+	}
+	if err := unzip(tmp, zipfile, prefix, maxSize); err != nil {
+		makeWritable(tmp)
+		os.RemoveAll(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		makeWritable(tmp)
+		os.RemoveAll(tmp)
+		if _, err1 := os.Stat(dir); err1 == nil {
+			// Another go command extracted the same module first.
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// makeWritable makes the directory tree dir writable again,
+// so that it can be removed.
+func makeWritable(dir string) {
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && info.IsDir() {
+			os.Chmod(path, 0777)
+		}
+		return nil
+	})
+}
+
+// RemoveAll removes the directory tree dir,
+// which may hold read-only directories extracted by Unzip.
+func RemoveAll(dir string) error {
+	makeWritable(dir)
+	return os.RemoveAll(dir)
+}
+
+// Checksums of module versions, from the go.sum file.
+// Each version maps to a list of hashes such as "h1:...".
+var goSum struct {
+	mu    sync.Mutex
+	m     map[module.Version][]string
+	dirty bool
+}
+
+// GoSumFile is the name of the go.sum file to use for verifying
+// downloads. It is set by the module loader; if it is empty,
+// downloads are not verified.
+var GoSumFile string
+
+// initGoSum loads the go.sum file, reporting whether checksums
+// are in use. The caller must hold goSum.mu.
+//
+// Errors here and in checkOneSum release goSum.mu before calling
+// base.Fatalf, so that the WriteGoSum exit hook does not deadlock.
+func initGoSum() bool {
+	if GoSumFile == "" {
+		return false
+	}
+	if goSum.m != nil {
+		return true
+	}
+	goSum.m = make(map[module.Version][]string)
+	data, err := ioutil.ReadFile(GoSumFile)
+	if err != nil && !os.IsNotExist(err) {
+		goSum.mu.Unlock()
+		base.Fatalf("go: %v", err)
+	}
+	for i, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		if len(f) != 3 {
+			goSum.mu.Unlock()
+			base.Fatalf("go: malformed go.sum:\n%s:%d: wrong number of fields %v", GoSumFile, i+1, len(f))
+		}
+		mod := module.Version{Path: f[0], Version: f[1]}
+		goSum.m[mod] = append(goSum.m[mod], f[2])
+	}
+	return true
+}
+
+// checkMod checks the hash of the cached zip file for mod
+// against go.sum, if the hash has been recorded.
+func checkMod(mod module.Version) {
+	ziphash, err := CachePath(mod, "ziphash")
+	if err != nil {
+		return
+	}
+	data, err := ioutil.ReadFile(ziphash)
+	if err != nil {
+		return
+	}
+	if h := strings.TrimSpace(string(data)); strings.HasPrefix(h, "h1:") {
+		checkOneSum(mod, h)
+	}
+}
+
+// goModSum returns the checksum for the go.mod contents.
+func goModSum(data []byte) (string, error) {
+	return dirhash.Hash1([]string{"go.mod"}, func(string) (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	})
+}
+
+// checkGoMod checks the given module's go.mod checksum;
+// data is the go.mod content.
+func checkGoMod(path, version string, data []byte) {
+	h, err := goModSum(data)
+	if err != nil {
+		base.Fatalf("go: verifying %s %s go.mod: %v", path, version, err)
+	}
+	checkOneSum(module.Version{Path: path, Version: version + "/go.mod"}, h)
+}
+
+// checkOneSum checks that the recorded hash for mod is h,
+// adding h to go.sum if no hash is recorded yet.
+func checkOneSum(mod module.Version, h string) {
+	goSum.mu.Lock()
+	defer goSum.mu.Unlock()
+	if !initGoSum() {
+		return
+	}
+	for _, vh := range goSum.m[mod] {
+		if h == vh {
+			return
+		}
+		if strings.HasPrefix(vh, "h1:") {
+			goSum.mu.Unlock()
+			base.Fatalf("go: verifying %s@%s: checksum mismatch\n\tdownloaded: %v\n\tgo.sum:     %v", mod.Path, mod.Version, h, vh)
+		}
+	}
+	goSum.m[mod] = append(goSum.m[mod], h)
+	goSum.dirty = true
+}
+
+// Sum returns the checksum recorded in go.sum
+// for the downloaded copy of the given module,
+// or the empty string if there is none.
+func Sum(mod module.Version) string {
+	goSum.mu.Lock()
+	defer goSum.mu.Unlock()
+	if !initGoSum() {
+		return ""
+	}
+	for _, h := range goSum.m[mod] {
+		if strings.HasPrefix(h, "h1:") {
+			return h
+		}
+	}
+	return ""
+}
+
+// WriteGoSum writes the go.sum file if it needs to be updated.
+func WriteGoSum() {
+	goSum.mu.Lock()
+	defer goSum.mu.Unlock()
+	if !goSum.dirty {
+		return
+	}
+	var mods []module.Version
+	for m := range goSum.m {
+		mods = append(mods, m)
+	}
+	module.Sort(mods)
+	var buf bytes.Buffer
+	for _, m := range mods {
+		list := goSum.m[m]
+		sort.Strings(list)
+		for _, h := range list {
+			fmt.Fprintf(&buf, "%s %s %s\n", m.Path, m.Version, h)
+		}
+	}
+	if err := ioutil.WriteFile(GoSumFile, buf.Bytes(), 0666); err != nil {
+		base.Fatalf("go: writing go.sum: %v", err)
+	}
+	goSum.dirty = false
+}
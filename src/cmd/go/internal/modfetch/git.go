@@ -0,0 +1,342 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfetch
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cmd/go/internal/cfg"
+	"cmd/go/internal/get"
+	"cmd/go/internal/module"
+	"cmd/go/internal/semver"
+)
+
+// A gitRepo is a module stored directly in a Git repository.
+//
+// The module's versions are the repository's tags of the form
+// codeDir/vX.Y.Z (or vX.Y.Z when the module is at the repository root)
+// whose major version matches the module path. At each revision,
+// the module's files are those in codeDir, or in codeDir/vN for a
+// module path ending in /vN if that directory holds a go.mod file.
+//
+// The repository is mirrored into a bare clone in the module cache,
+// $GOPATH/pkg/mod/cache/vcs, and fetched at most once per go command.
+type gitRepo struct {
+	modPath   string
+	pathMajor string // major version suffix of modPath: "", "/vN", or ".vN"
+	codeDir   string // directory within the repository holding the module
+	remote    string // repository URL
+	dir       string // local bare repository
+
+	fetchOnce sync.Once
+	fetchErr  error
+}
+
+func newGitRepo(modPath string, rr *get.RepoRoot) (Repo, error) {
+	if modPath != rr.Root && !strings.HasPrefix(modPath, rr.Root+"/") {
+		return nil, fmt.Errorf("%s: repository root %s does not contain module", modPath, rr.Root)
+	}
+	_, pathMajor, ok := module.SplitPathVersion(modPath)
+	if !ok {
+		return nil, fmt.Errorf("%s: invalid module path", modPath)
+	}
+	codeDir := strings.Trim(strings.TrimPrefix(modPath, rr.Root), "/")
+	if strings.HasPrefix(pathMajor, "/") {
+		codeDir = strings.TrimSuffix(strings.TrimSuffix(codeDir, pathMajor[1:]), "/")
+	}
+	if PkgMod == "" {
+		return nil, fmt.Errorf("internal error: modfetch.PkgMod not set")
+	}
+	r := &gitRepo{
+		modPath:   modPath,
+		pathMajor: pathMajor,
+		codeDir:   codeDir,
+		remote:    rr.Repo,
+		dir:       filepath.Join(PkgMod, "cache/vcs", fmt.Sprintf("%x", sha256.Sum256([]byte("git:"+rr.Repo)))),
+	}
+	if err := r.init(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *gitRepo) ModulePath() string {
+	return r.modPath
+}
+
+// init creates the local bare repository, if it does not yet exist.
+func (r *gitRepo) init() error {
+	if _, err := os.Stat(filepath.Join(r.dir, "objects")); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(r.dir, 0777); err != nil {
+		return err
+	}
+	if _, err := r.git("init", "--bare"); err != nil {
+		os.RemoveAll(r.dir)
+		return err
+	}
+	if _, err := r.git("remote", "add", "origin", "--", r.remote); err != nil {
+		os.RemoveAll(r.dir)
+		return err
+	}
+	return nil
+}
+
+// fetch updates the local repository from the remote,
+// mirroring its branches and tags and recording its default branch.
+func (r *gitRepo) fetch() error {
+	r.fetchOnce.Do(func() {
+		_, r.fetchErr = r.git("fetch", "-f", "origin",
+			"+refs/heads/*:refs/heads/*",
+			"+refs/tags/*:refs/tags/*",
+			"+HEAD:refs/remotes/origin/HEAD")
+	})
+	return r.fetchErr
+}
+
+// git runs the git command with the given arguments in the local repository.
+func (r *gitRepo) git(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = r.dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if cfg.BuildX {
+		fmt.Fprintf(os.Stderr, "cd %s; git %s\n", r.dir, strings.Join(args, " "))
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %v\n%s", strings.Join(args, " "), err, stderr.Bytes())
+	}
+	return out, nil
+}
+
+// tagPrefix returns the prefix of the repository tags naming module versions.
+func (r *gitRepo) tagPrefix() string {
+	if r.codeDir == "" {
+		return ""
+	}
+	return r.codeDir + "/"
+}
+
+// isVersionTag reports whether v, a tag with the prefix removed,
+// names a version of this module.
+func (r *gitRepo) isVersionTag(v string) bool {
+	return semver.IsValid(v) && v == semver.Canonical(v) && !IsPseudoVersion(v) && module.MatchPathMajor(v, r.pathMajor)
+}
+
+func (r *gitRepo) Versions(prefix string) ([]string, error) {
+	if err := r.fetch(); err != nil {
+		return nil, err
+	}
+	out, err := r.git("tag", "-l", "--", r.tagPrefix()+"v*")
+	if err != nil {
+		return nil, err
+	}
+	var list []string
+	for _, tag := range strings.Fields(string(out)) {
+		v := strings.TrimPrefix(tag, r.tagPrefix())
+		if r.isVersionTag(v) && strings.HasPrefix(v, prefix) {
+			list = append(list, v)
+		}
+	}
+	SortVersions(list)
+	return list, nil
+}
+
+func (r *gitRepo) Stat(rev string) (*RevInfo, error) {
+	hash, t, err := r.resolve(rev)
+	if err != nil {
+		return nil, err
+	}
+	if semver.IsValid(rev) && rev == semver.Canonical(rev) {
+		return &RevInfo{Version: rev, Time: t}, nil
+	}
+	return &RevInfo{Version: r.versionFor(hash, t), Time: t}, nil
+}
+
+func (r *gitRepo) Latest() (*RevInfo, error) {
+	if err := r.fetch(); err != nil {
+		return nil, err
+	}
+	return r.Stat("refs/remotes/origin/HEAD")
+}
+
+// versionFor returns the version naming the commit hash:
+// the highest version tag pointing at it, or else a pseudo-version.
+func (r *gitRepo) versionFor(hash string, t time.Time) string {
+	var best string
+	if out, err := r.git("tag", "-l", "--points-at", hash); err == nil {
+		for _, tag := range strings.Fields(string(out)) {
+			if !strings.HasPrefix(tag, r.tagPrefix()) {
+				continue
+			}
+			v := strings.TrimPrefix(tag, r.tagPrefix())
+			if r.isVersionTag(v) && (best == "" || semver.Compare(v, best) > 0) {
+				best = v
+			}
+		}
+	}
+	if best != "" {
+		return best
+	}
+	major := "v0"
+	if r.pathMajor != "" {
+		major = r.pathMajor[1:]
+	}
+	return PseudoVersion(major, t, hash)
+}
+
+// resolve returns the commit hash and commit time for rev,
+// which may be a version of this module or any Git revision.
+// It fetches from the remote only if rev is not known locally.
+func (r *gitRepo) resolve(rev string) (hash string, t time.Time, err error) {
+	if strings.HasPrefix(rev, "-") || rev == "" {
+		return "", time.Time{}, fmt.Errorf("%s: invalid revision %q", r.modPath, rev)
+	}
+	gitRev := rev
+	if IsPseudoVersion(rev) {
+		gitRev, _ = PseudoVersionRev(rev)
+	} else if semver.IsValid(rev) && rev == semver.Canonical(rev) {
+		if !r.isVersionTag(rev) {
+			return "", time.Time{}, fmt.Errorf("%s: invalid version %s for module", r.modPath, rev)
+		}
+		gitRev = "refs/tags/" + r.tagPrefix() + rev
+	}
+	out, err := r.git("log", "-n1", "--format=format:%H %ct", gitRev+"^{commit}", "--")
+	if err != nil {
+		if err := r.fetch(); err != nil {
+			return "", time.Time{}, err
+		}
+		out, err = r.git("log", "-n1", "--format=format:%H %ct", gitRev+"^{commit}", "--")
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("%s: unknown revision %s", r.modPath, rev)
+		}
+	}
+	f := strings.Fields(string(out))
+	if len(f) != 2 {
+		return "", time.Time{}, fmt.Errorf("%s: unexpected response from git log: %q", r.modPath, out)
+	}
+	sec, err := strconv.ParseInt(f[1], 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%s: invalid commit time %q", r.modPath, f[1])
+	}
+	return f[0], time.Unix(sec, 0).UTC(), nil
+}
+
+// moduleDir returns the directory holding the module at the given commit,
+// along with the content of its go.mod file, or nil if it has none.
+func (r *gitRepo) moduleDir(hash string) (dir string, gomod []byte) {
+	dirs := []string{r.codeDir}
+	if strings.HasPrefix(r.pathMajor, "/") {
+		dirs = []string{path.Join(r.codeDir, r.pathMajor[1:]), r.codeDir}
+	}
+	for _, dir := range dirs {
+		if data, err := r.git("cat-file", "blob", hash+":"+path.Join(dir, "go.mod")); err == nil {
+			return dir, data
+		}
+	}
+	return r.codeDir, nil
+}
+
+func (r *gitRepo) GoMod(version string) ([]byte, error) {
+	hash, _, err := r.resolve(version)
+	if err != nil {
+		return nil, err
+	}
+	_, data := r.moduleDir(hash)
+	if data == nil {
+		// A module without a go.mod file has no requirements.
+		data = []byte(fmt.Sprintf("module %s\n", r.modPath))
+	}
+	return data, nil
+}
+
+func (r *gitRepo) Zip(version, tmpdir string) (string, error) {
+	hash, _, err := r.resolve(version)
+	if err != nil {
+		return "", err
+	}
+	dir, _ := r.moduleDir(hash)
+	treeish := hash
+	if dir != "" {
+		treeish += ":" + dir
+	}
+	prefix := r.modPath + "@" + version + "/"
+	archive, err := r.git("archive", "--format=zip", "--prefix="+prefix, treeish)
+	if err != nil {
+		return "", err
+	}
+	f, err := ioutil.TempFile(tmpdir, "go-git-download-")
+	if err != nil {
+		return "", err
+	}
+	err = copyModuleZip(f, archive, prefix)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// copyModuleZip writes to w the regular files in the zip archive data,
+// leaving out any subdirectories holding go.mod files:
+// those are separate modules.
+func copyModuleZip(w io.Writer, data []byte, prefix string) error {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+	nested := make(map[string]bool)
+	for _, zf := range zr.File {
+		name := strings.TrimPrefix(zf.Name, prefix)
+		if path.Base(name) == "go.mod" && name != "go.mod" {
+			nested[path.Dir(name)+"/"] = true
+		}
+	}
+	zw := zip.NewWriter(w)
+Files:
+	for _, zf := range zr.File {
+		if !zf.Mode().IsRegular() {
+			continue
+		}
+		name := strings.TrimPrefix(zf.Name, prefix)
+		for dir := range nested {
+			if strings.HasPrefix(name, dir) {
+				continue Files
+			}
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return err
+		}
+		fw, err := zw.Create(zf.Name)
+		if err == nil {
+			_, err = io.Copy(fw, rc)
+		}
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
@@ -0,0 +1,147 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfetch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cmd/go/internal/module"
+	"cmd/go/internal/semver"
+	"cmd/go/internal/web"
+)
+
+// A module proxy serves a simple file tree over HTTP or from the
+// local file system (using a file:// URL). For a module path M,
+// with M encoded as by module.EncodePath, it serves:
+//
+//	M/@v/list        the known versions of M, one per line
+//	M/@v/V.info      JSON-encoded RevInfo for version V
+//	M/@v/V.mod       the go.mod file for version V
+//	M/@v/V.zip       the zip archive of version V
+//
+// The version V is encoded as by module.EncodeVersion.
+// Because the tree needs no special server support, a module
+// download cache directory, $GOPATH/pkg/mod/cache/download,
+// can itself be served as a proxy.
+
+type proxyRepo struct {
+	url  string
+	path string
+}
+
+func newProxyRepo(baseURL, path string) (Repo, error) {
+	enc, err := module.EncodePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return &proxyRepo{strings.TrimSuffix(baseURL, "/") + "/" + pathEscape(enc), path}, nil
+}
+
+func (p *proxyRepo) ModulePath() string {
+	return p.path
+}
+
+func (p *proxyRepo) Versions(prefix string) ([]string, error) {
+	data, err := proxyGet(p.url + "/@v/list")
+	if err != nil {
+		return nil, err
+	}
+	var list []string
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) >= 1 && semver.IsValid(f[0]) && strings.HasPrefix(f[0], prefix) {
+			list = append(list, f[0])
+		}
+	}
+	SortVersions(list)
+	return list, nil
+}
+
+func (p *proxyRepo) Stat(rev string) (*RevInfo, error) {
+	enc, err := module.EncodeVersion(rev)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proxyGet(p.url + "/@v/" + pathEscape(enc) + ".info")
+	if err != nil {
+		return nil, err
+	}
+	info := new(RevInfo)
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("%s@%s: invalid revision information: %v", p.path, rev, err)
+	}
+	return info, nil
+}
+
+func (p *proxyRepo) Latest() (*RevInfo, error) {
+	list, err := p.Versions("")
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("%s: no versions available", p.path)
+	}
+	return p.Stat(list[len(list)-1])
+}
+
+func (p *proxyRepo) GoMod(version string) ([]byte, error) {
+	enc, err := module.EncodeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	return proxyGet(p.url + "/@v/" + pathEscape(enc) + ".mod")
+}
+
+func (p *proxyRepo) Zip(version, tmpdir string) (string, error) {
+	enc, err := module.EncodeVersion(version)
+	if err != nil {
+		return "", err
+	}
+	data, err := proxyGet(p.url + "/@v/" + pathEscape(enc) + ".zip")
+	if err != nil {
+		return "", err
+	}
+	f, err := ioutil.TempFile(tmpdir, "go-proxy-download-")
+	if err != nil {
+		return "", err
+	}
+	_, err = f.Write(data)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// proxyGet returns the content at the given proxy URL,
+// which may use the http, https, or file scheme.
+func proxyGet(u string) ([]byte, error) {
+	if strings.HasPrefix(u, "file://") {
+		p, err := url.PathUnescape(strings.TrimPrefix(u, "file://"))
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.ReadFile(filepath.FromSlash(p))
+	}
+	if !strings.HasPrefix(u, "http://") && !strings.HasPrefix(u, "https://") {
+		return nil, fmt.Errorf("invalid GOPROXY URL %s: must use http, https, or file scheme", u)
+	}
+	return web.Get(u)
+}
+
+// pathEscape escapes s for use as a URL path,
+// leaving the slash separators alone.
+func pathEscape(s string) string {
+	return strings.Replace(url.PathEscape(s), "%2F", "/", -1)
+}
@@ -0,0 +1,60 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfetch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"cmd/go/internal/semver"
+)
+
+// Pseudo-versions name untagged revisions of a module. A pseudo-version
+// has the form vX.0.0-yyyymmddhhmmss-abcdefabcdef, where the time is the
+// commit time in UTC and the final element is a 12-character prefix of
+// the commit hash. X is the major version implied by the module path
+// (0 for paths without a major version suffix). Pseudo-versions are
+// prereleases, so they sort before any tagged release of that major
+// version, and in commit time order among themselves.
+
+// PseudoVersion returns a pseudo-version for the given major version
+// ("v0", "v2", and so on; "" means "v0"), commit time, and revision.
+func PseudoVersion(major string, t time.Time, rev string) string {
+	if major == "" {
+		major = "v0"
+	}
+	if len(rev) > 12 {
+		rev = rev[:12]
+	}
+	return fmt.Sprintf("%s.0.0-%s-%s", major, t.UTC().Format(pseudoVersionTimestampFormat), rev)
+}
+
+const pseudoVersionTimestampFormat = "20060102150405"
+
+var pseudoVersionRE = regexp.MustCompile(`^v[0-9]+\.0\.0-[0-9]{14}-[A-Za-z0-9]+$`)
+
+// IsPseudoVersion reports whether v is a pseudo-version.
+func IsPseudoVersion(v string) bool {
+	return pseudoVersionRE.MatchString(v) && semver.IsValid(v)
+}
+
+// PseudoVersionTime returns the time stamp of the pseudo-version v.
+func PseudoVersionTime(v string) (time.Time, error) {
+	if !IsPseudoVersion(v) {
+		return time.Time{}, fmt.Errorf("not a pseudo-version")
+	}
+	f := strings.Split(semver.Prerelease(v), "-")
+	return time.Parse(pseudoVersionTimestampFormat, f[1])
+}
+
+// PseudoVersionRev returns the revision identifier of the pseudo-version v.
+func PseudoVersionRev(v string) (rev string, err error) {
+	if !IsPseudoVersion(v) {
+		return "", fmt.Errorf("not a pseudo-version")
+	}
+	return v[strings.LastIndex(v, "-")+1:], nil
+}
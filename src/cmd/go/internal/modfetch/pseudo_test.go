@@ -0,0 +1,63 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfetch
+
+import (
+	"testing"
+	"time"
+)
+
+var pseudoTests = []struct {
+	major   string
+	version string
+}{
+	{"", "v0.0.0-20060102150405-hash"},
+	{"v0", "v0.0.0-20060102150405-hash"},
+	{"v1", "v1.0.0-20060102150405-hash"},
+	{"v2", "v2.0.0-20060102150405-hash"},
+}
+
+var pseudoTime = time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+func TestPseudoVersion(t *testing.T) {
+	for _, tt := range pseudoTests {
+		v := PseudoVersion(tt.major, pseudoTime, "hash")
+		if v != tt.version {
+			t.Errorf("PseudoVersion(%q, ...) = %v, want %v", tt.major, v, tt.version)
+		}
+	}
+	if v := PseudoVersion("", pseudoTime.In(time.FixedZone("EST", -5*3600)), "0123456789abcdef"); v != "v0.0.0-20060102150405-0123456789ab" {
+		t.Errorf("PseudoVersion with zone and long hash = %v", v)
+	}
+}
+
+func TestIsPseudoVersion(t *testing.T) {
+	for _, tt := range pseudoTests {
+		if !IsPseudoVersion(tt.version) {
+			t.Errorf("IsPseudoVersion(%q) = false, want true", tt.version)
+		}
+	}
+	for _, v := range []string{"v1.0.0", "v1.0.0-pre", "v1.2.0-20060102150405-hash", "v1.0.0-2006010215040-hash"} {
+		if IsPseudoVersion(v) {
+			t.Errorf("IsPseudoVersion(%q) = true, want false", v)
+		}
+	}
+}
+
+func TestPseudoVersionTime(t *testing.T) {
+	for _, tt := range pseudoTests {
+		tm, err := PseudoVersionTime(tt.version)
+		if tm != pseudoTime || err != nil {
+			t.Errorf("PseudoVersionTime(%q) = %v, %v, want %v, nil", tt.version, tm.Format(time.RFC3339), err, pseudoTime.Format(time.RFC3339))
+		}
+		rev, err := PseudoVersionRev(tt.version)
+		if rev != "hash" || err != nil {
+			t.Errorf("PseudoVersionRev(%q) = %q, %v, want %q, nil", tt.version, rev, err, "hash")
+		}
+	}
+	if _, err := PseudoVersionTime("v1.0.0"); err == nil {
+		t.Errorf("PseudoVersionTime(v1.0.0): expected error")
+	}
+}
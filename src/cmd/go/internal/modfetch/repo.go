@@ -0,0 +1,116 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package modfetch downloads module versions, either from a module
+// proxy named by $GOPROXY or directly from their version control
+// repositories, and maintains the module download cache.
+package modfetch
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"cmd/go/internal/get"
+	"cmd/go/internal/module"
+	"cmd/go/internal/semver"
+	"cmd/go/internal/web"
+)
+
+// A Repo represents a repository storing all versions of a single module.
+type Repo interface {
+	// ModulePath returns the module path.
+	ModulePath() string
+
+	// Versions lists all known versions with the given prefix,
+	// sorted in semantic version order.
+	// Pseudo-versions are not included.
+	Versions(prefix string) (tags []string, err error)
+
+	// Stat returns information about the revision rev.
+	// A revision can be any identifier known to the underlying service:
+	// a version, a branch name, a tag, or a commit hash.
+	Stat(rev string) (*RevInfo, error)
+
+	// Latest returns the latest revision on the default branch,
+	// whatever that means in the underlying source code repository.
+	Latest() (*RevInfo, error)
+
+	// GoMod returns the go.mod file for the given version.
+	GoMod(version string) (data []byte, err error)
+
+	// Zip downloads a zip file for the given version
+	// to a new file in the given temporary directory.
+	// It returns the name of the new file.
+	// The caller should remove the file when finished with it.
+	Zip(version, tmpdir string) (tmpfile string, err error)
+}
+
+// A RevInfo describes a single revision in a module repository.
+type RevInfo struct {
+	Version string    // version string
+	Time    time.Time // commit time
+}
+
+var lookupCache struct {
+	mu sync.Mutex
+	m  map[string]Repo
+}
+
+// Lookup returns the module repository for the given module path.
+// If $GOPROXY is set, the repository is served by that proxy;
+// otherwise it is accessed directly, using version control.
+func Lookup(path string) (Repo, error) {
+	lookupCache.mu.Lock()
+	defer lookupCache.mu.Unlock()
+	if r, ok := lookupCache.m[path]; ok {
+		return r, nil
+	}
+	r, err := lookup(path)
+	if err != nil {
+		return nil, err
+	}
+	r = newCachingRepo(r)
+	if lookupCache.m == nil {
+		lookupCache.m = make(map[string]Repo)
+	}
+	lookupCache.m[path] = r
+	return r, nil
+}
+
+// proxyURL is the module proxy to use, from $GOPROXY.
+var proxyURL = os.Getenv("GOPROXY")
+
+func lookup(path string) (Repo, error) {
+	if err := module.CheckPath(path); err != nil {
+		return nil, err
+	}
+	if proxyURL == "off" {
+		return nil, fmt.Errorf("module lookup disabled by GOPROXY=%s", proxyURL)
+	}
+	if proxyURL != "" {
+		return newProxyRepo(proxyURL, path)
+	}
+	rr, err := get.RepoRootForImportPath(path, web.Secure)
+	if err != nil {
+		return nil, err
+	}
+	if rr.VCS != "git" {
+		return nil, fmt.Errorf("%s: module download from %s repositories is not supported", path, rr.VCS)
+	}
+	return newGitRepo(path, rr)
+}
+
+// SortVersions sorts the list of versions in semantic version order.
+func SortVersions(list []string) {
+	sort.Slice(list, func(i, j int) bool {
+		cmp := semver.Compare(list[i], list[j])
+		if cmp != 0 {
+			return cmp < 0
+		}
+		return list[i] < list[j]
+	})
+}
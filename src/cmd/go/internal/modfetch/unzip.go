@@ -0,0 +1,116 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfetch
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// unzip extracts zipfile into the existing, empty directory dir.
+// See Unzip for the meaning of prefix and maxSize.
+func unzip(dir, zipfile, prefix string, maxSize int64) error {
+	z, err := zip.OpenReader(zipfile)
+	if err != nil {
+		return err
+	}
+	defer z.Close()
+
+	// Check the file names and sizes before writing anything.
+	var size int64
+	folded := make(map[string]string)
+	for _, zf := range z.File {
+		if isParentDir(zf.Name, prefix) {
+			continue
+		}
+		if !strings.HasPrefix(zf.Name, prefix) {
+			return fmt.Errorf("unexpected file name %s", zf.Name)
+		}
+		name := zf.Name[len(prefix):]
+		if name == "" || strings.HasSuffix(name, "/") {
+			continue
+		}
+		if path.Clean(name) != name || strings.HasPrefix(name, "../") || path.IsAbs(name) || strings.Contains(name, "\\") {
+			return fmt.Errorf("invalid file name %s", zf.Name)
+		}
+		// Reject names that differ only in case:
+		// they cannot be extracted on every file system.
+		lower := strings.ToLower(name)
+		if other, ok := folded[lower]; ok {
+			return fmt.Errorf("case-insensitive file name collision: %q and %q", other, name)
+		}
+		folded[lower] = name
+		s := int64(zf.UncompressedSize64)
+		if s < 0 || maxSize-size < s {
+			return fmt.Errorf("module source tree too big")
+		}
+		size += s
+	}
+
+	var dirs []string
+	for _, zf := range z.File {
+		if isParentDir(zf.Name, prefix) {
+			continue
+		}
+		name := zf.Name[len(prefix):]
+		if name == "" || strings.HasSuffix(name, "/") {
+			continue
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0777); err != nil {
+			return err
+		}
+		for d := path.Dir(name); d != "."; d = path.Dir(d) {
+			dirs = append(dirs, d)
+		}
+		if err := extractFile(target, zf); err != nil {
+			return err
+		}
+	}
+
+	// Make the directories read-only, deepest first.
+	sort.Sort(sort.Reverse(sort.StringSlice(dirs)))
+	for _, d := range dirs {
+		os.Chmod(filepath.Join(dir, filepath.FromSlash(d)), 0555)
+	}
+	return os.Chmod(dir, 0555)
+}
+
+// isParentDir reports whether the zip file name is a directory
+// entry for prefix or one of its parents, such as "example.com/"
+// for the prefix "example.com/m@v1.0.0/". Zip tools add such
+// entries; they carry no content and are skipped.
+func isParentDir(name, prefix string) bool {
+	return strings.HasSuffix(name, "/") && strings.HasPrefix(prefix, name)
+}
+
+// extractFile writes the content of zf to the new, read-only file target.
+func extractFile(target string, zf *zip.File) error {
+	w, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0444)
+	if err != nil {
+		return err
+	}
+	r, err := zf.Open()
+	if err != nil {
+		w.Close()
+		return err
+	}
+	lr := &io.LimitedReader{R: r, N: int64(zf.UncompressedSize64) + 1}
+	_, err = io.Copy(w, lr)
+	r.Close()
+	if err == nil && lr.N <= 0 {
+		err = fmt.Errorf("uncompressed size of file %s is larger than declared size (%d bytes)", zf.Name, zf.UncompressedSize64)
+	}
+	if err1 := w.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
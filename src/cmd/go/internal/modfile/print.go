@@ -0,0 +1,92 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfile
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+// Format returns a go.mod formatting of the syntax tree f.
+//
+// Statements are separated by blank lines, except that consecutive
+// single-line statements with the same verb are kept together.
+func Format(f *FileSyntax) []byte {
+	var b bytes.Buffer
+	var prev Expr
+	for _, stmt := range f.Stmt {
+		if prev != nil && !sameVerbLines(prev, stmt) {
+			b.WriteString("\n")
+		}
+		prev = stmt
+		c := stmt.Comment()
+		printComments(&b, "", c.Before)
+		switch stmt := stmt.(type) {
+		case *CommentBlock:
+			// Only comments.
+		case *Line:
+			printLine(&b, "", stmt.Token, stmt.Suffix)
+		case *LineBlock:
+			printLine(&b, "", append(stmt.Token[:len(stmt.Token):len(stmt.Token)], "("), stmt.Suffix)
+			for _, line := range stmt.Line {
+				printComments(&b, "\t", line.Before)
+				printLine(&b, "\t", line.Token, line.Suffix)
+			}
+			printComments(&b, "\t", stmt.RParen.Before)
+			printLine(&b, "", []string{")"}, stmt.RParen.Suffix)
+		}
+	}
+	if len(f.After) > 0 {
+		if prev != nil {
+			b.WriteString("\n")
+		}
+		printComments(&b, "", f.After)
+	}
+	return b.Bytes()
+}
+
+// sameVerbLines reports whether x and y are single-line
+// statements with the same verb.
+func sameVerbLines(x, y Expr) bool {
+	lx, ok1 := x.(*Line)
+	ly, ok2 := y.(*Line)
+	return ok1 && ok2 && len(ly.Before) == 0 && lx.Token[0] == ly.Token[0]
+}
+
+func printComments(b *bytes.Buffer, indent string, comments []string) {
+	for _, c := range comments {
+		b.WriteString(indent)
+		b.WriteString(c)
+		b.WriteString("\n")
+	}
+}
+
+func printLine(b *bytes.Buffer, indent string, tokens []string, suffix string) {
+	b.WriteString(indent)
+	for i, tok := range tokens {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(quoteToken(tok))
+	}
+	if suffix != "" {
+		b.WriteString(" ")
+		b.WriteString(suffix)
+	}
+	b.WriteString("\n")
+}
+
+// quoteToken returns tok quoted if it could not otherwise be read back
+// as a single token.
+func quoteToken(tok string) string {
+	if tok == "(" || tok == ")" {
+		return tok
+	}
+	if tok == "" || strings.ContainsAny(tok, " \t\r\n\"`()\\") || strings.Contains(tok, "//") {
+		return strconv.Quote(tok)
+	}
+	return tok
+}
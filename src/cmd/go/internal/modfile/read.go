@@ -0,0 +1,231 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfile
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// The go.mod syntax is line-oriented. Each line holds one statement,
+// a list of tokens beginning with a verb like "require". A statement
+// ending in "(" starts a block holding one statement per line, without
+// the verb, up to a line holding only ")". Tokens are separated by
+// spaces; a token that contains spaces or special characters is written
+// as a Go string literal. Comments begin with // and run to the end of
+// the line.
+
+// An Expr is a top-level statement in a go.mod file:
+// a *Line, a *LineBlock, or a *CommentBlock.
+type Expr interface {
+	Comment() *Comments
+}
+
+// Comments holds the comments attached to a syntax element.
+type Comments struct {
+	Before []string // whole-line comments before this element
+	Suffix string   // end-of-line comment after this element, if any
+}
+
+// Comment returns c, so that types embedding Comments implement Expr.
+func (c *Comments) Comment() *Comments {
+	return c
+}
+
+// A FileSyntax is the parsed form of a go.mod file.
+type FileSyntax struct {
+	Name  string // file name, for error messages
+	Stmt  []Expr
+	After []string // comments at the end of the file
+}
+
+// A Line is a single statement, at top level or inside a block.
+// At top level, Token[0] is the verb; inside a block, the verb is
+// held by the block and Token holds only the arguments.
+type Line struct {
+	Comments
+	Start   int // line number
+	Token   []string
+	InBlock bool
+}
+
+// A LineBlock is a block of statements sharing the verb in Token.
+type LineBlock struct {
+	Comments
+	Start  int // line number of the opening line
+	Token  []string
+	Line   []*Line
+	RParen Comments // comments before and after the closing )
+}
+
+// A CommentBlock is a group of comment lines that is not attached to
+// any statement, because a blank line separates them.
+type CommentBlock struct {
+	Comments
+	Start int // line number
+}
+
+// parse parses the go.mod file data, named file in error messages.
+func parse(file string, data []byte) (*FileSyntax, error) {
+	if !utf8.Valid(data) {
+		return nil, fmt.Errorf("%s: invalid UTF-8", file)
+	}
+	f := &FileSyntax{Name: file}
+	var (
+		comments []string   // pending whole-line comments
+		block    *LineBlock // open block, if any
+		errs     bytes.Buffer
+	)
+	flushComments := func() {
+		if len(comments) == 0 {
+			return
+		}
+		if block != nil {
+			// A blank line inside a block does not detach comments.
+			return
+		}
+		f.Stmt = append(f.Stmt, &CommentBlock{Comments: Comments{Before: comments}})
+		comments = nil
+	}
+	errorf := func(line int, format string, args ...interface{}) {
+		fmt.Fprintf(&errs, "%s:%d: %s\n", file, line, fmt.Sprintf(format, args...))
+	}
+
+	lines := strings.Split(string(data), "\n")
+	for i, text := range lines {
+		lineno := i + 1
+		tokens, comment, err := splitLine(text)
+		if err != nil {
+			errorf(lineno, "%v", err)
+			continue
+		}
+		if len(tokens) == 0 {
+			if comment != "" {
+				comments = append(comments, comment)
+			} else {
+				flushComments()
+			}
+			continue
+		}
+
+		if block != nil {
+			if len(tokens) == 1 && tokens[0] == ")" {
+				block.RParen = Comments{Before: comments, Suffix: comment}
+				comments = nil
+				block = nil
+				continue
+			}
+			if tokens[len(tokens)-1] == "(" {
+				errorf(lineno, "nested blocks are not allowed")
+				continue
+			}
+			block.Line = append(block.Line, &Line{
+				Comments: Comments{Before: comments, Suffix: comment},
+				Start:    lineno,
+				Token:    tokens,
+				InBlock:  true,
+			})
+			comments = nil
+			continue
+		}
+
+		if tokens[0] == ")" {
+			errorf(lineno, "unexpected )")
+			continue
+		}
+		if tokens[len(tokens)-1] == "(" {
+			if len(tokens) == 1 {
+				errorf(lineno, "block must begin with a verb")
+				continue
+			}
+			block = &LineBlock{
+				Comments: Comments{Before: comments, Suffix: comment},
+				Start:    lineno,
+				Token:    tokens[:len(tokens)-1],
+			}
+			comments = nil
+			f.Stmt = append(f.Stmt, block)
+			continue
+		}
+		f.Stmt = append(f.Stmt, &Line{
+			Comments: Comments{Before: comments, Suffix: comment},
+			Start:    lineno,
+			Token:    tokens,
+		})
+		comments = nil
+	}
+	if block != nil {
+		errorf(block.Start, "unterminated block")
+	}
+	f.After = comments
+
+	if errs.Len() > 0 {
+		return nil, fmt.Errorf("%s", strings.TrimSuffix(errs.String(), "\n"))
+	}
+	return f, nil
+}
+
+// splitLine splits a line of a go.mod file into its tokens
+// and its trailing comment, if any.
+func splitLine(text string) (tokens []string, comment string, err error) {
+	text = strings.TrimSuffix(text, "\r")
+	for {
+		text = strings.TrimLeft(text, " \t")
+		if text == "" {
+			return tokens, "", nil
+		}
+		switch {
+		case strings.HasPrefix(text, "//"):
+			return tokens, strings.TrimRight(text, " \t"), nil
+		case text[0] == '"' || text[0] == '`':
+			n, err := quotedLen(text)
+			if err != nil {
+				return nil, "", err
+			}
+			tok, err := strconv.Unquote(text[:n])
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid quoted string %s", text[:n])
+			}
+			tokens = append(tokens, tok)
+			text = text[n:]
+		case text[0] == '(' || text[0] == ')':
+			tokens = append(tokens, text[:1])
+			text = text[1:]
+		default:
+			n := 0
+			for n < len(text) && !isTokenEnd(text[n:]) {
+				n++
+			}
+			tokens = append(tokens, text[:n])
+			text = text[n:]
+		}
+	}
+}
+
+// quotedLen returns the length of the quoted string at the start of text.
+func quotedLen(text string) (int, error) {
+	q := text[0]
+	for i := 1; i < len(text); i++ {
+		switch {
+		case text[i] == q:
+			return i + 1, nil
+		case text[i] == '\\' && q == '"':
+			i++
+		}
+	}
+	return 0, fmt.Errorf("unterminated quoted string")
+}
+
+// isTokenEnd reports whether an unquoted token ends at the start of text.
+func isTokenEnd(text string) bool {
+	switch text[0] {
+	case ' ', '\t', '"', '`', '(', ')':
+		return true
+	}
+	return strings.HasPrefix(text, "//")
+}
@@ -0,0 +1,427 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package modfile implements parsing and formatting for go.mod files.
+//
+// A go.mod file defines a module: it declares the module path and
+// lists the versions of the other modules that the module requires.
+// Editing a go.mod file through this package keeps its comments.
+package modfile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"cmd/go/internal/module"
+	"cmd/go/internal/semver"
+)
+
+// A File is the parsed, interpreted form of a go.mod file.
+type File struct {
+	Module  *Module
+	Require []*Require
+	Exclude []*Exclude
+	Replace []*Replace
+
+	Syntax *FileSyntax
+}
+
+// A Module is the module statement.
+type Module struct {
+	Mod    module.Version
+	Syntax *Line
+}
+
+// A Require is a single requirement statement.
+type Require struct {
+	Mod    module.Version
+	Syntax *Line
+}
+
+// An Exclude is a single exclude statement.
+type Exclude struct {
+	Mod    module.Version
+	Syntax *Line
+}
+
+// A Replace is a single replace statement.
+// If New.Version is empty, New.Path is a directory
+// holding the replacement module.
+type Replace struct {
+	Old    module.Version
+	New    module.Version
+	Syntax *Line
+}
+
+// A VersionFixer turns the version written in a go.mod file for the
+// module path into a canonical semantic version, for example by
+// resolving a branch name. It returns an error if it cannot.
+type VersionFixer func(path, version string) (string, error)
+
+// Parse parses the data, reported in errors as being from file,
+// into a File struct. If fix is non-nil, it is used to canonicalize
+// versions that are not canonical semantic versions; the fixed
+// versions are written back to the syntax tree.
+func Parse(file string, data []byte, fix VersionFixer) (*File, error) {
+	fs, err := parse(file, data)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{Syntax: fs}
+
+	var errs bytes.Buffer
+	for _, x := range fs.Stmt {
+		switch x := x.(type) {
+		case *Line:
+			f.add(&errs, x, x.Token[0], x.Token[1:], fix)
+		case *LineBlock:
+			verb := x.Token[0]
+			if len(x.Token) > 1 {
+				fmt.Fprintf(&errs, "%s:%d: unknown block type: %s\n", file, x.Start, strings.Join(x.Token, " "))
+				continue
+			}
+			switch verb {
+			case "require", "exclude", "replace":
+				for _, l := range x.Line {
+					f.add(&errs, l, verb, l.Token, fix)
+				}
+			default:
+				fmt.Fprintf(&errs, "%s:%d: unknown block type: %s\n", file, x.Start, verb)
+			}
+		}
+	}
+	if errs.Len() > 0 {
+		return nil, errors.New(strings.TrimSuffix(errs.String(), "\n"))
+	}
+	return f, nil
+}
+
+func (f *File) add(errs *bytes.Buffer, line *Line, verb string, args []string, fix VersionFixer) {
+	errorf := func(format string, args ...interface{}) {
+		fmt.Fprintf(errs, "%s:%d: %s\n", f.Syntax.Name, line.Start, fmt.Sprintf(format, args...))
+	}
+	// args aliases line.Token, so that parseVersion can write
+	// fixed versions back to the syntax tree.
+	switch verb {
+	default:
+		errorf("unknown directive: %s", verb)
+
+	case "module":
+		if f.Module != nil {
+			errorf("repeated module statement")
+			return
+		}
+		if len(args) != 1 {
+			errorf("usage: module module/path")
+			return
+		}
+		if err := module.CheckPath(args[0]); err != nil {
+			errorf("%v", err)
+			return
+		}
+		f.Module = &Module{Mod: module.Version{Path: args[0]}, Syntax: line}
+
+	case "require", "exclude":
+		if len(args) != 2 {
+			errorf("usage: %s module/path v1.2.3", verb)
+			return
+		}
+		path := args[0]
+		if err := module.CheckPath(path); err != nil {
+			errorf("%v", err)
+			return
+		}
+		v, err := parseVersion(path, &args[1], fix)
+		if err != nil {
+			errorf("%v", err)
+			return
+		}
+		if verb == "require" {
+			f.Require = append(f.Require, &Require{Mod: module.Version{Path: path, Version: v}, Syntax: line})
+		} else {
+			f.Exclude = append(f.Exclude, &Exclude{Mod: module.Version{Path: path, Version: v}, Syntax: line})
+		}
+
+	case "replace":
+		arrow := 2
+		if len(args) >= 2 && args[1] == "=>" {
+			arrow = 1
+		}
+		if len(args) < arrow+2 || len(args) > arrow+3 || args[arrow] != "=>" {
+			errorf("usage: %s module/path [v1.2.3] => other/module v1.4\n\t or %s module/path [v1.2.3] => ../local/directory", verb, verb)
+			return
+		}
+		path := args[0]
+		if err := module.CheckPath(path); err != nil {
+			errorf("%v", err)
+			return
+		}
+		var v string
+		if arrow == 2 {
+			var err error
+			if v, err = parseVersion(path, &args[1], fix); err != nil {
+				errorf("%v", err)
+				return
+			}
+		}
+		nv := ""
+		ns := args[arrow+1]
+		if len(args) == arrow+2 {
+			if !IsDirectoryPath(ns) {
+				errorf("replacement module without version must be directory path (rooted or starting with ./ or ../)")
+				return
+			}
+		} else {
+			if IsDirectoryPath(ns) {
+				errorf("replacement module directory path %q cannot have version", ns)
+				return
+			}
+			if err := module.CheckPath(ns); err != nil {
+				errorf("%v", err)
+				return
+			}
+			var err error
+			if nv, err = parseVersion(ns, &args[arrow+2], fix); err != nil {
+				errorf("%v", err)
+				return
+			}
+		}
+		f.Replace = append(f.Replace, &Replace{
+			Old:    module.Version{Path: path, Version: v},
+			New:    module.Version{Path: ns, Version: nv},
+			Syntax: line,
+		})
+	}
+}
+
+// IsDirectoryPath reports whether the given path should be interpreted
+// as a directory path. Just like on the go command line, relative paths
+// and rooted paths are directory paths; the rest are module paths.
+func IsDirectoryPath(ns string) bool {
+	return strings.HasPrefix(ns, "./") || strings.HasPrefix(ns, "../") || strings.HasPrefix(ns, "/") ||
+		strings.HasPrefix(ns, `.\`) || strings.HasPrefix(ns, `..\`) || strings.HasPrefix(ns, `\`) ||
+		filepath.IsAbs(ns)
+}
+
+func parseVersion(path string, s *string, fix VersionFixer) (string, error) {
+	v := *s
+	if fix != nil && semver.Canonical(v) != v {
+		var err error
+		if v, err = fix(path, v); err != nil {
+			return "", err
+		}
+	}
+	if cv := semver.Canonical(v); cv != "" && cv == v {
+		*s = v
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid version %q for %s: must be of the form v1.2.3", *s, path)
+}
+
+// Format returns the go.mod formatting of f,
+// after removing any statements deleted by edits.
+func (f *File) Format() []byte {
+	f.Cleanup()
+	return Format(f.Syntax)
+}
+
+// AddModuleStmt sets the module path declared by f to path.
+func (f *File) AddModuleStmt(path string) {
+	if f.Syntax == nil {
+		f.Syntax = new(FileSyntax)
+	}
+	if f.Module == nil {
+		line := &Line{Token: []string{"module", path}}
+		f.Syntax.Stmt = append([]Expr{line}, f.Syntax.Stmt...)
+		f.Module = &Module{Mod: module.Version{Path: path}, Syntax: line}
+		return
+	}
+	f.Module.Mod.Path = path
+	f.Module.Syntax.Token[len(f.Module.Syntax.Token)-1] = path
+}
+
+// AddRequire adds a requirement of path at version vers to f,
+// or updates the existing requirement of path.
+func (f *File) AddRequire(path, vers string) {
+	found := false
+	for _, r := range f.Require {
+		if r.Mod.Path != path {
+			continue
+		}
+		if found {
+			// Remove duplicate requirements of path.
+			r.Syntax.Token = nil
+			continue
+		}
+		found = true
+		r.Mod.Version = vers
+		r.Syntax.Token[len(r.Syntax.Token)-1] = vers
+	}
+	if !found {
+		line := f.Syntax.addLine("require", path, vers)
+		f.Require = append(f.Require, &Require{Mod: module.Version{Path: path, Version: vers}, Syntax: line})
+	}
+	f.dropDeleted()
+}
+
+// DropRequire removes any requirement of path from f.
+func (f *File) DropRequire(path string) {
+	for _, r := range f.Require {
+		if r.Mod.Path == path {
+			r.Syntax.Token = nil
+		}
+	}
+	f.dropDeleted()
+}
+
+// SetRequire makes the requirements of f exactly req,
+// updating, adding, and removing statements as needed.
+func (f *File) SetRequire(req []module.Version) {
+	want := make(map[string]string)
+	for _, m := range req {
+		want[m.Path] = m.Version
+	}
+	for _, r := range f.Require {
+		if v, ok := want[r.Mod.Path]; ok && v != "" {
+			r.Mod.Version = v
+			r.Syntax.Token[len(r.Syntax.Token)-1] = v
+			want[r.Mod.Path] = "" // keep only the first statement
+		} else {
+			r.Syntax.Token = nil
+		}
+	}
+	for _, m := range req {
+		if want[m.Path] != "" {
+			line := f.Syntax.addLine("require", m.Path, m.Version)
+			f.Require = append(f.Require, &Require{Mod: m, Syntax: line})
+			want[m.Path] = ""
+		}
+	}
+	f.dropDeleted()
+	f.Syntax.sortBlocks("require")
+}
+
+// dropDeleted removes the requirements whose statements were deleted.
+func (f *File) dropDeleted() {
+	w := 0
+	for _, r := range f.Require {
+		if r.Syntax.Token != nil {
+			f.Require[w] = r
+			w++
+		}
+	}
+	f.Require = f.Require[:w]
+}
+
+// Cleanup removes deleted statements from the syntax tree.
+// A block left with a single statement becomes a single-line statement.
+func (f *File) Cleanup() {
+	fs := f.Syntax
+	w := 0
+	for _, stmt := range fs.Stmt {
+		switch x := stmt.(type) {
+		case *Line:
+			if x.Token == nil {
+				continue
+			}
+		case *LineBlock:
+			ww := 0
+			for _, line := range x.Line {
+				if line.Token != nil {
+					x.Line[ww] = line
+					ww++
+				}
+			}
+			x.Line = x.Line[:ww]
+			switch len(x.Line) {
+			case 0:
+				continue
+			case 1:
+				line := x.Line[0]
+				line.Token = append(x.Token[:len(x.Token):len(x.Token)], line.Token...)
+				line.InBlock = false
+				line.Before = append(x.Before, line.Before...)
+				if line.Suffix == "" {
+					line.Suffix = x.Suffix
+				}
+				stmt = line
+			}
+		}
+		fs.Stmt[w] = stmt
+		w++
+	}
+	fs.Stmt = fs.Stmt[:w]
+}
+
+// addLine adds a statement with the given verb and arguments. It goes
+// into the last block with that verb; if there is none but there are
+// single-line statements with the verb, they are gathered into a new
+// block at the position of the first one. Otherwise the statement is
+// added at the end of the file.
+func (fs *FileSyntax) addLine(verb string, args ...string) *Line {
+	for i := len(fs.Stmt) - 1; i >= 0; i-- {
+		if b, ok := fs.Stmt[i].(*LineBlock); ok && len(b.Token) == 1 && b.Token[0] == verb {
+			line := &Line{Token: args, InBlock: true}
+			b.Line = append(b.Line, line)
+			return line
+		}
+	}
+
+	var block *LineBlock
+	w := 0
+	for _, stmt := range fs.Stmt {
+		if l, ok := stmt.(*Line); ok && l.Token != nil && l.Token[0] == verb {
+			if block == nil {
+				block = &LineBlock{Token: []string{verb}}
+				fs.Stmt[w] = block
+				w++
+			}
+			block.Line = append(block.Line, &Line{
+				Comments: l.Comments,
+				Start:    l.Start,
+				Token:    l.Token[1:],
+				InBlock:  true,
+			})
+			// Keep the statement referred to by the File,
+			// now as a line of the block.
+			*l = *block.Line[len(block.Line)-1]
+			block.Line[len(block.Line)-1] = l
+			continue
+		}
+		fs.Stmt[w] = stmt
+		w++
+	}
+	fs.Stmt = fs.Stmt[:w]
+	if block != nil {
+		line := &Line{Token: args, InBlock: true}
+		block.Line = append(block.Line, line)
+		return line
+	}
+
+	line := &Line{Token: append([]string{verb}, args...)}
+	fs.Stmt = append(fs.Stmt, line)
+	return line
+}
+
+// sortBlocks sorts the lines of the blocks with the given verb
+// by their first token, the module path.
+func (fs *FileSyntax) sortBlocks(verb string) {
+	for _, stmt := range fs.Stmt {
+		b, ok := stmt.(*LineBlock)
+		if !ok || len(b.Token) != 1 || b.Token[0] != verb {
+			continue
+		}
+		sort.SliceStable(b.Line, func(i, j int) bool {
+			li, lj := b.Line[i], b.Line[j]
+			if li.Token == nil || lj.Token == nil {
+				return lj.Token == nil && li.Token != nil
+			}
+			return li.Token[0] < lj.Token[0]
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modfile
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"cmd/go/internal/module"
+)
+
+const testFile = `// The x module.
+module "x.y/z"
+
+// Direct dependencies.
+require (
+	a.b/c v1.2.3 // for c
+	d.e/f/v2 v2.0.0
+)
+
+exclude a.b/c v1.2.2
+
+replace (
+	d.e/f/v2 => ../f
+	g.h/i v1.0.0 => g.h/j v1.0.1
+)
+
+// Trailing comment.
+`
+
+func TestParse(t *testing.T) {
+	f, err := Parse("go.mod", []byte(testFile), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Module.Mod.Path != "x.y/z" {
+		t.Errorf("module path = %q, want x.y/z", f.Module.Mod.Path)
+	}
+	checkMods(t, "require", requires(f), "a.b/c@v1.2.3 d.e/f/v2@v2.0.0")
+	if len(f.Exclude) != 1 || f.Exclude[0].Mod.String() != "a.b/c@v1.2.2" {
+		t.Errorf("exclude = %v, want a.b/c@v1.2.2", f.Exclude)
+	}
+	var replace []string
+	for _, r := range f.Replace {
+		replace = append(replace, r.Old.String()+"=>"+r.New.String())
+	}
+	if got, want := strings.Join(replace, " "), "d.e/f/v2=>../f g.h/i@v1.0.0=>g.h/j@v1.0.1"; got != want {
+		t.Errorf("replace = %s, want %s", got, want)
+	}
+
+	want := strings.Replace(testFile, `"x.y/z"`, "x.y/z", 1)
+	if out := string(f.Format()); out != want {
+		t.Errorf("Format:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+var parseErrorTests = []struct {
+	in  string
+	err string
+}{
+	{"module x.y/z\nmodule x.y/w\n", "go.mod:2: repeated module statement"},
+	{"module x/y\n", "go.mod:1: malformed module path"},
+	{"require a.b/c\n", "go.mod:1: usage: require module/path v1.2.3"},
+	{"require a.b/c master\n", `go.mod:1: invalid version "master" for a.b/c`},
+	{"require (\na.b/c v1.0.0\n", "go.mod:1: unterminated block"},
+	{"unknown x\n", "go.mod:1: unknown directive: unknown"},
+	{"replace a.b/c => d.e/f\n", "go.mod:1: replacement module without version must be directory path"},
+	{"replace a.b/c => ../d v1.0.0\n", `go.mod:1: replacement module directory path "../d" cannot have version`},
+	{`module "x.y/z` + "\n", "go.mod:1: unterminated quoted string"},
+}
+
+func TestParseError(t *testing.T) {
+	for _, tt := range parseErrorTests {
+		_, err := Parse("go.mod", []byte(tt.in), nil)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Parse(%q): error %v, want %q", tt.in, err, tt.err)
+		}
+	}
+}
+
+func TestParseFix(t *testing.T) {
+	fix := func(path, vers string) (string, error) {
+		if vers == "master" {
+			return "v0.0.0-20180101000000-abcdefabcdef", nil
+		}
+		return "", fmt.Errorf("unknown revision %s", vers)
+	}
+	f, err := Parse("go.mod", []byte("module x.y/z\nrequire a.b/c master\n"), fix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "module x.y/z\n\nrequire a.b/c v0.0.0-20180101000000-abcdefabcdef\n"
+	if out := string(f.Format()); out != want {
+		t.Errorf("Format:\n%s\nwant:\n%s", out, want)
+	}
+	if _, err := Parse("go.mod", []byte("module x.y/z\nrequire a.b/c devel\n"), fix); err == nil || !strings.Contains(err.Error(), "unknown revision devel") {
+		t.Errorf("Parse with unknown revision: error %v, want unknown revision", err)
+	}
+}
+
+var editTests = []struct {
+	desc string
+	in   string
+	edit func(f *File)
+	out  string
+}{
+	{
+		"add first requirement",
+		"module x.y/z\n",
+		func(f *File) { f.AddRequire("a.b/c", "v1.0.0") },
+		"module x.y/z\n\nrequire a.b/c v1.0.0\n",
+	},
+	{
+		"add to single line",
+		"module x.y/z\n\n// c\nrequire a.b/c v1.0.0 // why\n",
+		func(f *File) { f.AddRequire("d.e/f", "v1.1.0") },
+		"module x.y/z\n\nrequire (\n\t// c\n\ta.b/c v1.0.0 // why\n\td.e/f v1.1.0\n)\n",
+	},
+	{
+		"update in block",
+		"module x.y/z\n\nrequire (\n\ta.b/c v1.0.0 // why\n\td.e/f v1.1.0\n)\n",
+		func(f *File) { f.AddRequire("a.b/c", "v1.2.0") },
+		"module x.y/z\n\nrequire (\n\ta.b/c v1.2.0 // why\n\td.e/f v1.1.0\n)\n",
+	},
+	{
+		"drop to single line",
+		"module x.y/z\n\nrequire (\n\ta.b/c v1.0.0\n\td.e/f v1.1.0 // why\n)\n",
+		func(f *File) { f.DropRequire("a.b/c") },
+		"module x.y/z\n\nrequire d.e/f v1.1.0 // why\n",
+	},
+	{
+		"set",
+		"module x.y/z\n\nrequire (\n\ta.b/c v1.0.0\n\td.e/f v1.1.0 // why\n)\n",
+		func(f *File) {
+			f.SetRequire([]module.Version{{Path: "g.h/i", Version: "v0.1.0"}, {Path: "d.e/f", Version: "v1.2.0"}, {Path: "b.c/d", Version: "v3.0.0"}})
+		},
+		"module x.y/z\n\nrequire (\n\tb.c/d v3.0.0\n\td.e/f v1.2.0 // why\n\tg.h/i v0.1.0\n)\n",
+	},
+	{
+		"set module",
+		"// x\nmodule x.y/z\n",
+		func(f *File) { f.AddModuleStmt("x.y/w") },
+		"// x\nmodule x.y/w\n",
+	},
+}
+
+func TestEdit(t *testing.T) {
+	for _, tt := range editTests {
+		f, err := Parse("go.mod", []byte(tt.in), nil)
+		if err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		tt.edit(f)
+		if out := string(f.Format()); out != tt.out {
+			t.Errorf("%s: Format:\n%s\nwant:\n%s", tt.desc, out, tt.out)
+			continue
+		}
+		// The edited file must read back to the same requirements.
+		f2, err := Parse("go.mod", []byte(tt.out), nil)
+		if err != nil {
+			t.Errorf("%s: reparse: %v", tt.desc, err)
+			continue
+		}
+		want := requires(f)
+		module.Sort(want)
+		checkMods(t, tt.desc, requires(f2), modString(want))
+	}
+}
+
+func requires(f *File) []module.Version {
+	var list []module.Version
+	for _, r := range f.Require {
+		list = append(list, r.Mod)
+	}
+	return list
+}
+
+func modString(list []module.Version) string {
+	var s []string
+	for _, m := range list {
+		s = append(s, m.String())
+	}
+	return strings.Join(s, " ")
+}
+
+func checkMods(t *testing.T, desc string, list []module.Version, want string) {
+	t.Helper()
+	module.Sort(list)
+	if got := modString(list); got != want {
+		t.Errorf("%s: got %s, want %s", desc, got, want)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package modget implements the module-aware ``go get'' command.
+package modget
+
+import (
+	"go/build"
+	"path/filepath"
+	"strings"
+
+	"cmd/go/internal/base"
+	"cmd/go/internal/modfile"
+	"cmd/go/internal/modload"
+	"cmd/go/internal/module"
+	"cmd/go/internal/semver"
+	"cmd/go/internal/work"
+)
+
+var CmdGet = &base.Command{
+	UsageLine: "get [-d] [-u] [build flags] [packages]",
+	Short:     "add dependencies to current module and install them",
+	Long: `
+Get resolves and adds dependencies to the current development module
+and then builds and installs them.
+
+The first step is to resolve which dependencies to add.
+
+For each named package or package pattern, get must decide which version of
+the corresponding module to use. By default, get chooses the latest tagged
+release version, such as v0.4.5 or v1.2.3. If there are no tagged release
+versions, get chooses the latest commit on the repository's default branch,
+named by a pseudo-version.
+
+This default version selection can be overridden by adding an @version
+suffix to the package argument, as in 'go get golang.org/x/text@v0.3.0'.
+For modules stored in source control repositories, the version suffix can
+also be a commit hash, branch identifier, or other syntax known to the
+source control system, as in 'go get golang.org/x/text@master'.
+The version suffix @latest explicitly requests the default behavior
+described above.
+
+If a module under consideration is already a dependency of the current
+development module, then get will update the required version.
+Specifying a version earlier than the current required version is valid and
+downgrades the dependency, although the build may still select a higher
+version if another module in the build requires it. The version suffix
+@none indicates that the dependency should be removed entirely.
+
+The -u flag instructs get to update the dependencies of the modules
+providing the named packages to use newer minor or patch releases when
+available. With no package arguments, -u updates all the dependencies
+of the main module.
+
+In general, adding a new dependency may require upgrading
+existing dependencies to keep a working build, and 'go get' does
+this automatically, by minimal version selection.
+
+The second step is to download (if needed), build, and install
+the named packages. With no package arguments, get installs the
+package in the current directory.
+
+The -d flag instructs get to download the source code needed to build
+the named packages, including downloading necessary dependencies,
+but not to build and install them.
+
+Get also accepts build flags to control the installation.
+See 'go help build'.
+
+For more about modules, see 'go help modules'.
+
+For more about specifying packages, see 'go help packages'.
+
+See also: go build, go install, go clean, go mod.
+	`,
+}
+
+// HelpModuleGet documents the module-aware get command, which
+// replaces CmdGet in the command list only when modules are enabled.
+var HelpModuleGet = &base.Command{
+	UsageLine: "module-get",
+	Short:     "module-aware go get",
+	Long: `
+The 'go get' command changes behavior depending on whether the
+go command is running in module-aware mode or legacy GOPATH mode.
+This help text, accessible as 'go help module-get' even in legacy GOPATH mode,
+describes 'go get' as it operates in module-aware mode.
+
+Usage: ` + CmdGet.UsageLine + `
+` + CmdGet.Long,
+}
+
+var (
+	getD = CmdGet.Flag.Bool("d", false, "")
+	getU = CmdGet.Flag.Bool("u", false, "")
+)
+
+func init() {
+	work.AddBuildFlags(CmdGet)
+	CmdGet.Run = runGet // break init loop
+}
+
+func runGet(cmd *base.Command, args []string) {
+	modload.LoadBuildList()
+	f := modload.ModFile()
+
+	var pkgs []string            // packages to install
+	var upgrade []module.Version // modules whose dependencies -u upgrades
+	for _, arg := range args {
+		path, vers := arg, ""
+		if i := strings.Index(arg, "@"); i >= 0 {
+			path, vers = arg[:i], arg[i+1:]
+		}
+		if build.IsLocalImport(path) || filepath.IsAbs(path) || strings.Contains(path, "...") {
+			if vers != "" {
+				base.Errorf("go get %s: cannot use version with local directory or pattern", arg)
+				continue
+			}
+			pkgs = append(pkgs, path)
+			continue
+		}
+		if vers == "none" {
+			f.DropRequire(path)
+			continue
+		}
+		if vers == "" {
+			vers = "latest"
+		}
+		m, err := modload.QueryPackage(path, vers, modload.Allowed)
+		if err != nil {
+			base.Errorf("go get %s: %v", arg, err)
+			continue
+		}
+		f.AddRequire(m.Path, m.Version)
+		upgrade = append(upgrade, m)
+		pkgs = append(pkgs, path)
+	}
+	base.ExitIfErrors()
+
+	if *getU {
+		if len(args) == 0 {
+			upgrade = []module.Version{modload.Target}
+		}
+		upgradeDeps(f, upgrade)
+		base.ExitIfErrors()
+	}
+
+	modload.ReloadBuildList()
+	modload.WriteGoMod()
+
+	if *getD {
+		return
+	}
+	if len(args) == 0 {
+		pkgs = []string{"."}
+	}
+	if len(pkgs) > 0 {
+		work.BuildInit()
+		work.InstallPackages(pkgs, true)
+	}
+}
+
+// upgradeDeps adds to f requirements of the latest versions of all the
+// modules required, directly or indirectly, by the modules in roots,
+// where those are newer than the versions already required.
+func upgradeDeps(f *modfile.File, roots []module.Version) {
+	reqs := modload.Reqs()
+	seen := make(map[module.Version]bool)
+	queue := append([]module.Version{}, roots...)
+	for len(queue) > 0 {
+		m := queue[0]
+		queue = queue[1:]
+		if seen[m] {
+			continue
+		}
+		seen[m] = true
+		required, err := reqs.Required(m)
+		if err != nil {
+			base.Errorf("go get: %v", err)
+			continue
+		}
+		for _, r := range required {
+			if r.Version == "none" || seen[r] {
+				continue
+			}
+			info, err := modload.Query(r.Path, "latest", modload.Allowed)
+			if err != nil {
+				base.Errorf("go get: upgrading %s: %v", r.Path, err)
+				continue
+			}
+			if semver.Compare(info.Version, r.Version) > 0 {
+				f.AddRequire(r.Path, info.Version)
+			}
+			queue = append(queue, r)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package modinfo defines the module information
+// reported by 'go list'.
+package modinfo
+
+import "time"
+
+// A ModulePublic describes a module in the build.
+//
+// Note: These fields are part of the go command's public API.
+// See list.go. It is okay to add fields, but not to change or
+// remove existing ones. Keep in sync with list.go.
+type ModulePublic struct {
+	Path    string        `json:",omitempty"` // module path
+	Version string        `json:",omitempty"` // module version
+	Replace *ModulePublic `json:",omitempty"` // replaced by this module
+	Time    *time.Time    `json:",omitempty"` // time version was created
+	Main    bool          `json:",omitempty"` // is this the main module?
+	Dir     string        `json:",omitempty"` // directory holding files for this module, if any
+	GoMod   string        `json:",omitempty"` // path to go.mod file for this module, if any
+	Error   *ModuleError  `json:",omitempty"` // error loading module
+}
+
+// A ModuleError describes an error loading information about a module.
+type ModuleError struct {
+	Err string // the error itself
+}
+
+func (m *ModulePublic) String() string {
+	s := m.Path
+	if m.Version != "" {
+		s += " " + m.Version
+	}
+	if m.Replace != nil {
+		s += " => " + m.Replace.Path
+		if m.Replace.Version != "" {
+			s += " " + m.Replace.Version
+		}
+	}
+	return s
+}
@@ -0,0 +1,338 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modload
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"cmd/go/internal/base"
+	"cmd/go/internal/cfg"
+	"cmd/go/internal/modfetch"
+	"cmd/go/internal/modfile"
+	"cmd/go/internal/modinfo"
+	"cmd/go/internal/module"
+	"cmd/go/internal/mvs"
+	"cmd/go/internal/semver"
+)
+
+// buildList is the list of modules in the build,
+// computed from the main module's go.mod by Minimal Version Selection.
+// The first element is the main module.
+var buildList []module.Version
+
+// LoadBuildList loads and returns the build list.
+func LoadBuildList() []module.Version {
+	InitMod()
+	if buildList == nil {
+		list, err := mvs.BuildList(Target, Reqs())
+		if err != nil {
+			base.Fatalf("go: %v", err)
+		}
+		buildList = list
+	}
+	return buildList
+}
+
+// SetBuildList sets the build list, for 'go get',
+// which chooses its own module versions.
+// The next call to WriteGoMod records it in go.mod.
+func SetBuildList(list []module.Version) {
+	buildList = append([]module.Version{}, list...)
+}
+
+// ReloadBuildList recomputes the build list from the
+// requirements in go.mod, after they have been edited.
+func ReloadBuildList() []module.Version {
+	buildList = nil
+	return LoadBuildList()
+}
+
+// ModFile returns the parsed main go.mod file, for editing.
+// Callers should call ReloadBuildList after changing requirements.
+func ModFile() *modfile.File {
+	InitMod()
+	return modFile
+}
+
+// minimalReqs returns the minimal requirement list for the main module
+// that yields the build list, keeping all the module paths in keep.
+func minimalReqs(keep []string) ([]module.Version, error) {
+	inList := make(map[string]bool)
+	for _, m := range buildList {
+		inList[m.Path] = true
+	}
+	var base []string
+	for _, path := range keep {
+		if inList[path] {
+			base = append(base, path)
+		}
+	}
+	return mvs.Req(Target, buildList, base, Reqs())
+}
+
+// Reqs returns the requirement graph for the build,
+// in which the main module's requirements come from its go.mod file,
+// and replace and exclude statements in that file apply.
+func Reqs() mvs.Reqs {
+	return &mvsReqs{}
+}
+
+type mvsReqs struct{}
+
+var reqsCache struct {
+	mu sync.Mutex
+	m  map[module.Version][]module.Version
+}
+
+func (r *mvsReqs) Required(mod module.Version) ([]module.Version, error) {
+	if mod == Target {
+		var list []module.Version
+		for _, r := range modFile.Require {
+			list = append(list, r.Mod)
+		}
+		return list, nil
+	}
+	if mod.Version == "none" {
+		return nil, nil
+	}
+
+	reqsCache.mu.Lock()
+	list, ok := reqsCache.m[mod]
+	reqsCache.mu.Unlock()
+	if ok {
+		return list, nil
+	}
+
+	data, err := goModData(mod)
+	if err != nil {
+		return nil, err
+	}
+	f, err := modfile.Parse("go.mod", data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("parsing go.mod for %v: %v", mod, err)
+	}
+	if f.Module != nil && f.Module.Mod.Path != mod.Path && Replacement(mod).Path == "" {
+		return nil, fmt.Errorf("parsing go.mod for %v: unexpected module path %q", mod, f.Module.Mod.Path)
+	}
+	for _, req := range f.Require {
+		m := req.Mod
+		if excluded[m] {
+			// Use the next version that is not excluded.
+			if m, err = nextAllowed(m); err != nil {
+				return nil, err
+			}
+		}
+		list = append(list, m)
+	}
+
+	reqsCache.mu.Lock()
+	if reqsCache.m == nil {
+		reqsCache.m = make(map[module.Version][]module.Version)
+	}
+	reqsCache.m[mod] = list
+	reqsCache.mu.Unlock()
+	return list, nil
+}
+
+// Max returns the maximum of v1 and v2 according to semver.Compare.
+// The main module's version is the empty string, which sorts
+// above all versions.
+func (*mvsReqs) Max(v1, v2 string) string {
+	if v1 != "" && semver.Compare(v1, v2) == -1 {
+		return v2
+	}
+	return v1
+}
+
+// nextAllowed returns the lowest version of m's module above m.Version
+// that is not excluded by the main module.
+func nextAllowed(m module.Version) (module.Version, error) {
+	repo, err := modfetch.Lookup(m.Path)
+	if err != nil {
+		return module.Version{}, err
+	}
+	list, err := repo.Versions("")
+	if err != nil {
+		return module.Version{}, err
+	}
+	for _, v := range list {
+		next := module.Version{Path: m.Path, Version: v}
+		if semver.Compare(v, m.Version) > 0 && !excluded[next] {
+			return next, nil
+		}
+	}
+	return module.Version{}, fmt.Errorf("%v excluded by go.mod, and no later version available", m)
+}
+
+// goModData returns the go.mod file content for mod,
+// following any replacement in the main go.mod file.
+func goModData(mod module.Version) ([]byte, error) {
+	if repl := Replacement(mod); repl.Path != "" {
+		if repl.Version == "" {
+			data, err := ioutil.ReadFile(filepath.Join(replaceDir(repl.Path), "go.mod"))
+			if os.IsNotExist(err) {
+				// A replacement directory without go.mod has no requirements.
+				return []byte("module " + mod.Path + "\n"), nil
+			}
+			return data, err
+		}
+		mod = repl
+	}
+	repo, err := modfetch.Lookup(mod.Path)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GoMod(mod.Version)
+}
+
+// Replacement returns the replacement for mod, if any, from go.mod.
+// If there is no replacement for mod, Replacement returns
+// a module.Version with Path == "".
+func Replacement(mod module.Version) module.Version {
+	if modFile == nil {
+		return module.Version{}
+	}
+	var found *modfile.Replace
+	for _, r := range modFile.Replace {
+		if r.Old.Path == mod.Path && (r.Old.Version == "" || r.Old.Version == mod.Version) {
+			found = r // the last matching line wins
+		}
+	}
+	if found == nil {
+		return module.Version{}
+	}
+	return found.New
+}
+
+// replaceDir returns the directory named by a replacement
+// directory path, which is relative to the main module's root.
+func replaceDir(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(modRoot, path)
+}
+
+// ModuleDir returns the directory holding the files of mod,
+// downloading it into the module cache if needed.
+func ModuleDir(mod module.Version) (string, error) {
+	if mod == Target {
+		return modRoot, nil
+	}
+	if repl := Replacement(mod); repl.Path != "" {
+		if repl.Version == "" {
+			return replaceDir(repl.Path), nil
+		}
+		mod = repl
+	}
+	return modfetch.Download(mod)
+}
+
+// BinDir returns the directory for installing commands:
+// $GOBIN if set, and otherwise $GOPATH/bin.
+func BinDir() string {
+	if cfg.GOBIN != "" {
+		return cfg.GOBIN
+	}
+	return filepath.Join(gopath, "bin")
+}
+
+// PackageModuleInfo returns information about the module
+// providing the package with the given import path.
+func PackageModuleInfo(path string) *modinfo.ModulePublic {
+	mod, ok := pkgModule[path]
+	if !ok {
+		return nil
+	}
+	return moduleInfo(mod)
+}
+
+// moduleInfo returns information about the module mod,
+// which is in the build list.
+func moduleInfo(mod module.Version) *modinfo.ModulePublic {
+	if mod == Target {
+		return &modinfo.ModulePublic{
+			Path:  mod.Path,
+			Main:  true,
+			Dir:   modRoot,
+			GoMod: filepath.Join(modRoot, "go.mod"),
+		}
+	}
+
+	info := &modinfo.ModulePublic{
+		Path:    mod.Path,
+		Version: mod.Version,
+	}
+	if repl := Replacement(mod); repl.Path != "" {
+		info.Replace = &modinfo.ModulePublic{
+			Path:    repl.Path,
+			Version: repl.Version,
+		}
+		if repl.Version == "" {
+			info.Replace.Dir = replaceDir(repl.Path)
+			info.Replace.GoMod = filepath.Join(info.Replace.Dir, "go.mod")
+			info.Dir = info.Replace.Dir
+			info.GoMod = info.Replace.GoMod
+			return info
+		}
+		mod = repl
+	}
+	if repo, err := modfetch.Lookup(mod.Path); err == nil {
+		if rev, err := repo.Stat(mod.Version); err == nil && !rev.Time.IsZero() {
+			t := rev.Time
+			if info.Replace != nil {
+				info.Replace.Time = &t
+			} else {
+				info.Time = &t
+			}
+		}
+	}
+	if dir, err := modfetch.DownloadDir(mod); err == nil {
+		if _, err := os.Stat(dir); err == nil {
+			info.Dir = dir
+			if info.Replace != nil {
+				info.Replace.Dir = dir
+			}
+		}
+	}
+	if gomod, err := modfetch.CachePath(mod, "mod"); err == nil {
+		if _, err := os.Stat(gomod); err == nil {
+			info.GoMod = gomod
+			if info.Replace != nil {
+				info.Replace.GoMod = gomod
+			}
+		}
+	}
+	return info
+}
+
+// Sync updates the main module's requirements to match its imports,
+// for 'go mod -sync': it adds modules providing missing packages and
+// drops requirements of modules that no package in "all" uses.
+// If verbose is set, Sync reports the dropped modules.
+func Sync(verbose bool) {
+	AllPackages()
+	base.ExitIfErrors()
+
+	used := make(map[module.Version]bool)
+	for _, m := range pkgModule {
+		used[m] = true
+	}
+	var keep []module.Version
+	for _, m := range buildList[1:] {
+		if used[m] {
+			keep = append(keep, m)
+		} else if verbose {
+			fmt.Fprintf(os.Stderr, "unused %s\n", m.Path)
+		}
+	}
+	modFile.SetRequire(keep)
+	ReloadBuildList()
+	WriteGoMod()
+}
@@ -0,0 +1,174 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modload
+
+import "cmd/go/internal/base"
+
+var HelpModules = &base.Command{
+	UsageLine: "modules",
+	Short:     "modules, module versions, and more",
+	Long: `
+A module is a collection of related Go packages, versioned together
+as a single unit. Modules record precise dependency requirements
+and create reproducible builds, without GOPATH.
+
+Module support
+
+The go command is module-aware when the current directory, or one of
+its parents, holds a go.mod file and is outside GOPATH/src.
+The environment variable GO111MODULE changes that default:
+GO111MODULE=on makes the go command module-aware everywhere,
+and GO111MODULE=off disables module support entirely.
+In module-aware mode, GOPATH no longer defines the meaning of imports
+during a build, but it still stores downloaded dependencies
+(in GOPATH/pkg/mod) and installed commands (in GOPATH/bin,
+unless GOBIN is set).
+
+Defining a module
+
+A module is defined by a tree of Go source files with a go.mod file
+in the tree's root directory. The directory holding the go.mod file
+is the module root. The go.mod file declares the module path, which
+is the import path prefix for all the packages in the module,
+and the modules it requires, each at a minimum version:
+
+	module example.com/m
+
+	require (
+		golang.org/x/text v0.3.0
+		gopkg.in/yaml.v2 v2.1.0
+	)
+
+The module's root package is the one in the module root,
+imported as example.com/m, and its other packages are in
+subdirectories, such as example.com/m/sub in the directory sub.
+A subdirectory holding its own go.mod file starts a separate module.
+
+To start a new module, run 'go mod -init' in its root directory.
+The module path is taken from the directory's location in GOPATH
+or from an import comment in the package there; the -module flag
+sets it explicitly. See 'go help go.mod' for the file format.
+
+Module versions
+
+Modules are versioned using semantic version tags such as v1.2.3
+in their version control repositories. Tagged versions of a module
+in a repository subdirectory are prefixed by the subdirectory, as in
+sub/v1.2.3. Untagged commits are named by pseudo-versions of the form
+v0.0.0-yyyymmddhhmmss-abcdefabcdef, giving the UTC commit time and
+a prefix of the commit hash.
+
+Following semantic import versioning, a module at major version 2 or
+higher must include the major version in its path, as in example.com/m/v2,
+so that different major versions are different modules. The code for
+such a module is either at the repository path root, with a go.mod file
+declaring the /v2 path, or in the v2 subdirectory.
+
+Minimal version selection
+
+The set of modules providing packages to a build is the build list.
+It starts with the main module, the module holding the current
+directory, and adds the modules it requires, and the modules
+those modules require, and so on. When more than one version of a
+module is required, the build list holds only the highest of them:
+the minimum version that satisfies every requirement.
+The build list changes only when a go.mod file changes, never because
+a newer version of a dependency was published.
+
+The main module's go.mod file may also exclude specific module
+versions, which are then skipped in favor of the next higher version,
+and replace module versions with other modules or with local
+directories. Exclusions and replacements in the go.mod files of
+other modules are ignored.
+
+When a package being built imports a package that no module in the
+build list provides, the go command looks up the latest version of
+a module providing it and adds that module to go.mod.
+'go list -m all' prints the build list, and 'go mod -graph' prints
+the requirement graph behind it.
+
+Downloading and verifying modules
+
+The go command downloads modules into the module cache, in
+GOPATH/pkg/mod, where their files are made read-only.
+'go clean -modcache' removes the cache.
+
+By default, modules are downloaded directly from their version control
+repositories (only Git is supported). If GOPROXY is set to a URL,
+modules are instead fetched from that module proxy, which serves
+for each module path M the files M/@v/list (the versions of M, one
+per line), and M/@v/V.info, M/@v/V.mod, and M/@v/V.zip (the commit
+information, go.mod file, and file archive of version V). A file://
+URL names a directory tree in the same layout; the download cache,
+GOPATH/pkg/mod/cache/download, is such a tree.
+GOPROXY=off disallows downloading modules.
+
+The go.sum file, next to go.mod, holds the expected cryptographic
+checksums of the content of specific module versions and of their
+go.mod files:
+
+	golang.org/x/text v0.3.0 h1:g61tztE5qeGQ89tm6NTjjM9VPIm088od1l6aSorWRWg=
+	golang.org/x/text v0.3.0/go.mod h1:NqM8EUOU14njkJ3fqMW+pc6Ldnwhi/IjpwHt7yyuwOQ=
+
+Each time a dependency is used, its checksum is added to go.sum if
+missing, or else required to match the existing entry. A mismatch is
+reported as an error. Both go.mod and go.sum should be checked in
+to version control. 'go mod -verify' checks that the module cache
+still holds the files recorded in go.sum.
+
+Packages and patterns in module mode
+
+In module mode, the pattern "all" means the packages in the main module
+and all the packages they import, including in tests. A pattern with
+"..." matches packages in the main module and the other modules of the
+build list. Directories outside the main module cannot be named on the
+command line, and vendor directories are ignored, except in the
+standard library.
+	`,
+}
+
+var HelpGoMod = &base.Command{
+	UsageLine: "go.mod",
+	Short:     "the go.mod file",
+	Long: `
+A module version is defined by a tree of source files, with a go.mod
+file in its root. When the go command is run, it looks in the current
+directory and then successive parent directories to find the go.mod
+marking the root of the main (current) module.
+
+The go.mod file itself is line-oriented, with // comments but
+no /* */ comments. Each line holds a single directive, made up of a
+verb followed by arguments. For example:
+
+	module example.com/my/thing
+	require example.com/other/thing v1.0.2
+	require example.com/new/thing/v2 v2.3.4
+	exclude example.com/old/thing v1.2.3
+	replace example.com/bad/thing v1.4.5 => example.com/good/thing v1.4.5
+
+The verbs are module, to define the module path; require, to require
+a particular module at a given version or later; exclude, to exclude
+a particular module version from use; and replace, to replace a module
+version with a different module version or with a directory, such as
+../thing. A replace directive without a version on the left side
+applies to all versions of the module.
+
+The leading verb can be factored out of adjacent lines to create a block,
+like in Go imports:
+
+	require (
+		example.com/new/thing/v2 v2.3.4
+		example.com/old/thing v1.2.3
+	)
+
+The go.mod file is designed both to be edited directly and to be
+easily updated by tools. The go command updates go.mod automatically
+when it adds a module to satisfy an import, and 'go get' and 'go mod'
+edit it as well. In particular, versions such as branch names or
+commit hashes are rewritten to the corresponding semantic version
+or pseudo-version. Otherwise, edits preserve comments and formatting;
+'go mod -fmt' reformats the file.
+	`,
+}
@@ -0,0 +1,188 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modload
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"strings"
+
+	"cmd/go/internal/base"
+	"cmd/go/internal/cfg"
+	"cmd/go/internal/module"
+)
+
+// pkgModule maps the import paths of packages found by Lookup
+// to the modules providing them.
+var pkgModule = make(map[string]module.Version)
+
+// Lookup returns the directory holding the package with the given
+// import path. Standard library packages are found in GOROOT.
+// Other packages are found in the module in the build list whose path
+// is a prefix of the import path and that holds the package;
+// if no such module exists, Lookup adds the latest version of
+// a module providing the package to go.mod.
+func Lookup(path string) (dir string, err error) {
+	if isStandardImportPath(path) {
+		return filepath.Join(cfg.GOROOTsrc, path), nil
+	}
+	if err := module.CheckImportPath(path); err != nil {
+		return "", err
+	}
+
+	mod, dir, err := findPackage(path)
+	if err == errMissing {
+		var m module.Version
+		m, err = addModuleForPackage(path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(os.Stderr, "go: found %s in %s %s\n", path, m.Path, m.Version)
+		mod, dir, err = findPackage(path)
+	}
+	if err != nil {
+		return "", err
+	}
+	pkgModule[path] = mod
+	return dir, nil
+}
+
+var errMissing = fmt.Errorf("cannot find module providing package")
+
+// findPackage returns the module in the build list providing
+// the package with the given import path, and the package's directory.
+func findPackage(path string) (module.Version, string, error) {
+	var mods []module.Version
+	var dirs []string
+	for _, m := range LoadBuildList() {
+		if !maybeInModule(path, m.Path) {
+			continue
+		}
+		root, err := ModuleDir(m)
+		if err != nil {
+			return module.Version{}, "", err
+		}
+		if dir, ok := dirInModule(path, m.Path, root); ok {
+			mods = append(mods, m)
+			dirs = append(dirs, dir)
+		}
+	}
+	switch len(mods) {
+	case 0:
+		return module.Version{}, "", errMissing
+	case 1:
+		return mods[0], dirs[0], nil
+	}
+	var buf strings.Builder
+	for i, m := range mods {
+		fmt.Fprintf(&buf, "\n\t%s %s (%s)", m.Path, m.Version, dirs[i])
+	}
+	return module.Version{}, "", fmt.Errorf("ambiguous import: found %s in multiple modules:%s", path, buf.String())
+}
+
+// addModuleForPackage adds to go.mod the latest version of the module
+// providing the package with the given import path.
+func addModuleForPackage(path string) (module.Version, error) {
+	InitMod()
+	m, err := QueryPackage(path, "latest", Allowed)
+	if err != nil {
+		return module.Version{}, err
+	}
+	modFile.AddRequire(m.Path, m.Version)
+	ReloadBuildList()
+	WriteGoMod()
+	return m, nil
+}
+
+// Allowed reports whether m may be used in the build:
+// that is, whether the main module does not exclude it.
+func Allowed(m module.Version) bool {
+	return !excluded[m]
+}
+
+// maybeInModule reports whether, syntactically,
+// a package with the given import path could be in the module path mpath.
+func maybeInModule(path, mpath string) bool {
+	return path == mpath || len(path) > len(mpath) && path[len(mpath)] == '/' && path[:len(mpath)] == mpath
+}
+
+// dirInModule returns the directory that would hold the package with
+// the given import path in the module mpath rooted at mdir, and reports
+// whether that directory exists and holds Go files, and is not part
+// of a nested module.
+func dirInModule(path, mpath, mdir string) (dir string, ok bool) {
+	if path == mpath {
+		dir = mdir
+	} else {
+		dir = filepath.Join(mdir, filepath.FromSlash(path[len(mpath)+1:]))
+	}
+	if !hasGoFiles(dir) {
+		return dir, false
+	}
+	// A go.mod file below the module root starts a different module.
+	for d := dir; len(d) > len(mdir); d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return dir, false
+		}
+	}
+	return dir, true
+}
+
+// hasGoFiles reports whether dir holds any .go files.
+func hasGoFiles(dir string) bool {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, fi := range fis {
+		if !fi.IsDir() && strings.HasSuffix(fi.Name(), ".go") {
+			return true
+		}
+	}
+	return false
+}
+
+// isStandardImportPath reports whether path is in the standard library,
+// which is to say its first element has no dot.
+func isStandardImportPath(path string) bool {
+	i := strings.Index(path, "/")
+	if i < 0 {
+		i = len(path)
+	}
+	return !strings.Contains(path[:i], ".")
+}
+
+// DirImportPath returns the import path of the package in dir,
+// which must be in the main module or in GOROOT/src.
+func DirImportPath(dir string) (string, error) {
+	dir = filepath.Clean(dir)
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(base.Cwd, dir)
+	}
+	if rel, ok := relDir(cfg.GOROOTsrc, dir); ok && rel != "." {
+		return filepath.ToSlash(rel), nil
+	}
+	InitMod()
+	if rel, ok := relDir(modRoot, dir); ok {
+		if rel == "." {
+			return Target.Path, nil
+		}
+		return pathpkg.Join(Target.Path, filepath.ToSlash(rel)), nil
+	}
+	return "", fmt.Errorf("directory %s outside available modules", base.ShortPath(dir))
+}
+
+// relDir returns the path of dir relative to root,
+// if dir is root or inside it.
+func relDir(root, dir string) (string, bool) {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
@@ -0,0 +1,289 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package modload loads packages in module-aware mode, resolving
+// imports through the main module's go.mod file and the build list
+// computed from it, instead of through GOPATH and vendor directories.
+package modload
+
+import (
+	"bytes"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cmd/go/internal/base"
+	"cmd/go/internal/cfg"
+	"cmd/go/internal/load"
+	"cmd/go/internal/modfetch"
+	"cmd/go/internal/modfile"
+	"cmd/go/internal/module"
+	"cmd/go/internal/semver"
+)
+
+var (
+	// CmdModInit and CmdModModule are set by 'go mod -init' and
+	// 'go mod -module' before calling Init.
+	CmdModInit   bool
+	CmdModModule string
+
+	modRoot     string        // directory holding go.mod, or "" if none
+	modFile     *modfile.File // parsed go.mod file, after InitMod
+	modFileData []byte        // go.mod content last read or written
+	excluded    map[module.Version]bool
+	Target      module.Version // the main module
+
+	gopath string // first GOPATH entry
+)
+
+// Enabled reports whether modules are in use.
+func Enabled() bool {
+	return cfg.ModulesEnabled
+}
+
+// ModRoot returns the root directory of the main module,
+// or the empty string if there is none.
+func ModRoot() string {
+	return modRoot
+}
+
+// ModFilePath returns the name of the main module's go.mod file,
+// or the empty string if modules are not in use.
+func ModFilePath() string {
+	if modRoot == "" {
+		return ""
+	}
+	return filepath.Join(modRoot, "go.mod")
+}
+
+// Init determines whether the go command runs in module-aware mode,
+// according to $GO111MODULE and the location of the current directory,
+// and if so, installs the module hooks in package load.
+//
+// GO111MODULE=off disables modules. GO111MODULE=on enables them everywhere.
+// GO111MODULE=auto, or unset, enables them only outside GOPATH/src,
+// and only when the current directory or one of its parents holds go.mod.
+func Init() {
+	env := os.Getenv("GO111MODULE")
+	mustUseModules := false
+	switch env {
+	default:
+		base.Fatalf("go: unknown environment setting GO111MODULE=%s", env)
+	case "", "auto":
+		// Decided below.
+	case "on":
+		mustUseModules = true
+	case "off":
+		if CmdModInit || CmdModModule != "" {
+			base.Fatalf("go: modules disabled by GO111MODULE=off; see 'go help modules'")
+		}
+		return
+	}
+
+	cwd := base.Cwd
+	if CmdModInit {
+		modRoot = cwd
+	} else {
+		modRoot = findModuleRoot(cwd)
+		if !mustUseModules && (modRoot == "" || inGOPATH(cwd)) {
+			modRoot = ""
+			return
+		}
+	}
+
+	list := filepath.SplitList(cfg.BuildContext.GOPATH)
+	if len(list) == 0 || list[0] == "" {
+		base.Fatalf("go: GOPATH must be set to use modules; see 'go help modules'")
+	}
+	gopath = list[0]
+	if _, err := os.Stat(filepath.Join(gopath, "go.mod")); err == nil {
+		base.Fatalf("$GOPATH/go.mod exists but should not")
+	}
+
+	cfg.ModulesEnabled = true
+	modfetch.PkgMod = filepath.Join(gopath, "pkg/mod")
+	if modRoot != "" {
+		modfetch.GoSumFile = filepath.Join(modRoot, "go.sum")
+	}
+
+	load.ModBinDir = BinDir
+	load.ModLookup = Lookup
+	load.ModPackageModuleInfo = PackageModuleInfo
+	load.ModImportPaths = ImportPaths
+	load.ModDirImportPath = DirImportPath
+}
+
+// inGOPATH reports whether dir is inside a GOPATH src directory.
+func inGOPATH(dir string) bool {
+	for _, root := range filepath.SplitList(cfg.BuildContext.GOPATH) {
+		if root == "" {
+			continue
+		}
+		if rel, err := filepath.Rel(filepath.Join(root, "src"), dir); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// findModuleRoot returns the closest directory at or above dir
+// holding a go.mod file, or the empty string if there is none.
+func findModuleRoot(dir string) string {
+	dir = filepath.Clean(dir)
+	for {
+		if fi, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil && !fi.IsDir() {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// InitMod reads the main module's go.mod file, creating it first
+// for 'go mod -init'. It is a no-op after the first call.
+func InitMod() {
+	if modFile != nil {
+		return
+	}
+	if modRoot == "" {
+		base.Fatalf("go: cannot find main module; see 'go help modules'")
+	}
+	base.AtExit(modfetch.WriteGoSum)
+
+	gomod := filepath.Join(modRoot, "go.mod")
+	data, err := ioutil.ReadFile(gomod)
+	if err != nil {
+		if os.IsNotExist(err) && CmdModInit {
+			modInit()
+			return
+		}
+		base.Fatalf("go: %v", err)
+	}
+	if CmdModInit {
+		base.Fatalf("go: %s already exists", gomod)
+	}
+
+	fixed := false
+	f, err := modfile.Parse(gomod, data, func(path, vers string) (string, error) {
+		v, err := fixVersion(path, vers)
+		if v != vers {
+			fixed = true
+		}
+		return v, err
+	})
+	if err != nil {
+		base.Fatalf("go: errors parsing go.mod:\n%v", err)
+	}
+	modFile = f
+	modFileData = data
+	if f.Module == nil {
+		if CmdModModule == "" {
+			base.Fatalf("go: no module declaration in go.mod.\n\tRun 'go mod -module=M' to specify the module path.")
+		}
+		f.AddModuleStmt(CmdModModule)
+	}
+	if CmdModModule != "" {
+		if err := module.CheckPath(CmdModModule); err != nil {
+			base.Fatalf("go: invalid -module: %v", err)
+		}
+		f.AddModuleStmt(CmdModModule)
+	}
+	Target = f.Module.Mod
+	excluded = make(map[module.Version]bool)
+	for _, x := range f.Exclude {
+		excluded[x.Mod] = true
+	}
+	if fixed || CmdModModule != "" {
+		WriteGoMod()
+	}
+}
+
+// modInit creates a new go.mod file for 'go mod -init'.
+func modInit() {
+	path := CmdModModule
+	if path == "" {
+		var err error
+		if path, err = findModulePath(modRoot); err != nil {
+			base.Fatalf("go: %v", err)
+		}
+	}
+	if err := module.CheckPath(path); err != nil {
+		base.Fatalf("go: invalid module path %q: %v", path, err)
+	}
+	fmt.Fprintf(os.Stderr, "go: creating new go.mod: module %s\n", path)
+	modFile = new(modfile.File)
+	modFile.AddModuleStmt(path)
+	Target = modFile.Module.Mod
+	excluded = make(map[module.Version]bool)
+	WriteGoMod()
+}
+
+// findModulePath guesses the module path for the source in dir,
+// from its location in GOPATH or from an import comment.
+func findModulePath(dir string) (string, error) {
+	for _, root := range filepath.SplitList(cfg.BuildContext.GOPATH) {
+		if root == "" {
+			continue
+		}
+		src := filepath.Join(root, "src")
+		if rel, err := filepath.Rel(src, dir); err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel), nil
+		}
+	}
+	bp, err := cfg.BuildContext.ImportDir(dir, build.ImportComment)
+	if err == nil && bp.ImportComment != "" {
+		return bp.ImportComment, nil
+	}
+	return "", fmt.Errorf("cannot determine module path for source directory %s (outside GOPATH, no import comments)\n\tRun 'go mod -init -module=M' to specify the module path.", dir)
+}
+
+// fixVersion resolves a version in go.mod, such as a branch name,
+// that is not a canonical semantic version.
+func fixVersion(path, vers string) (string, error) {
+	if semver.IsValid(vers) && vers == semver.Canonical(vers) {
+		return vers, nil
+	}
+	info, err := Query(path, vers, nil)
+	if err != nil {
+		return "", err
+	}
+	return info.Version, nil
+}
+
+// WriteGoMod writes the go.mod file, if it has changed,
+// and then the go.sum file, if it has changed.
+// When the build list is loaded, the requirements in go.mod
+// are first set to the minimal list that yields the build list.
+func WriteGoMod() {
+	if modFile == nil {
+		return
+	}
+	if buildList != nil {
+		var keep []string
+		for _, r := range modFile.Require {
+			keep = append(keep, r.Mod.Path)
+		}
+		min, err := minimalReqs(keep)
+		if err != nil {
+			base.Fatalf("go: %v", err)
+		}
+		modFile.SetRequire(min)
+	}
+	modFile.Cleanup()
+
+	data := modFile.Format()
+	if !bytes.Equal(data, modFileData) {
+		if err := ioutil.WriteFile(filepath.Join(modRoot, "go.mod"), data, 0666); err != nil {
+			base.Fatalf("go: %v", err)
+		}
+		modFileData = data
+	}
+	modfetch.WriteGoSum()
+}
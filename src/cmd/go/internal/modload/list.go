@@ -0,0 +1,80 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modload
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"cmd/go/internal/load"
+	"cmd/go/internal/modinfo"
+	"cmd/go/internal/module"
+)
+
+// ListModules returns information about the modules named by args,
+// for 'go list -m'. With no arguments, it lists the main module.
+// The argument "all" means the whole build list; other arguments are
+// module paths or patterns matched against the paths in the build list,
+// or path@version queries, which need not be in the build list.
+func ListModules(args []string) []*modinfo.ModulePublic {
+	list := LoadBuildList()
+	if len(args) == 0 {
+		return []*modinfo.ModulePublic{moduleInfo(Target)}
+	}
+
+	var mods []*modinfo.ModulePublic
+	for _, arg := range args {
+		if i := strings.Index(arg, "@"); i >= 0 {
+			path, vers := arg[:i], arg[i+1:]
+			info, err := Query(path, vers, nil)
+			if err != nil {
+				mods = append(mods, &modinfo.ModulePublic{
+					Path:    path,
+					Version: vers,
+					Error:   &modinfo.ModuleError{Err: err.Error()},
+				})
+				continue
+			}
+			mods = append(mods, moduleInfo(module.Version{Path: path, Version: info.Version}))
+			continue
+		}
+		if arg == "all" {
+			for _, m := range list {
+				mods = append(mods, moduleInfo(m))
+			}
+			continue
+		}
+		if strings.Contains(arg, "...") {
+			match := load.MatchPattern(arg)
+			matched := false
+			for _, m := range list {
+				if match(m.Path) {
+					matched = true
+					mods = append(mods, moduleInfo(m))
+				}
+			}
+			if !matched {
+				fmt.Fprintf(os.Stderr, "warning: pattern %q matched no module dependencies\n", arg)
+			}
+			continue
+		}
+		found := false
+		for _, m := range list {
+			if m.Path == arg {
+				found = true
+				mods = append(mods, moduleInfo(m))
+				break
+			}
+		}
+		if !found {
+			mods = append(mods, &modinfo.ModulePublic{
+				Path:  arg,
+				Error: &modinfo.ModuleError{Err: "module " + arg + " is not a known dependency"},
+			})
+		}
+	}
+	return mods
+}
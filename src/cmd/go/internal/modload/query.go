@@ -0,0 +1,177 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modload
+
+import (
+	"fmt"
+	"os"
+	pathpkg "path"
+	"strings"
+
+	"cmd/go/internal/modfetch"
+	"cmd/go/internal/module"
+	"cmd/go/internal/semver"
+)
+
+// Query looks up a revision of the module with the given path
+// matching the query, which can be:
+//
+//	- the literal string "latest", denoting the latest tagged release,
+//	  or the latest revision on the default branch if there are none;
+//	- a full semantic version ("v1.2.3"), denoting that version;
+//	- a version prefix ("v1" or "v1.2"), denoting the latest release
+//	  with that prefix;
+//	- a comparison ("<v1.2.3", "<=v1.2.3", ">v1.2.3", or ">=v1.2.3"),
+//	  denoting the release closest to the target and satisfying
+//	  the comparison;
+//	- a revision identifier known to the underlying repository,
+//	  such as a branch name, tag, or commit hash prefix.
+//
+// Only the full semantic version and revision identifier forms
+// can denote prereleases or pseudo-versions. If allowed is non-nil,
+// Query ignores versions for which allowed returns false.
+func Query(path, query string, allowed func(module.Version) bool) (*modfetch.RevInfo, error) {
+	if allowed == nil {
+		allowed = func(module.Version) bool { return true }
+	}
+
+	// Parse query to detect parse errors before any network I/O.
+	var ok func(v string) bool
+	prefer := func(v, best string) bool { return semver.Compare(v, best) > 0 }
+	switch {
+	case query == "latest":
+		ok = func(string) bool { return true }
+
+	case strings.HasPrefix(query, "<="):
+		v := query[len("<="):]
+		if !semver.IsValid(v) {
+			return nil, badQuery(path, query)
+		}
+		ok = func(x string) bool { return semver.Compare(x, v) <= 0 }
+
+	case strings.HasPrefix(query, "<"):
+		v := query[len("<"):]
+		if !semver.IsValid(v) {
+			return nil, badQuery(path, query)
+		}
+		ok = func(x string) bool { return semver.Compare(x, v) < 0 }
+
+	case strings.HasPrefix(query, ">="):
+		v := query[len(">="):]
+		if !semver.IsValid(v) {
+			return nil, badQuery(path, query)
+		}
+		ok = func(x string) bool { return semver.Compare(x, v) >= 0 }
+		prefer = func(v, best string) bool { return semver.Compare(v, best) < 0 }
+
+	case strings.HasPrefix(query, ">"):
+		v := query[len(">"):]
+		if !semver.IsValid(v) {
+			return nil, badQuery(path, query)
+		}
+		ok = func(x string) bool { return semver.Compare(x, v) > 0 }
+		prefer = func(v, best string) bool { return semver.Compare(v, best) < 0 }
+
+	case semver.IsValid(query) && isSemverPrefix(query):
+		ok = func(x string) bool { return strings.HasPrefix(x, query+".") }
+
+	case strings.HasPrefix(query, "<") || strings.HasPrefix(query, ">"):
+		return nil, badQuery(path, query)
+	}
+
+	repo, err := modfetch.Lookup(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok == nil {
+		// A full version or a revision identifier.
+		if semver.IsValid(query) {
+			query = semver.Canonical(query)
+			if !allowed(module.Version{Path: path, Version: query}) {
+				return nil, fmt.Errorf("%s@%s excluded", path, query)
+			}
+		}
+		info, err := repo.Stat(query)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed(module.Version{Path: path, Version: info.Version}) {
+			return nil, fmt.Errorf("%s@%s excluded", path, info.Version)
+		}
+		return info, nil
+	}
+
+	versions, err := repo.Versions("")
+	if err != nil {
+		return nil, err
+	}
+	best := ""
+	for _, v := range versions {
+		if semver.Prerelease(v) != "" || !ok(v) || !allowed(module.Version{Path: path, Version: v}) {
+			continue
+		}
+		if best == "" || prefer(v, best) {
+			best = v
+		}
+	}
+	if best != "" {
+		return repo.Stat(best)
+	}
+	if query == "latest" {
+		// No tagged releases: use the default branch.
+		return repo.Latest()
+	}
+	return nil, fmt.Errorf("no matching versions for query %q", query)
+}
+
+// isSemverPrefix reports whether v is a semantic version prefix: v1 or v1.2 (not v1.2.3).
+// The caller is assumed to have checked that semver.IsValid(v) is true.
+func isSemverPrefix(v string) bool {
+	dots := 0
+	for i := 0; i < len(v); i++ {
+		switch v[i] {
+		case '-', '+':
+			return false
+		case '.':
+			dots++
+			if dots >= 2 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func badQuery(path, query string) error {
+	return fmt.Errorf("%s: invalid version query %q", path, query)
+}
+
+// QueryPackage looks up a revision of the module providing the package
+// with the given import path, trying module paths from the longest
+// prefix of the import path to the shortest, and returns the first
+// module version matching the query that holds the package.
+// The query and allowed arguments are as for Query.
+func QueryPackage(path, query string, allowed func(module.Version) bool) (module.Version, error) {
+	for p := path; p != "." && p != "/"; p = pathpkg.Dir(p) {
+		if module.CheckPath(p) != nil {
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "go: finding %s %s\n", p, query)
+		info, err := Query(p, query, allowed)
+		if err != nil {
+			continue
+		}
+		m := module.Version{Path: p, Version: info.Version}
+		root, err := ModuleDir(m)
+		if err != nil {
+			return module.Version{}, err
+		}
+		if _, ok := dirInModule(path, m.Path, root); ok {
+			return m, nil
+		}
+	}
+	return module.Version{}, fmt.Errorf("%v %s", errMissing, path)
+}
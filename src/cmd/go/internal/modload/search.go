@@ -0,0 +1,230 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modload
+
+import (
+	"fmt"
+	"go/build"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"cmd/go/internal/base"
+	"cmd/go/internal/cfg"
+	"cmd/go/internal/load"
+)
+
+// ImportPaths returns the import paths to use for the given command
+// line in module mode, expanding patterns:
+// "all" is the main module's packages and everything they import,
+// including in tests; "std" and "cmd" are the standard packages and
+// commands; and a pattern with "..." matches packages in the main
+// module, in GOROOT, and in the other modules in the build list.
+func ImportPaths(args []string) []string {
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+	var out []string
+	for _, a := range args {
+		if filepath.Separator == '\\' {
+			a = strings.Replace(a, `\`, `/`, -1)
+		}
+		if strings.HasPrefix(a, "./") || strings.HasPrefix(a, "../") || a == "." || a == ".." {
+			a = "./" + pathpkg.Clean(a)
+			if a == "./." {
+				a = "."
+			}
+		} else if !filepath.IsAbs(a) {
+			a = pathpkg.Clean(a)
+		}
+
+		var pkgs []string
+		switch {
+		case a == "all":
+			pkgs = AllPackages()
+		case a == "std" || a == "cmd":
+			pkgs = load.MatchPackages(a)
+		case !strings.Contains(a, "..."):
+			out = append(out, a)
+			continue
+		case build.IsLocalImport(a) || filepath.IsAbs(a):
+			pkgs = matchLocal(a)
+		default:
+			pkgs = matchPackages(a)
+		}
+		if len(pkgs) == 0 {
+			fmt.Fprintf(os.Stderr, "warning: %q matched no packages\n", a)
+		}
+		out = append(out, pkgs...)
+	}
+	return out
+}
+
+// matchLocal returns the import paths of the packages in the
+// directory tree matching the pattern a, which begins with ./ or ../
+// or is an absolute path. As in MatchPackagesInFS, the pattern is
+// matched against directory names, not import paths.
+func matchLocal(a string) []string {
+	i := strings.Index(a, "...")
+	dir, _ := pathpkg.Split(a[:i])
+	root := filepath.Clean(filepath.FromSlash(dir))
+	rootPath, err := DirImportPath(root)
+	if err != nil {
+		base.Errorf("go: %v", err)
+		return nil
+	}
+	prefix := ""
+	if strings.HasPrefix(a, "./") {
+		prefix = "./"
+	}
+	match := load.MatchPattern(a)
+	var pkgs []string
+	walkPackages(root, rootPath, func(string) bool { return true }, func(dir, path string) {
+		if match(prefix + filepath.ToSlash(dir)) {
+			pkgs = append(pkgs, path)
+		}
+	})
+	return pkgs
+}
+
+// matchPackages returns the import paths of the packages in GOROOT
+// and in the modules of the build list matching the pattern.
+func matchPackages(pattern string) []string {
+	match := load.MatchPattern(pattern)
+	treeCanMatch := load.TreeCanMatchPattern(pattern)
+	seen := make(map[string]bool)
+	var pkgs []string
+	add := func(dir, path string) {
+		if match(path) && !seen[path] {
+			seen[path] = true
+			pkgs = append(pkgs, path)
+		}
+	}
+
+	if isStandardImportPath(pattern) {
+		walkPackages(cfg.GOROOTsrc, "", treeCanMatch, add)
+		return pkgs
+	}
+
+	for _, mod := range LoadBuildList() {
+		if !treeCanMatch(mod.Path) {
+			continue
+		}
+		root, err := ModuleDir(mod)
+		if err != nil {
+			base.Errorf("go: %v", err)
+			continue
+		}
+		walkPackages(root, mod.Path, treeCanMatch, add)
+	}
+	return pkgs
+}
+
+// walkPackages calls found with the directory and import path of each
+// package in the directory tree rooted at root, whose import path is rootPath,
+// skipping subtrees for which treeCanMatch returns false.
+// Like MatchPackages, it ignores directories beginning with . or _,
+// testdata directories, and vendor directories, and it does not
+// descend into nested modules. If rootPath is empty, root is
+// taken to be GOROOT/src.
+func walkPackages(root, rootPath string, treeCanMatch func(string) bool, found func(dir, path string)) {
+	root = filepath.Clean(root)
+	filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || !fi.IsDir() {
+			return nil
+		}
+		name := rootPath
+		if path != root {
+			rel := filepath.ToSlash(path[len(root)+1:])
+			name = pathpkg.Join(rootPath, rel)
+			elem := fi.Name()
+			if strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, "_") || elem == "testdata" || elem == "vendor" {
+				return filepath.SkipDir
+			}
+			if rootPath == "" && rel == "builtin" {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+		if name != "" && !treeCanMatch(name) {
+			return filepath.SkipDir
+		}
+		if name != "" && hasGoFiles(path) {
+			found(path, name)
+		}
+		return nil
+	})
+}
+
+// AllPackages returns the packages matched by the "all" pattern:
+// the packages in the main module and, recursively, all the packages
+// they import, including imports from tests of main module packages.
+func AllPackages() []string {
+	LoadBuildList()
+	var queue []string
+	walkPackages(modRoot, Target.Path, func(string) bool { return true }, func(dir, path string) {
+		queue = append(queue, path)
+	})
+
+	inMain := make(map[string]bool)
+	for _, path := range queue {
+		inMain[path] = true
+	}
+	seen := make(map[string]bool)
+	for _, path := range queue {
+		seen[path] = true
+	}
+	var all []string
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+		all = append(all, path)
+
+		dir, err := Lookup(path)
+		if err != nil {
+			base.Errorf("go: %v", err)
+			continue
+		}
+		bp, err := cfg.BuildContext.ImportDir(dir, 0)
+		if err != nil {
+			if _, noGo := err.(*build.NoGoError); !noGo {
+				base.Errorf("go: %v", err)
+			}
+			continue
+		}
+		imports := bp.Imports
+		if inMain[path] {
+			imports = append(append(imports, bp.TestImports...), bp.XTestImports...)
+		}
+		for _, imp := range imports {
+			if imp == "C" || build.IsLocalImport(imp) {
+				continue
+			}
+			if bp.Goroot {
+				imp = stdVendored(imp)
+			}
+			if !seen[imp] {
+				seen[imp] = true
+				queue = append(queue, imp)
+			}
+		}
+	}
+	sort.Strings(all)
+	return all
+}
+
+// stdVendored returns the path under which a standard library package
+// finds path, which may be vendored in GOROOT/src/vendor.
+func stdVendored(path string) string {
+	vpath := "vendor/" + path
+	if fi, err := os.Stat(filepath.Join(cfg.GOROOTsrc, filepath.FromSlash(vpath))); err == nil && fi.IsDir() {
+		return vpath
+	}
+	return path
+}
@@ -0,0 +1,201 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mvs implements Minimal Version Selection.
+//
+// Each module version lists the minimum versions of the modules it
+// requires. The build list for a target module is the set of module
+// versions reachable from the target through those requirements, with
+// only the highest version of each module kept. It changes only when a
+// go.mod file changes, never because a newer version was published.
+package mvs
+
+import (
+	"fmt"
+	"sort"
+
+	"cmd/go/internal/module"
+)
+
+// A Reqs is the requirement graph on which Minimal Version Selection (MVS) operates.
+type Reqs interface {
+	// Required returns the module versions explicitly required by m itself.
+	// The caller must not modify the returned list.
+	Required(m module.Version) ([]module.Version, error)
+
+	// Max returns the maximum of v1 and v2 (it returns either v1 or v2).
+	//
+	// For all versions v, Max(v, "none") must be v,
+	// and for the target passed as the first argument to MVS functions,
+	// Max(target, v) must be target.
+	Max(v1, v2 string) string
+}
+
+// A MissingModuleError reports that the requirements of a module
+// version could not be loaded.
+type MissingModuleError struct {
+	Module module.Version
+	Err    error
+}
+
+func (e *MissingModuleError) Error() string {
+	return fmt.Sprintf("loading requirements of %v: %v", e.Module, e.Err)
+}
+
+// BuildList returns the build list for the target module.
+// The first element is the target itself, followed by the other
+// modules in the list sorted by path.
+func BuildList(target module.Version, reqs Reqs) ([]module.Version, error) {
+	// Explore the whole requirement graph, recording
+	// the highest version required of each module.
+	graph := map[module.Version][]module.Version{}
+	min := map[string]string{} // maps module path to minimum required version
+	queue := []module.Version{target}
+	graph[target] = nil
+	for len(queue) > 0 {
+		m := queue[0]
+		queue = queue[1:]
+		required, err := reqs.Required(m)
+		if err != nil {
+			return nil, &MissingModuleError{m, err}
+		}
+		graph[m] = required
+		if v, ok := min[m.Path]; !ok || reqs.Max(v, m.Version) != v {
+			min[m.Path] = m.Version
+		}
+		for _, r := range required {
+			if _, ok := graph[r]; ok || r.Version == "none" {
+				continue
+			}
+			graph[r] = nil
+			queue = append(queue, r)
+		}
+	}
+	if v := min[target.Path]; v != target.Version {
+		return nil, fmt.Errorf("mvs: target %v is required at higher version %s", target, v)
+	}
+
+	// Walk the graph again, following only the requirements
+	// of the selected version of each module.
+	list := []module.Version{target}
+	listed := map[string]bool{target.Path: true}
+	for i := 0; i < len(list); i++ {
+		for _, r := range graph[list[i]] {
+			if r.Version == "none" || listed[r.Path] {
+				continue
+			}
+			listed[r.Path] = true
+			list = append(list, module.Version{Path: r.Path, Version: min[r.Path]})
+		}
+	}
+	tail := list[1:]
+	sort.Slice(tail, func(i, j int) bool {
+		return tail[i].Path < tail[j].Path
+	})
+	return list, nil
+}
+
+// Req returns the minimal requirement list for the target module
+// that results in the given build list, with the constraint that all
+// module paths listed in base must appear in the returned list.
+func Req(target module.Version, list []module.Version, base []string, reqs Reqs) ([]module.Version, error) {
+	// Compute a postorder of the requirement graph of the build list,
+	// caching the requirements.
+	var postorder []module.Version
+	reqCache := map[module.Version][]module.Version{}
+	reqCache[target] = nil
+	var walk func(module.Version) error
+	walk = func(m module.Version) error {
+		if _, ok := reqCache[m]; ok {
+			return nil
+		}
+		required, err := reqs.Required(m)
+		if err != nil {
+			return &MissingModuleError{m, err}
+		}
+		reqCache[m] = required
+		for _, m1 := range required {
+			if m1.Version == "none" {
+				continue
+			}
+			if err := walk(m1); err != nil {
+				return err
+			}
+		}
+		postorder = append(postorder, m)
+		return nil
+	}
+	for _, m := range list {
+		if err := walk(m); err != nil {
+			return nil, err
+		}
+	}
+
+	// Walk modules in reverse postorder, adding only those
+	// not implied by the modules already added.
+	have := map[string]string{}
+	var mark func(module.Version)
+	mark = func(m module.Version) {
+		if m.Version == "none" {
+			return
+		}
+		if v, ok := have[m.Path]; ok && reqs.Max(m.Version, v) == v {
+			return
+		}
+		have[m.Path] = m.Version
+		for _, m1 := range reqCache[m] {
+			mark(m1)
+		}
+	}
+	max := map[string]string{}
+	for _, m := range list {
+		if v, ok := max[m.Path]; ok {
+			max[m.Path] = reqs.Max(m.Version, v)
+		} else {
+			max[m.Path] = m.Version
+		}
+	}
+	var min []module.Version
+	for _, path := range base {
+		m := module.Version{Path: path, Version: max[path]}
+		min = append(min, m)
+		mark(m)
+	}
+	for i := len(postorder) - 1; i >= 0; i-- {
+		m := postorder[i]
+		if max[m.Path] != m.Version {
+			// An older version, not in the build list.
+			continue
+		}
+		if have[m.Path] != m.Version {
+			min = append(min, m)
+			mark(m)
+		}
+	}
+	sort.Slice(min, func(i, j int) bool {
+		return min[i].Path < min[j].Path
+	})
+	return min, nil
+}
+
+// Graph returns the requirement edges among the modules in the
+// build list, as "from to" pairs of module versions in the
+// "path@version" form, sorted.
+func Graph(list []module.Version, reqs Reqs) ([]string, error) {
+	var edges []string
+	for _, m := range list {
+		required, err := reqs.Required(m)
+		if err != nil {
+			return nil, &MissingModuleError{m, err}
+		}
+		for _, r := range required {
+			if r.Version == "none" {
+				continue
+			}
+			edges = append(edges, m.String()+" "+r.String())
+		}
+	}
+	sort.Strings(edges)
+	return edges, nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mvs
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cmd/go/internal/module"
+)
+
+// The tests are written as a small language describing requirement graphs
+// and the results expected from them. Each test is a group of lines
+// starting with a name: line. Modules are named by a single letter and
+// versions are numbers, so that "A1" is module A at version 1.
+//
+//	A: B1 C2    A (the target) requires B1 and C2
+//	B1: D3      B1 requires D3
+//	build A: A B1 C2 D4    the build list for A is A B1 C2 D4
+//	req A: B1 C2           the minimal requirement list for A's build list
+//	req A D: B1 C2 D4      the same, keeping D
+var tests = `
+name: blog
+A: B1 C2
+B1: D3
+C1: D2
+C2: D4
+C3: D5
+C4: G1
+D2: E1
+D3: E2
+D4: E2 F1
+D5: E2
+E1:
+E2:
+F1:
+G1: C4
+A2: B1 C4 D4
+build A: A B1 C2 D4 E2 F1
+build A2: A2 B1 C4 D4 E2 F1 G1
+req A: B1 C2
+req A2: B1 C4 D4
+
+name: trim
+A: B1 C2
+B1: D3
+C2: B2
+B2:
+D3:
+build A: A B2 C2
+req A: C2
+req A B: B2 C2
+
+name: cross
+A: B1 C1
+B1: D1
+B2: D2
+C1: B2
+D1:
+D2:
+build A: A B2 C1 D2
+req A: C1
+
+name: cycle
+A: B1
+B1: A1
+A1: C1
+C1:
+build A: A B1
+req A: B1
+
+name: none
+A: B1 C1
+B1: D1
+C1: Dnone
+D1:
+build A: A B1 C1 D1
+req A: B1 C1
+`
+
+type reqsMap map[module.Version][]module.Version
+
+func (r reqsMap) Max(v1, v2 string) string {
+	if v1 == "none" || v2 == "" {
+		return v2
+	}
+	if v2 == "none" || v1 == "" {
+		return v1
+	}
+	if v1 < v2 {
+		return v2
+	}
+	return v1
+}
+
+func (r reqsMap) Required(m module.Version) ([]module.Version, error) {
+	rr, ok := r[m]
+	if !ok {
+		return nil, fmt.Errorf("missing module: %v", m)
+	}
+	return rr, nil
+}
+
+func m(s string) module.Version {
+	if s == "" {
+		return module.Version{}
+	}
+	if len(s) == 1 {
+		return module.Version{Path: s}
+	}
+	return module.Version{Path: s[:1], Version: "v" + s[1:]}
+}
+
+func ms(list []string) []module.Version {
+	var mlist []module.Version
+	for _, s := range list {
+		if strings.HasSuffix(s, "none") {
+			mlist = append(mlist, module.Version{Path: s[:1], Version: "none"})
+			continue
+		}
+		mlist = append(mlist, m(s))
+	}
+	return mlist
+}
+
+func TestMVS(t *testing.T) {
+	var name string
+	var reqs reqsMap
+	for i, line := range strings.Split(tests, "\n") {
+		lineno := i + 1
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		i := strings.Index(line, ":")
+		if i < 0 {
+			t.Fatalf("line %d: missing colon", lineno)
+		}
+		key, val := strings.TrimSpace(line[:i]), strings.Fields(line[i+1:])
+		kf := strings.Fields(key)
+		switch {
+		case key == "name":
+			name = val[0]
+			reqs = make(reqsMap)
+		case len(kf) == 1:
+			reqs[m(key)] = ms(val)
+		case kf[0] == "build":
+			list, err := BuildList(m(kf[1]), reqs)
+			if err != nil {
+				t.Errorf("%s: line %d: BuildList: %v", name, lineno, err)
+				continue
+			}
+			if want := ms(val); !reflect.DeepEqual(list, want) {
+				t.Errorf("%s: line %d: BuildList = %v, want %v", name, lineno, list, want)
+			}
+		case kf[0] == "req":
+			list, err := BuildList(m(kf[1]), reqs)
+			if err != nil {
+				t.Errorf("%s: line %d: BuildList: %v", name, lineno, err)
+				continue
+			}
+			min, err := Req(m(kf[1]), list, kf[2:], reqs)
+			if err != nil {
+				t.Errorf("%s: line %d: Req: %v", name, lineno, err)
+				continue
+			}
+			if want := ms(val); !reflect.DeepEqual(min, want) {
+				t.Errorf("%s: line %d: Req = %v, want %v", name, lineno, min, want)
+			}
+		default:
+			t.Fatalf("line %d: unknown key %q", lineno, key)
+		}
+	}
+}
+
+func TestMissingModule(t *testing.T) {
+	reqs := reqsMap{
+		m("A"):  ms([]string{"B1"}),
+		m("B1"): ms([]string{"C1"}),
+	}
+	_, err := BuildList(m("A"), reqs)
+	merr, ok := err.(*MissingModuleError)
+	if !ok || merr.Module != m("C1") {
+		t.Errorf("BuildList with missing module: error %v, want MissingModuleError for C@v1", err)
+	}
+}
+
+func TestGraph(t *testing.T) {
+	reqs := reqsMap{
+		m("A"):  ms([]string{"B1", "C1"}),
+		m("B1"): ms([]string{"C1"}),
+		m("C1"): nil,
+	}
+	list, err := BuildList(m("A"), reqs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edges, err := Graph(list, reqs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"A B@v1", "A C@v1", "B@v1 C@v1"}
+	if !reflect.DeepEqual(edges, want) {
+		t.Errorf("Graph = %q, want %q", edges, want)
+	}
+}
@@ -63,8 +63,8 @@ followed by detailed output for each failed package.
 
 'Go test' recompiles each package along with any files with names matching
 the file pattern "*_test.go".
-These additional files can contain test functions, benchmark functions, and
-example functions. See 'go help testfunc' for more.
+These additional files can contain test functions, benchmark functions,
+fuzz targets, and example functions. See 'go help testfunc' for more.
 Each listed package causes the execution of a separate test binary.
 Files whose names begin with "_" (including "_test.go") or "." are ignored.
 
@@ -253,6 +253,26 @@ const testFlag2 = `
 	-failfast
 	    Do not start new tests after the first test failure.
 
+	-fuzz regexp
+	    Fuzz the fuzz target matching the regular expression, after
+	    running the tests. The regular expression must match exactly one
+	    fuzz target, and the flag can only be used with a single package.
+	    The package under test (or the packages selected by -coverpkg) is
+	    instrumented for coverage, which guides the generation of inputs.
+	    A failing input is minimized and written to testdata/fuzz/FuzzXxx
+	    in the package directory. Inputs that reach new coverage are kept
+	    in the build cache for the next run. Unless -timeout is given,
+	    the test binary is not timed out. See 'go help testfunc'.
+
+	-fuzzminimizetime t
+	    Spend at most t minimizing a failing input found by -fuzz.
+	    The default is 60 seconds (60s). 0 disables minimization.
+
+	-fuzztime t
+	    Fuzz for at most t, specified as a time.Duration (for example,
+	    -fuzztime 1h30s). The default is to fuzz until a failing input
+	    is found or the go command is interrupted.
+
 	-list regexp
 	    List tests, benchmarks, or examples matching the regular expression.
 	    No tests, benchmarks or examples will be run. This will only
@@ -416,8 +436,8 @@ var HelpTestfunc = &base.Command{
 	UsageLine: "testfunc",
 	Short:     "testing functions",
 	Long: `
-The 'go test' command expects to find test, benchmark, and example functions
-in the "*_test.go" files corresponding to the package under test.
+The 'go test' command expects to find test, benchmark, fuzz target, and example
+functions in the "*_test.go" files corresponding to the package under test.
 
 A test function is one named TestXxx (where Xxx does not start with a
 lower case letter) and should have the signature,
@@ -428,6 +448,14 @@ A benchmark function is one named BenchmarkXxx and should have the signature,
 
 	func BenchmarkXxx(b *testing.B) { ... }
 
+A fuzz target is one named FuzzXxx and should have the signature,
+
+	func FuzzXxx(f *testing.F) { ... }
+
+A fuzz target runs as a test of its seed inputs and of the inputs stored in
+testdata/fuzz/FuzzXxx; with the -fuzz flag, go test also generates new inputs.
+See the documentation of the testing package for more.
+
 An example function is similar to a test function but, instead of using
 *testing.T to report success or failure, prints output to os.Stdout.
 If the last comment in the function starts with "Output:" then the output
@@ -480,6 +508,7 @@ var (
 	testCoverPaths   []string        // -coverpkg flag
 	testCoverPkgs    []*load.Package // -coverpkg flag
 	testCoverProfile string          // -coverprofile flag
+	testFuzz         string          // -fuzz flag
 	testOutputDir    string          // -outputdir flag
 	testO            string          // -o flag
 	testProfile      string          // profiling flag that limits test to one package
@@ -506,6 +535,10 @@ var testMainDeps = []string{
 	"testing/internal/testdeps",
 }
 
+// testMainFuzzDep is the additional dependency of testmain with -fuzz,
+// which lets an interrupt stop fuzzing gracefully.
+const testMainFuzzDep = "testing/internal/testdeps/interrupt"
+
 // testVetFlags is the list of flags to pass to vet when invoked automatically during go test.
 var testVetFlags = []string{
 	// TODO(rsc): Decide which tests are enabled by default.
@@ -554,6 +587,9 @@ func runTest(cmd *base.Command, args []string) {
 	if testProfile != "" && len(pkgs) != 1 {
 		base.Fatalf("cannot use %s flag with multiple packages", testProfile)
 	}
+	if testFuzz != "" && len(pkgs) != 1 {
+		base.Fatalf("cannot use -fuzz flag with multiple packages")
+	}
 	initCoverProfile()
 	defer closeCoverProfile()
 
@@ -567,6 +603,10 @@ func runTest(cmd *base.Command, args []string) {
 		// An explicit zero disables the test timeout.
 		// Let it have one century (almost) before we kill it.
 		testKillTimeout = 100 * 365 * 24 * time.Hour
+	} else if testTimeout == "" && testFuzz != "" {
+		// Fuzzing runs until it finds a failure or -fuzztime expires,
+		// so there is no default timeout either.
+		testKillTimeout = 100 * 365 * 24 * time.Hour
 	}
 
 	// show passing test output (after buffering) with -v flag.
@@ -600,6 +640,9 @@ func runTest(cmd *base.Command, args []string) {
 		for _, dep := range testMainDeps {
 			deps[dep] = true
 		}
+		if testFuzz != "" {
+			deps[testMainFuzzDep] = true
+		}
 
 		for _, p := range pkgs {
 			// Dependencies for each test.
@@ -709,7 +752,7 @@ func runTest(cmd *base.Command, args []string) {
 	// Prepare build + run + print actions for all packages being tested.
 	for _, p := range pkgs {
 		// sync/atomic import is inserted by the cover tool. See #18486
-		if testCover && testCoverMode == "atomic" {
+		if (testCover || testFuzz != "") && testCoverMode == "atomic" {
 			ensureImport(p, "sync/atomic")
 		}
 
@@ -798,7 +841,9 @@ func builderTest(b *work.Builder, p *load.Package) (buildAction, runAction, prin
 	//	pmain - pkg.test binary
 	var ptest, pxtest, pmain *load.Package
 
-	localCover := testCover && testCoverPaths == nil
+	// Fuzzing is guided by the coverage counters of the package under test,
+	// or of the packages selected with -coverpkg.
+	localCover := (testCover || testFuzz != "") && testCoverPaths == nil
 
 	ptest, pxtest, err = load.TestPackagesFor(p, localCover || p.Name == "main")
 	if err != nil {
@@ -819,7 +864,7 @@ func builderTest(b *work.Builder, p *load.Package) (buildAction, runAction, prin
 
 	// Should we apply coverage analysis locally,
 	// only for this package and only for this test?
-	// Yes, if -cover is on but -coverpkg has not specified
+	// Yes, if -cover or -fuzz is on but -coverpkg has not specified
 	// a list of packages for global coverage.
 	if localCover {
 		ptest.Internal.CoverMode = testCoverMode
@@ -859,6 +904,9 @@ func builderTest(b *work.Builder, p *load.Package) (buildAction, runAction, prin
 	var stk load.ImportStack
 	stk.Push("testmain")
 	deps := testMainDeps // cap==len, so safe for append
+	if testFuzz != "" {
+		deps = append(deps, testMainFuzzDep)
+	}
 	for _, d := range load.LinkerDeps(p) {
 		deps = append(deps, d)
 	}
@@ -1167,10 +1215,10 @@ func (c *runCache) builderRunTest(b *work.Builder, a *work.Action) error {
 	}
 
 	var buf bytes.Buffer
-	if len(pkgArgs) == 0 || testBench {
+	if len(pkgArgs) == 0 || testBench || testFuzz != "" {
 		// Stream test output (no buffering) when no package has
 		// been given on the command line (implicit current directory)
-		// or when benchmarking.
+		// or when benchmarking or fuzzing.
 		// No change to stdout.
 	} else {
 		// If we're only running a single package under test or if parallelism is
@@ -1222,7 +1270,15 @@ func (c *runCache) builderRunTest(b *work.Builder, a *work.Action) error {
 	if !c.disableCache && len(execCmd) == 0 {
 		testlogArg = []string{"-test.testlogfile=" + a.Objdir + "testlog.txt"}
 	}
-	args := str.StringList(execCmd, a.Deps[0].BuiltTarget(), testlogArg, testArgs)
+	fuzzArg := []string{}
+	if testFuzz != "" {
+		// Keep the inputs that the fuzzer finds interesting
+		// for the next run, in a directory of the build cache.
+		if dir := cache.DefaultDir(); dir != "off" {
+			fuzzArg = []string{"-test.fuzzcachedir=" + filepath.Join(dir, "fuzz", a.Package.ImportPath)}
+		}
+	}
+	args := str.StringList(execCmd, a.Deps[0].BuiltTarget(), testlogArg, fuzzArg, testArgs)
 
 	if testCoverProfile != "" {
 		// Write coverage to temporary profile, for merging later.
@@ -1743,7 +1799,7 @@ func builderNoTest(b *work.Builder, a *work.Action) error {
 }
 
 // isTestFunc tells whether fn has the type of a testing function. arg
-// specifies the parameter type we look for: B, M or T.
+// specifies the parameter type we look for: B, F, M or T.
 func isTestFunc(fn *ast.FuncDecl, arg string) bool {
 	if fn.Type.Results != nil && len(fn.Type.Results.List) > 0 ||
 		fn.Type.Params.List == nil ||
@@ -1758,7 +1814,7 @@ func isTestFunc(fn *ast.FuncDecl, arg string) bool {
 	// We can't easily check that the type is *testing.M
 	// because we don't know how testing has been imported,
 	// but at least check that it's *M or *something.M.
-	// Same applies for B and T.
+	// Same applies for B, F and T.
 	if name, ok := ptr.X.(*ast.Ident); ok && name.Name == arg {
 		return true
 	}
@@ -1768,7 +1824,7 @@ func isTestFunc(fn *ast.FuncDecl, arg string) bool {
 	return false
 }
 
-// isTest tells whether name looks like a test (or benchmark, according to prefix).
+// isTest tells whether name looks like a test (or benchmark or fuzz target, according to prefix).
 // It is a Test (say) if there is a character after Test that is not a lower-case letter.
 // We don't want TesticularCancer.
 func isTest(name, prefix string) bool {
@@ -1823,6 +1879,7 @@ func writeTestmain(out string, t *testFuncs) error {
 type testFuncs struct {
 	Tests       []testFunc
 	Benchmarks  []testFunc
+	FuzzTargets []testFunc
 	Examples    []testFunc
 	TestMain    *testFunc
 	Package     *load.Package
@@ -1833,12 +1890,23 @@ type testFuncs struct {
 	Cover       []coverInfo
 }
 
+// CoverMode returns the coverage mode to report to the testing package.
+// It is empty when the package is instrumented for -fuzz only, so that
+// the test binary does not report coverage.
 func (t *testFuncs) CoverMode() string {
+	if !testCover {
+		return ""
+	}
 	return testCoverMode
 }
 
 func (t *testFuncs) CoverEnabled() bool {
-	return testCover
+	return testCover || testFuzz != ""
+}
+
+// Fuzzing reports whether the test binary is built for -fuzz.
+func (t *testFuncs) Fuzzing() bool {
+	return testFuzz != ""
 }
 
 // ImportPath returns the import path of the package being tested, if it is within GOPATH.
@@ -1923,6 +1991,13 @@ func (t *testFuncs) load(filename, pkg string, doImport, seen *bool) error {
 			}
 			t.Benchmarks = append(t.Benchmarks, testFunc{pkg, name, "", false})
 			*doImport, *seen = true, true
+		case isTest(name, "Fuzz"):
+			err := checkTestFunc(n, "F")
+			if err != nil {
+				return err
+			}
+			t.FuzzTargets = append(t.FuzzTargets, testFunc{pkg, name, "", false})
+			*doImport, *seen = true, true
 		}
 	}
 	ex := doc.Examples(f)
@@ -1957,6 +2032,9 @@ import (
 {{end}}
 	"testing"
 	"testing/internal/testdeps"
+{{if .Fuzzing}}
+	_ "testing/internal/testdeps/interrupt"
+{{end}}
 
 {{if .ImportTest}}
 	{{if .NeedTest}}_test{{else}}_{{end}} {{.Package.ImportPath | printf "%q"}}
@@ -1981,6 +2059,12 @@ var benchmarks = []testing.InternalBenchmark{
 {{end}}
 }
 
+var fuzzTargets = []testing.InternalFuzzTarget{
+{{range .FuzzTargets}}
+	{"{{.Name}}", {{.Package}}.{{.Name}}},
+{{end}}
+}
+
 var examples = []testing.InternalExample{
 {{range .Examples}}
 	{"{{.Name}}", {{.Package}}.{{.Name}}, {{.Output | printf "%q"}}, {{.Unordered}}},
@@ -2039,7 +2123,7 @@ func main() {
 		CoveredPackages: {{printf "%q" .Covered}},
 	})
 {{end}}
-	m := testing.MainStart(testdeps.TestDeps{}, tests, benchmarks, examples)
+	m := testing.MainStart(testdeps.TestDeps{}, tests, benchmarks, fuzzTargets, examples)
 {{with .TestMain}}
 	{{.Package}}.{{.Name}}(m)
 {{else}}
@@ -423,6 +423,10 @@ func InstallPackages(args []string, forGet bool) {
 	for _, p := range pkgs {
 		if p.Target == "" && (!p.Standard || p.ImportPath != "unsafe") {
 			switch {
+			case p.Module != nil && p.Name != "main":
+				// Packages in modules are kept in the build cache
+				// rather than installed; there is nothing more to do.
+				continue
 			case p.Internal.GobinSubdir:
 				base.Errorf("go %s: cannot install cross-compiled binaries when GOBIN is set", cfg.CmdName)
 			case p.Internal.CmdlineFiles:
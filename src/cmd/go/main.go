@@ -27,6 +27,9 @@ import (
 	"cmd/go/internal/get"
 	"cmd/go/internal/help"
 	"cmd/go/internal/list"
+	"cmd/go/internal/modcmd"
+	"cmd/go/internal/modget"
+	"cmd/go/internal/modload"
 	"cmd/go/internal/run"
 	"cmd/go/internal/test"
 	"cmd/go/internal/tool"
@@ -48,6 +51,7 @@ func init() {
 		get.CmdGet,
 		work.CmdInstall,
 		list.CmdList,
+		modcmd.CmdMod,
 		run.CmdRun,
 		test.CmdTest,
 		tool.CmdTool,
@@ -58,9 +62,12 @@ func init() {
 		help.HelpBuildmode,
 		help.HelpCache,
 		help.HelpFileType,
+		modload.HelpGoMod,
 		help.HelpGopath,
 		help.HelpEnvironment,
 		help.HelpImportPath,
+		modload.HelpModules,
+		modget.HelpModuleGet,
 		help.HelpPackages,
 		test.HelpTestflag,
 		test.HelpTestfunc,
@@ -115,6 +122,17 @@ func main() {
 		os.Exit(2)
 	}
 
+	// Decide whether to use modules, and if so,
+	// replace get with the module-aware get.
+	modload.Init()
+	if modload.Enabled() {
+		for i, cmd := range base.Commands {
+			if cmd == get.CmdGet {
+				base.Commands[i] = modget.CmdGet
+			}
+		}
+	}
+
 	// Set environment (GOOS, GOARCH, etc) explicitly.
 	// In theory all the commands we invoke should have
 	// the same default computation of these as we do,
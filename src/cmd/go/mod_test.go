@@ -0,0 +1,217 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Tests for module-aware mode.
+
+package main_test
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// modFiles are the files of one module version served by the test proxy.
+type modFiles map[string]string
+
+// testModules are the module versions served by the test proxy.
+var testModules = map[string]modFiles{
+	"example.com/hello v1.0.0": {
+		"go.mod":   "module example.com/hello\n",
+		"hello.go": "package hello\n\nfunc Hello() string { return \"hello, v1.0.0\" }\n",
+	},
+	"example.com/hello v1.1.0": {
+		"go.mod":   "module example.com/hello\n",
+		"hello.go": "package hello\n\nfunc Hello() string { return \"hello, v1.1.0\" }\n",
+	},
+	"example.com/greet v1.0.0": {
+		"go.mod":   "module example.com/greet\n\nrequire example.com/hello v1.1.0\n",
+		"greet.go": "package greet\n\nimport \"example.com/hello\"\n\nfunc Greet() string { return hello.Hello() }\n",
+	},
+}
+
+// modTestgo returns a testgoData set up for module-aware mode:
+// GOPATH is a fresh temporary directory, and GOPROXY is a file://
+// module proxy serving testModules. The main module directory,
+// "work", is outside GOPATH and is the current directory.
+func modTestgo(t *testing.T) *testgoData {
+	tg := testgo(t)
+	tg.makeTempdir()
+	proxy := tg.path("proxy")
+	for key, files := range testModules {
+		f := strings.Fields(key)
+		writeProxyModule(tg, proxy, f[0], f[1], files)
+	}
+	tg.setenv("GO111MODULE", "auto")
+	tg.setenv("GOPATH", tg.path("gopath"))
+	tg.setenv("GOPROXY", "file://"+filepath.ToSlash(proxy))
+	tg.tempDir("work")
+	tg.cd(tg.path("work"))
+	return tg
+}
+
+// writeProxyModule writes the module path at version, with the given files,
+// to the module proxy tree rooted at dir.
+func writeProxyModule(tg *testgoData, dir, path, version string, files modFiles) {
+	tg.t.Helper()
+	vdir := filepath.Join(dir, filepath.FromSlash(path), "@v")
+	tg.must(os.MkdirAll(vdir, 0777))
+
+	list, _ := ioutil.ReadFile(filepath.Join(vdir, "list"))
+	list = append(list, version+"\n"...)
+	tg.must(ioutil.WriteFile(filepath.Join(vdir, "list"), list, 0666))
+	tg.must(ioutil.WriteFile(filepath.Join(vdir, version+".info"), []byte(`{"Version":"`+version+`","Time":"2018-02-14T00:00:00Z"}`), 0666))
+	tg.must(ioutil.WriteFile(filepath.Join(vdir, version+".mod"), []byte(files["go.mod"]), 0666))
+
+	zf, err := os.Create(filepath.Join(vdir, version+".zip"))
+	tg.must(err)
+	z := zip.NewWriter(zf)
+	for name, data := range files {
+		w, err := z.Create(path + "@" + version + "/" + name)
+		tg.must(err)
+		_, err = w.Write([]byte(data))
+		tg.must(err)
+	}
+	tg.must(z.Close())
+	tg.must(zf.Close())
+}
+
+func TestModBuildOutsideGOPATH(t *testing.T) {
+	tg := modTestgo(t)
+	defer tg.cleanup()
+	defer tg.run("clean", "-modcache")
+
+	tg.run("mod", "-init", "-module=example.com/work")
+	tg.grepStderr("creating new go.mod: module example.com/work", "did not create go.mod")
+	tg.tempFile("work/main.go", `package main
+
+		import (
+			"fmt"
+
+			"example.com/hello"
+		)
+
+		func main() { fmt.Println(hello.Hello()) }
+	`)
+	tg.tempFile("work/main_test.go", `package main
+
+		import (
+			"testing"
+
+			"example.com/hello"
+		)
+
+		func TestHello(t *testing.T) {
+			if hello.Hello() != "hello, v1.1.0" {
+				t.Fatal(hello.Hello())
+			}
+		}
+	`)
+
+	// Building adds the latest version of the missing module to go.mod,
+	// and its checksums to go.sum.
+	tg.run("build", "-o", tg.path("work/work.exe"), ".")
+	tg.grepStderr("found example.com/hello in example.com/hello v1.1.0", "did not find latest example.com/hello")
+	tg.run("run", "main.go")
+	tg.grepStdout("hello, v1.1.0", "did not run with example.com/hello v1.1.0")
+	tg.run("test", ".")
+	tg.grepStdout("ok", "go test failed")
+
+	gomod, err := ioutil.ReadFile(tg.path("work/go.mod"))
+	tg.must(err)
+	if !strings.Contains(string(gomod), "require example.com/hello v1.1.0") {
+		t.Errorf("go.mod missing requirement:\n%s", gomod)
+	}
+	gosum, err := ioutil.ReadFile(tg.path("work/go.sum"))
+	tg.must(err)
+	if !strings.Contains(string(gosum), "example.com/hello v1.1.0 h1:") || !strings.Contains(string(gosum), "example.com/hello v1.1.0/go.mod h1:") {
+		t.Errorf("go.sum missing checksums:\n%s", gosum)
+	}
+	tg.mustExist(tg.path("gopath/pkg/mod/example.com/hello@v1.1.0/hello.go"))
+
+	tg.run("list", "-m", "all")
+	tg.grepStdout(`^example.com/work$`, "did not list main module")
+	tg.grepStdout(`^example.com/hello v1.1.0$`, "did not list example.com/hello")
+	tg.run("env", "GOMOD")
+	tg.grepStdout(`work[/\\]go.mod`, "GOMOD is not the main go.mod")
+
+	tg.run("mod", "-verify")
+	tg.grepStdout("all modules verified", "did not verify modules")
+
+	// A corrupted go.sum is reported.
+	tg.must(ioutil.WriteFile(tg.path("work/go.sum"), []byte(strings.Replace(string(gosum), "v1.1.0 h1:", "v1.1.0 h1:x", 1)), 0666))
+	tg.run("clean", "-modcache")
+	tg.runFail("build", ".")
+	tg.grepStderr("checksum mismatch", "did not detect checksum mismatch")
+}
+
+func TestModMinimalVersionSelection(t *testing.T) {
+	tg := modTestgo(t)
+	defer tg.cleanup()
+	defer tg.run("clean", "-modcache")
+
+	tg.tempFile("work/go.mod", `module example.com/work
+
+require (
+	example.com/greet v1.0.0
+	example.com/hello v1.0.0
+)
+`)
+	tg.tempFile("work/main.go", `package main
+
+		import (
+			"fmt"
+
+			"example.com/greet"
+			"example.com/hello"
+		)
+
+		func main() { fmt.Println(greet.Greet(), hello.Hello()) }
+	`)
+
+	// example.com/greet requires a newer example.com/hello
+	// than the main module does, so the newer one is selected.
+	tg.run("list", "-m", "all")
+	tg.grepStdout(`^example.com/hello v1.1.0$`, "did not select example.com/hello v1.1.0")
+	tg.run("mod", "-graph")
+	tg.grepStdout(`^example.com/greet@v1.0.0 example.com/hello@v1.1.0$`, "missing requirement edge")
+	tg.grepStdout(`^example.com/work example.com/hello@v1.0.0$`, "missing requirement edge")
+	tg.run("run", "main.go")
+	tg.grepStdout("hello, v1.1.0 hello, v1.1.0", "did not build with example.com/hello v1.1.0")
+
+	// Requesting an older version with go get does not override
+	// the requirement of example.com/greet.
+	tg.run("get", "-d", "example.com/hello@v1.0.0")
+	tg.run("list", "-m", "example.com/hello")
+	tg.grepStdout(`^example.com/hello v1.1.0$`, "downgraded below requirement of example.com/greet")
+
+	tg.run("list", "-m", "example.com/hello@<v1.1.0")
+	tg.grepStdout(`^example.com/hello v1.0.0$`, "wrong result for query")
+}
+
+func TestModGOPATHModeUnchanged(t *testing.T) {
+	tg := testgo(t)
+	defer tg.cleanup()
+	tg.makeTempdir()
+	tg.setenv("GO111MODULE", "auto")
+	tg.setenv("GOPATH", tg.path("."))
+
+	// Inside GOPATH/src, a go.mod file is ignored by default.
+	tg.tempFile("src/x/go.mod", "module example.com/x\n")
+	tg.tempFile("src/x/x.go", "package x\n")
+	tg.cd(tg.path("src/x"))
+	tg.run("list", "-f", "{{.ImportPath}}", ".")
+	tg.grepStdout(`^x$`, "did not use GOPATH import path")
+	tg.run("env", "GOMOD")
+	tg.grepStdoutNot(`go.mod`, "GOMOD set in GOPATH mode")
+	tg.runFail("list", "-m")
+
+	// GO111MODULE=on forces module mode.
+	tg.setenv("GO111MODULE", "on")
+	tg.run("list", "-f", "{{.ImportPath}}", ".")
+	tg.grepStdout(`^example.com/x$`, "did not use module import path")
+}
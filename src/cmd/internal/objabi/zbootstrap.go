@@ -0,0 +1,15 @@
+// Code generated by go tool dist; DO NOT EDIT.
+
+package objabi
+
+import "runtime"
+
+const defaultGO386 = `sse2`
+const defaultGOARM = `5`
+const defaultGOMIPS = `hardfloat`
+const defaultGOOS = runtime.GOOS
+const defaultGOARCH = runtime.GOARCH
+const defaultGO_EXTLINK_ENABLED = ``
+const version = `go1.10.2`
+const stackGuardMultiplier = 1
+const goexperiment = ``
@@ -0,0 +1,692 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Views of user annotations: tasks, regions and logs.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"internal/trace"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+func init() {
+	http.HandleFunc("/usertasks", httpUserTasks)
+	http.HandleFunc("/usertask", httpUserTask)
+	http.HandleFunc("/userregions", httpUserRegions)
+	http.HandleFunc("/userregion", httpUserRegion)
+}
+
+// taskDesc describes a task created by trace.NewTask.
+type taskDesc struct {
+	name     string
+	id       uint64
+	create   *trace.Event // nil if the task is created before the trace
+	end      *trace.Event // nil if the task does not end in the trace
+	parent   *taskDesc
+	children []*taskDesc
+
+	events     []*trace.Event  // events associated with the task, by time
+	goroutines map[uint64]bool // goroutines that have events of the task
+}
+
+// regionDesc describes a region of a goroutine.
+type regionDesc struct {
+	*trace.UserRegionDesc
+	G uint64 // goroutine of the region
+}
+
+// annotationAnalysisResult is the result of analyzeAnnotations.
+type annotationAnalysisResult struct {
+	tasks   map[uint64]*taskDesc // tasks by id
+	regions []*regionDesc        // all regions, by start time
+	firstTs int64                // timestamp of the first event in the trace
+	lastTs  int64                // timestamp of the last event in the trace
+}
+
+var (
+	annotInit sync.Once
+	annotRes  annotationAnalysisResult
+	annotErr  error
+)
+
+// analyzeAnnotations collects the tasks and regions of the trace.
+func analyzeAnnotations() (annotationAnalysisResult, error) {
+	annotInit.Do(func() {
+		res, err := parseTrace()
+		if err != nil {
+			annotErr = fmt.Errorf("failed to parse trace: %v", err)
+			return
+		}
+		events := res.Events
+		if len(events) == 0 {
+			annotErr = fmt.Errorf("empty trace")
+			return
+		}
+		annotRes.tasks = make(map[uint64]*taskDesc)
+		annotRes.firstTs = events[0].Ts
+		annotRes.lastTs = events[len(events)-1].Ts
+
+		task := func(id uint64) *taskDesc {
+			if id == 0 {
+				return nil // background task
+			}
+			t := annotRes.tasks[id]
+			if t == nil {
+				t = &taskDesc{id: id, goroutines: make(map[uint64]bool)}
+				annotRes.tasks[id] = t
+			}
+			return t
+		}
+		for _, ev := range events {
+			var t *taskDesc
+			switch ev.Type {
+			case trace.EvUserTaskCreate:
+				t = task(ev.Args[0])
+				t.name = ev.SArgs[0]
+				t.create = ev
+				if p := task(ev.Args[1]); p != nil {
+					t.parent = p
+					p.children = append(p.children, t)
+				}
+			case trace.EvUserTaskEnd:
+				t = task(ev.Args[0])
+				t.end = ev
+			case trace.EvUserRegion, trace.EvUserLog:
+				t = task(ev.Args[0])
+			}
+			if t != nil {
+				t.events = append(t.events, ev)
+				t.goroutines[ev.G] = true
+			}
+		}
+
+		analyzeGoroutines(events)
+		for goid, g := range gs {
+			for _, r := range g.Regions {
+				annotRes.regions = append(annotRes.regions, &regionDesc{UserRegionDesc: r, G: goid})
+			}
+		}
+		sort.Slice(annotRes.regions, func(i, j int) bool {
+			return annotRes.regions[i].firstTimestamp() < annotRes.regions[j].firstTimestamp()
+		})
+	})
+	return annotRes, annotErr
+}
+
+// complete reports whether both the start and the end of the task are
+// in the trace.
+func (t *taskDesc) complete() bool {
+	return t.create != nil && t.end != nil
+}
+
+// firstTimestamp returns the timestamp of the task creation, or the
+// start of the trace if the task is created before it.
+func (t *taskDesc) firstTimestamp() int64 {
+	if t.create != nil {
+		return t.create.Ts
+	}
+	return annotRes.firstTs
+}
+
+// lastTimestamp returns the timestamp of the task end, or of the last
+// event of the task and its subtasks if it does not end in the trace.
+func (t *taskDesc) lastTimestamp() int64 {
+	if t.end != nil {
+		return t.end.Ts
+	}
+	last := t.firstTimestamp()
+	if len(t.events) > 0 {
+		last = t.events[len(t.events)-1].Ts
+	}
+	for _, c := range t.children {
+		if ts := c.lastTimestamp(); ts > last {
+			last = ts
+		}
+	}
+	return last
+}
+
+// duration returns the latency of the task, up to the end of the trace
+// if it does not end in it.
+func (t *taskDesc) duration() time.Duration {
+	if t.end != nil {
+		return time.Duration(t.end.Ts - t.firstTimestamp())
+	}
+	return time.Duration(annotRes.lastTs - t.firstTimestamp())
+}
+
+// relatedGoroutines returns the goroutines that have events of the task
+// or of its subtasks.
+func (t *taskDesc) relatedGoroutines() map[uint64]bool {
+	gmap := map[uint64]bool{0: true} // for GC events
+	var add func(t *taskDesc)
+	add = func(t *taskDesc) {
+		for g := range t.goroutines {
+			gmap[g] = true
+		}
+		for _, c := range t.children {
+			add(c)
+		}
+	}
+	add(t)
+	return gmap
+}
+
+func (r *regionDesc) firstTimestamp() int64 {
+	if r.Start != nil {
+		return r.Start.Ts
+	}
+	return annotRes.firstTs
+}
+
+func (r *regionDesc) duration() time.Duration {
+	if r.End != nil {
+		return time.Duration(r.End.Ts - r.firstTimestamp())
+	}
+	return time.Duration(r.TotalTime)
+}
+
+// complete reports whether both the start and the end of the region
+// are in the trace.
+func (r *regionDesc) complete() bool {
+	return r.Start != nil && r.End != nil
+}
+
+// durationHistogram is a histogram of durations with logarithmic
+// buckets, five for every power of 10.
+type durationHistogram struct {
+	Count     int
+	Buckets   []int
+	MinBucket int
+	MaxBucket int
+}
+
+var logDiv = math.Log(math.Pow(10, 1.0/5))
+
+func (h *durationHistogram) add(d time.Duration) {
+	var bucket int
+	if d > 0 {
+		bucket = int(math.Log(float64(d)) / logDiv)
+	}
+	if len(h.Buckets) <= bucket {
+		h.Buckets = append(h.Buckets, make([]int, bucket-len(h.Buckets)+1)...)
+	}
+	h.Buckets[bucket]++
+	if h.Count == 0 || bucket < h.MinBucket {
+		h.MinBucket = bucket
+	}
+	if bucket > h.MaxBucket {
+		h.MaxBucket = bucket
+	}
+	h.Count++
+}
+
+// BucketMin returns the smallest duration in the bucket.
+func (h *durationHistogram) BucketMin(bucket int) time.Duration {
+	return time.Duration(math.Exp(float64(bucket) * logDiv))
+}
+
+func niceDuration(d time.Duration) string {
+	var rnd time.Duration
+	var unit string
+	switch {
+	case d < 10*time.Microsecond:
+		rnd, unit = time.Nanosecond, "ns"
+	case d < 10*time.Millisecond:
+		rnd, unit = time.Microsecond, "µs"
+	case d < 10*time.Second:
+		rnd, unit = time.Millisecond, "ms"
+	default:
+		rnd, unit = time.Second, "s "
+	}
+	return fmt.Sprintf("%d%s", d/rnd, unit)
+}
+
+// ToHTML renders the histogram as a table of bars. The buckets link to
+// the URLs returned by urlmaker for their duration ranges.
+func (h *durationHistogram) ToHTML(urlmaker func(min, max time.Duration) string) template.HTML {
+	if h == nil || h.Count == 0 {
+		return template.HTML("")
+	}
+
+	const barWidth = 400
+
+	maxCount := 0
+	for _, count := range h.Buckets {
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, `<table>`)
+	for i := h.MinBucket; i <= h.MaxBucket; i++ {
+		// Tick label.
+		if h.Buckets[i] > 0 {
+			fmt.Fprintf(w, `<tr><td class="histoTime" align="right"><a href="%s">%s</a></td>`, template.HTMLEscapeString(urlmaker(h.BucketMin(i), h.BucketMin(i+1))), niceDuration(h.BucketMin(i)))
+		} else {
+			fmt.Fprintf(w, `<tr><td class="histoTime" align="right">%s</td>`, niceDuration(h.BucketMin(i)))
+		}
+		// Bucket bar.
+		width := h.Buckets[i] * barWidth / maxCount
+		fmt.Fprintf(w, `<td><div style="width:%dpx;background:blue;position:relative">&nbsp;</div></td>`, width)
+		// Bucket count.
+		fmt.Fprintf(w, `<td align="right"><div style="position:relative">%d</div></td>`, h.Buckets[i])
+		fmt.Fprintf(w, "</tr>\n")
+	}
+	// Final tick label.
+	fmt.Fprintf(w, `<tr><td align="right">%s</td></tr>`, niceDuration(h.BucketMin(h.MaxBucket+1)))
+	fmt.Fprintf(w, `</table>`)
+	return template.HTML(w.String())
+}
+
+// latencyURL returns the URL of the page at path listing the instances
+// of the given type with a latency in [min, max).
+func latencyURL(path, typ string, min, max time.Duration) string {
+	v := url.Values{}
+	v.Set("type", typ)
+	v.Set("latmin", min.String())
+	v.Set("latmax", max.String())
+	return path + "?" + v.Encode()
+}
+
+// typeStats is a summary of the tasks or the regions of one type.
+type typeStats struct {
+	Type      string
+	Count     int
+	Complete  int // number of instances with both start and end in the trace
+	Histogram durationHistogram
+	url       string // path of the page listing instances
+}
+
+func (s *typeStats) add(complete bool, d time.Duration) {
+	s.Count++
+	if complete {
+		s.Complete++
+		s.Histogram.add(d)
+	}
+}
+
+func (s *typeStats) HistogramHTML() template.HTML {
+	return s.Histogram.ToHTML(func(min, max time.Duration) string {
+		return latencyURL(s.url, s.Type, min, max)
+	})
+}
+
+func sortedStats(m map[string]*typeStats) []*typeStats {
+	var list []*typeStats
+	for _, s := range m {
+		list = append(list, s)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Type < list[j].Type
+	})
+	return list
+}
+
+// httpUserTasks serves the summary of the tasks by type.
+func httpUserTasks(w http.ResponseWriter, r *http.Request) {
+	res, err := analyzeAnnotations()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	stats := make(map[string]*typeStats)
+	for _, t := range res.tasks {
+		s := stats[t.name]
+		if s == nil {
+			s = &typeStats{Type: t.name, url: "/usertask"}
+			stats[t.name] = s
+		}
+		s.add(t.complete(), t.duration())
+	}
+	err = templUserTypes.Execute(w, struct {
+		Kind  string
+		Path  string
+		Stats []*typeStats
+	}{"Task", "/usertask", sortedStats(stats)})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+// httpUserRegions serves the summary of the regions by type.
+func httpUserRegions(w http.ResponseWriter, r *http.Request) {
+	res, err := analyzeAnnotations()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	stats := make(map[string]*typeStats)
+	for _, r := range res.regions {
+		s := stats[r.Name]
+		if s == nil {
+			s = &typeStats{Type: r.Name, url: "/userregion"}
+			stats[r.Name] = s
+		}
+		s.add(r.complete(), r.duration())
+	}
+	err = templUserTypes.Execute(w, struct {
+		Kind  string
+		Path  string
+		Stats []*typeStats
+	}{"Region", "/userregion", sortedStats(stats)})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+var templUserTypes = template.Must(template.New("").Parse(`
+<html>
+<style type="text/css">
+.histoTime {
+   width: 20%;
+   white-space:nowrap;
+}
+</style>
+<body>
+<table border="1" sortable="1">
+<tr>
+<th>{{.Kind}} type</th>
+<th>Count</th>
+<th>Latency distribution (complete only)</th>
+</tr>
+{{range $.Stats}}
+  <tr>
+    <td>{{.Type}}</td>
+    <td><a href="{{$.Path}}?type={{.Type}}">{{.Count}}</a> ({{.Complete}} complete)</td>
+    <td>{{.HistogramHTML}}</td>
+  </tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
+
+// latencyFilter is the filter of the latmin and latmax parameters.
+type latencyFilter struct {
+	min, max time.Duration
+	set      bool
+}
+
+func parseLatencyFilter(r *http.Request) (latencyFilter, error) {
+	var f latencyFilter
+	lmin, lmax := r.FormValue("latmin"), r.FormValue("latmax")
+	if lmin == "" && lmax == "" {
+		return f, nil
+	}
+	f.set = true
+	f.max = time.Duration(math.MaxInt64)
+	var err error
+	if lmin != "" {
+		if f.min, err = time.ParseDuration(lmin); err != nil {
+			return f, fmt.Errorf("failed to parse latmin parameter '%v': %v", lmin, err)
+		}
+	}
+	if lmax != "" {
+		if f.max, err = time.ParseDuration(lmax); err != nil {
+			return f, fmt.Errorf("failed to parse latmax parameter '%v': %v", lmax, err)
+		}
+	}
+	return f, nil
+}
+
+// match reports whether an instance passes the filter. Instances that
+// are not complete have no latency and only pass an unset filter.
+func (f latencyFilter) match(complete bool, d time.Duration) bool {
+	if !f.set {
+		return true
+	}
+	return complete && f.min <= d && d < f.max
+}
+
+// taskEvent is an event in the list of a task.
+type taskEvent struct {
+	WhenString string // time since the task start
+	Elapsed    string // time since the previous event of the task
+	Go         uint64
+	What       string
+}
+
+// taskView is a task in the list of httpUserTask.
+type taskView struct {
+	ID         uint64
+	Name       string
+	Parent     uint64
+	Start      string
+	Duration   string
+	Complete   bool
+	Goroutines int
+	Events     []taskEvent
+}
+
+// httpUserTask serves the list of the tasks of a type with their events,
+// optionally restricted to a latency range.
+func httpUserTask(w http.ResponseWriter, r *http.Request) {
+	res, err := analyzeAnnotations()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	filter, err := parseLatencyFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	typ := r.FormValue("type")
+	var taskid uint64
+	if s := r.FormValue("taskid"); s != "" {
+		if taskid, err = strconv.ParseUint(s, 10, 64); err != nil {
+			http.Error(w, fmt.Sprintf("failed to parse taskid parameter '%v': %v", s, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var tasks []*taskDesc
+	for _, t := range res.tasks {
+		if taskid != 0 && t.id != taskid || taskid == 0 && t.name != typ {
+			continue
+		}
+		if !filter.match(t.complete(), t.duration()) {
+			continue
+		}
+		tasks = append(tasks, t)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].firstTimestamp() < tasks[j].firstTimestamp()
+	})
+
+	var views []taskView
+	for _, t := range tasks {
+		v := taskView{
+			ID:         t.id,
+			Name:       t.name,
+			Start:      time.Duration(t.firstTimestamp() - res.firstTs).String(),
+			Duration:   t.duration().String(),
+			Complete:   t.complete(),
+			Goroutines: len(t.goroutines),
+		}
+		if t.parent != nil {
+			v.Parent = t.parent.id
+		}
+		last := t.firstTimestamp()
+		for _, ev := range t.events {
+			v.Events = append(v.Events, taskEvent{
+				WhenString: time.Duration(ev.Ts - t.firstTimestamp()).String(),
+				Elapsed:    time.Duration(ev.Ts - last).String(),
+				Go:         ev.G,
+				What:       describeEvent(ev, res.tasks),
+			})
+			last = ev.Ts
+		}
+		views = append(views, v)
+	}
+	err = templUserTask.Execute(w, struct {
+		Type  string
+		Tasks []taskView
+	}{typ, views})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+// describeEvent describes an event in the list of a task.
+func describeEvent(ev *trace.Event, tasks map[uint64]*taskDesc) string {
+	switch ev.Type {
+	case trace.EvUserTaskCreate:
+		if p := tasks[ev.Args[1]]; p != nil {
+			return fmt.Sprintf("task %q created (subtask of %q %d)", ev.SArgs[0], p.name, p.id)
+		}
+		return fmt.Sprintf("task %q created", ev.SArgs[0])
+	case trace.EvUserTaskEnd:
+		return "task end"
+	case trace.EvUserRegion:
+		if ev.Args[1] == 0 {
+			return fmt.Sprintf("region %q started", ev.SArgs[0])
+		}
+		return fmt.Sprintf("region %q ended", ev.SArgs[0])
+	case trace.EvUserLog:
+		if ev.SArgs[0] == "" {
+			return fmt.Sprintf("log %q", ev.SArgs[1])
+		}
+		return fmt.Sprintf("log %s=%q", ev.SArgs[0], ev.SArgs[1])
+	}
+	return ""
+}
+
+var templUserTask = template.Must(template.New("").Parse(`
+<html>
+<body>
+<h2>User Task: {{.Type}}</h2>
+{{range $.Tasks}}
+<table border="1">
+<tr>
+<th colspan="4">
+Task {{.ID}} {{.Name}}{{if .Parent}} (subtask of <a href="/usertask?taskid={{.Parent}}">{{.Parent}}</a>){{end}}:
+start {{.Start}}, duration {{.Duration}}{{if not .Complete}} (incomplete){{end}},
+{{.Goroutines}} goroutines,
+<a href="/trace?taskid={{.ID}}">goroutine view</a>
+</th>
+</tr>
+<tr><th>When</th><th>Elapsed</th><th>Goroutine</th><th>Event</th></tr>
+{{range .Events}}
+  <tr>
+    <td align="right">{{.WhenString}}</td>
+    <td align="right">{{.Elapsed}}</td>
+    <td><a href="/trace?goid={{.Go}}">{{.Go}}</a></td>
+    <td>{{.What}}</td>
+  </tr>
+{{end}}
+</table>
+<br>
+{{end}}
+</body>
+</html>
+`))
+
+// regionView is a region in the list of httpUserRegion.
+type regionView struct {
+	*regionDesc
+	Start    string
+	Duration string
+	Task     string
+}
+
+// httpUserRegion serves the list of the regions of a type with the
+// statistics of their goroutines while in them, optionally restricted
+// to a latency range.
+func httpUserRegion(w http.ResponseWriter, r *http.Request) {
+	res, err := analyzeAnnotations()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	filter, err := parseLatencyFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	typ := r.FormValue("type")
+
+	var views []regionView
+	for _, r := range res.regions {
+		if r.Name != typ || !filter.match(r.complete(), r.duration()) {
+			continue
+		}
+		v := regionView{
+			regionDesc: r,
+			Start:      time.Duration(r.firstTimestamp() - res.firstTs).String(),
+			Duration:   r.duration().String(),
+		}
+		if !r.complete() {
+			v.Duration += " (incomplete)"
+		}
+		if t := res.tasks[r.TaskID]; t != nil {
+			v.Task = fmt.Sprintf("%s %d", t.name, t.id)
+		}
+		views = append(views, v)
+	}
+	sort.SliceStable(views, func(i, j int) bool {
+		return views[i].duration() > views[j].duration()
+	})
+	err = templUserRegion.Execute(w, struct {
+		Type    string
+		Regions []regionView
+	}{typ, views})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+var templUserRegion = template.Must(template.New("").Parse(`
+<html>
+<body>
+<h2>User Region: {{.Type}}</h2>
+<table border="1" sortable="1">
+<tr>
+<th> Goroutine </th>
+<th> Task </th>
+<th> Start </th>
+<th> Total time </th>
+<th> Execution time, ns </th>
+<th> Network wait time, ns </th>
+<th> Sync block time, ns </th>
+<th> Blocking syscall time, ns </th>
+<th> Scheduler wait time, ns </th>
+<th> GC sweeping time, ns </th>
+<th> GC pause time, ns </th>
+</tr>
+{{range .Regions}}
+  <tr>
+    <td> <a href="/trace?goid={{.G}}">{{.G}}</a> </td>
+    <td> {{if .Task}}<a href="/usertask?taskid={{.TaskID}}">{{.Task}}</a>{{end}} </td>
+    <td> {{.Start}} </td>
+    <td> {{.Duration}} </td>
+    <td> {{.ExecTime}} </td>
+    <td> {{.IOTime}} </td>
+    <td> {{.BlockTime}} </td>
+    <td> {{.SyscallTime}} </td>
+    <td> {{.SchedWaitTime}} </td>
+    <td> {{.SweepTime}} </td>
+    <td> {{.GCTime}} </td>
+  </tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
@@ -0,0 +1,122 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"internal/trace"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDurationHistogram(t *testing.T) {
+	var h durationHistogram
+	for _, d := range []time.Duration{0, 1, 150 * time.Microsecond, 160 * time.Microsecond, 2 * time.Second} {
+		h.add(d)
+	}
+	if h.Count != 5 {
+		t.Errorf("got count %d, want 5", h.Count)
+	}
+	if h.MinBucket != 0 {
+		t.Errorf("got min bucket %d, want 0", h.MinBucket)
+	}
+	if min := h.BucketMin(h.MaxBucket); min > 2*time.Second || h.BucketMin(h.MaxBucket+1) <= 2*time.Second {
+		t.Errorf("max bucket [%v, %v) does not contain 2s", min, h.BucketMin(h.MaxBucket+1))
+	}
+	total := 0
+	for _, n := range h.Buckets {
+		total += n
+	}
+	if total != h.Count {
+		t.Errorf("got %d durations in buckets, want %d", total, h.Count)
+	}
+
+	html := string(h.ToHTML(func(min, max time.Duration) string {
+		return latencyURL("/userregion", "a&b", min, max)
+	}))
+	if want := "/userregion?latmax="; !strings.Contains(html, want) {
+		t.Errorf("histogram does not link to %s...:\n%s", want, html)
+	}
+	if strings.Contains(html, "type=a&b") {
+		t.Errorf("histogram links do not escape the type:\n%s", html)
+	}
+	if (&durationHistogram{}).ToHTML(nil) != "" {
+		t.Errorf("empty histogram is not empty")
+	}
+}
+
+func TestUserAnnotations(t *testing.T) {
+	w := trace.NewWriter()
+	w.Emit(trace.EvBatch, 0, 0)  // start of per-P batch event [pid, timestamp]
+	w.Emit(trace.EvFrequency, 1) // [ticks per second]
+	w.EmitString(1, "order")
+	w.EmitString(2, "steam")
+	w.EmitString(3, "orderID")
+
+	var s stacks
+	// goroutine 10 runs task 5, with a region that runs for 3+1 ticks
+	// and waits to be scheduled for 3.
+	w.Emit(trace.EvGoCreate, 1, 10, s.add("pkg.f1"), s.add("main.f1")) // [timestamp, new goroutine id, new stack id, stack id]
+	w.Emit(trace.EvGoStartLocal, 1, 10)                                // [timestamp, goroutine id]
+	w.Emit(trace.EvUserTaskCreate, 1, 5, 0, 1, s.add("main.order"))    // [timestamp, task id, parent task id, name string id, stack]
+	w.Emit(trace.EvUserRegion, 1, 5, 0, 2, s.add("main.steam"))        // [timestamp, task id, mode, name string id, stack]
+	w.EmitLog("42", 1, 5, 3, s.add("main.steam"))                      // [timestamp, task id, key string id, stack]
+	w.Emit(trace.EvGoSched, 2, s.add("main.steam"))                    // [timestamp, stack]
+	w.Emit(trace.EvGoStartLocal, 3, 10)
+	w.Emit(trace.EvUserRegion, 1, 5, 1, 2, s.add("main.steam"))
+	w.Emit(trace.EvUserTaskEnd, 1, 5, s.add("main.order")) // [timestamp, task id, stack]
+	w.Emit(trace.EvGoBlock, 1, s.add("main.f1"))           // [timestamp, stack]
+
+	res, err := trace.Parse(w, "")
+	if err != nil {
+		t.Fatalf("failed to parse test trace: %v", err)
+	}
+	res.Stacks = s // use fake stacks
+	for _, ev := range res.Events {
+		ev.Stk = s[ev.StkID]
+	}
+
+	gs := trace.GoroutineStats(res.Events)
+	regions := gs[10].Regions
+	if len(regions) != 1 {
+		t.Fatalf("got %d regions, want 1", len(regions))
+	}
+	r := regions[0]
+	if r.Name != "steam" || r.TaskID != 5 || r.Start == nil || r.End == nil {
+		t.Errorf("got region %+v, want complete region steam of task 5", r)
+	}
+	if r.ExecTime != 4e9 || r.SchedWaitTime != 3e9 || r.TotalTime != 7e9 {
+		t.Errorf("got region execution time %d, scheduler wait time %d, total time %d, want 4s, 3s, 7s", r.ExecTime, r.SchedWaitTime, r.TotalTime)
+	}
+
+	params := &traceParams{
+		parsed:  res,
+		gtrace:  true,
+		endTime: int64(1<<63 - 1),
+		maing:   10,
+		gs:      map[uint64]bool{0: true, 10: true},
+	}
+	viewerData, err := generateTrace(params)
+	if err != nil {
+		t.Fatalf("generateTrace failed: %v", err)
+	}
+
+	phases := make(map[string]string)
+	var logs []string
+	for _, ev := range viewerData.Events {
+		switch {
+		case ev.Category == "task" || ev.Category == "region":
+			phases[ev.Category+" "+ev.Name] += ev.Phase
+		case strings.HasPrefix(ev.Name, "log"):
+			logs = append(logs, ev.Name)
+		}
+	}
+	if phases["task order"] != "be" || phases["region steam"] != "be" || len(phases) != 2 {
+		t.Errorf("got task and region events %v, want task order and region steam", phases)
+	}
+	if len(logs) != 1 || logs[0] != "log: orderID" {
+		t.Errorf("got log events %v, want [log: orderID]", logs)
+	}
+}
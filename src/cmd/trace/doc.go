@@ -27,6 +27,12 @@ Supported profile types are:
 Then, you can use the pprof tool to analyze the profile:
 	go tool pprof TYPE.pprof
 
+Programs annotated with the runtime/trace package's tasks, regions and
+log messages can be analyzed per task and per region type: the
+'User-defined tasks' and 'User-defined regions' pages show latency
+histograms, the events of each task, and how the goroutine of each
+region spent its time.
+
 Note that while the various profiles available when launching
 'go tool trace' work on every browser, the trace viewer itself
 (the 'view trace' page) comes from the Chrome/Chromium project
@@ -23,7 +23,8 @@ func init() {
 	http.HandleFunc("/trace_viewer_html", httpTraceViewerHTML)
 }
 
-// httpTrace serves either whole trace (goid==0) or trace for goid goroutine.
+// httpTrace serves either whole trace (goid==0), trace for goid goroutine
+// or trace for the goroutines of task taskid.
 func httpTrace(w http.ResponseWriter, r *http.Request) {
 	_, err := parseTrace()
 	if err != nil {
@@ -178,6 +179,29 @@ func httpJsonTrace(w http.ResponseWriter, r *http.Request) {
 		params.endTime = g.EndTime
 		params.maing = goid
 		params.gs = trace.RelatedGoroutines(res.Events, goid)
+	} else if taskids := r.FormValue("taskid"); taskids != "" {
+		// If taskid argument is present, we are rendering a trace for the
+		// goroutines involved in this particular task.
+		taskid, err := strconv.ParseUint(taskids, 10, 64)
+		if err != nil {
+			log.Printf("failed to parse taskid parameter '%v': %v", taskids, err)
+			return
+		}
+		annotRes, err := analyzeAnnotations()
+		if err != nil {
+			log.Printf("failed to analyze annotations: %v", err)
+			return
+		}
+		task := annotRes.tasks[taskid]
+		if task == nil || len(task.events) == 0 {
+			log.Printf("failed to find task with id %d", taskid)
+			return
+		}
+		params.gtrace = true
+		params.startTime = task.firstTimestamp() - 1
+		params.endTime = task.lastTimestamp() + 1
+		params.maing = task.events[0].G
+		params.gs = task.relatedGoroutines()
 	}
 
 	data, err := generateTrace(params)
@@ -274,6 +298,8 @@ type traceContext struct {
 	frameTree frameNode
 	frameSeq  int
 	arrowSeq  uint64
+	searchSeq uint64
+	regionSeq uint64
 	gcount    uint64
 
 	heapStats, prevHeapStats     heapStats
@@ -293,8 +319,8 @@ type threadStats struct {
 }
 
 type frameNode struct {
-	id        int
-	_children map[uint64]frameNode
+	id       int
+	children map[uint64]frameNode
 }
 
 type gState int
@@ -335,6 +361,7 @@ type ViewerEvent struct {
 	Pid      uint64      `json:"pid"`
 	Tid      uint64      `json:"tid"`
 	ID       uint64      `json:"id,omitempty"`
+	Category string      `json:"cat,omitempty"`
 	Stack    int         `json:"sf,omitempty"`
 	EndStack int         `json:"esf,omitempty"`
 	Arg      interface{} `json:"args,omitempty"`
@@ -362,7 +389,7 @@ type SortIndexArg struct {
 // gset restricts goroutines that are included in the resulting trace.
 func generateTrace(params *traceParams) (ViewerData, error) {
 	ctx := &traceContext{traceParams: params}
-	ctx.frameTree._children = make(map[uint64]frameNode)
+	ctx.frameTree.children = make(map[uint64]frameNode)
 	ctx.data.Frames = make(map[string]ViewerFrame)
 	ctx.data.TimeUnit = "ns"
 	maxProc := 0
@@ -560,6 +587,18 @@ func generateTrace(params *traceParams) (ViewerData, error) {
 			ctx.emitInstant(ev, "syscall")
 		case trace.EvGoSysExit:
 			ctx.emitArrow(ev, "sysexit")
+		case trace.EvSearchTaskStart:
+			ctx.emitSearchTask(ev)
+		case trace.EvUserTaskCreate:
+			ctx.emitUserTask(ev)
+		case trace.EvUserRegion:
+			// A region can outlive the executions of its goroutine on Ps,
+			// so regions are only shown on goroutines.
+			if ctx.gtrace && ev.Args[1] == 0 {
+				ctx.emitUserRegion(ev)
+			}
+		case trace.EvUserLog:
+			ctx.emitUserLog(ev)
 		}
 		// Emit any counter updates.
 		ctx.emitThreadCounters(ev)
@@ -725,6 +764,75 @@ func (ctx *traceContext) emitArrow(ev *trace.Event, name string) {
 	ctx.emit(&ViewerEvent{Name: name, Phase: "t", Tid: ctx.proc(ev.Link), ID: ctx.arrowSeq, Time: ctx.time(ev.Link)})
 }
 
+// emitSearchTask emits the subtree of a search statement that an engine
+// goroutine expanded as an asynchronous slice. The subtrees of a search
+// are shown together, which makes visible how the search tree was
+// partitioned among the engine goroutines.
+func (ctx *traceContext) emitSearchTask(ev *trace.Event) {
+	end := ev.Link
+	if end == nil {
+		// The subtree is not finished before trace stop.
+		end = ctx.parsed.Events[len(ctx.parsed.Events)-1]
+	}
+	type Arg struct {
+		Subtree uint64
+		Parent  uint64
+		G       uint64
+	}
+	name := fmt.Sprintf("search %d", ev.Args[0])
+	ctx.searchSeq++
+	ctx.emit(&ViewerEvent{Name: name, Category: "search", Phase: "b", Tid: ctx.proc(ev), ID: ctx.searchSeq, Time: ctx.time(ev), Arg: &Arg{ev.Args[1], ev.Args[2], ev.G}})
+	ctx.emit(&ViewerEvent{Name: name, Category: "search", Phase: "e", Tid: ctx.proc(ev), ID: ctx.searchSeq, Time: ctx.time(end)})
+}
+
+// emitUserTask emits a task created by trace.NewTask as an asynchronous
+// slice from its creation to its end.
+func (ctx *traceContext) emitUserTask(ev *trace.Event) {
+	end := ev.Link
+	if end == nil {
+		// The task does not end before trace stop.
+		end = ctx.parsed.Events[len(ctx.parsed.Events)-1]
+	}
+	type Arg struct {
+		ID     uint64
+		Parent uint64
+	}
+	name := ev.SArgs[0]
+	ctx.emit(&ViewerEvent{Name: name, Category: "task", Phase: "b", Tid: ctx.proc(ev), ID: ev.Args[0], Time: ctx.time(ev), Stack: ctx.stack(ev.Stk), Arg: &Arg{ev.Args[0], ev.Args[1]}})
+	ctx.emit(&ViewerEvent{Name: name, Category: "task", Phase: "e", Tid: ctx.proc(ev), ID: ev.Args[0], Time: ctx.time(end), Stack: ctx.stack(end.Stk)})
+}
+
+// emitUserRegion emits a region of a goroutine as an asynchronous slice
+// from its start to its end.
+func (ctx *traceContext) emitUserRegion(ev *trace.Event) {
+	end := ev.Link
+	if end == nil {
+		// The region does not end before trace stop.
+		end = ctx.parsed.Events[len(ctx.parsed.Events)-1]
+	}
+	type Arg struct {
+		TaskID uint64
+	}
+	name := ev.SArgs[0]
+	ctx.regionSeq++
+	ctx.emit(&ViewerEvent{Name: name, Category: "region", Phase: "b", Tid: ctx.proc(ev), ID: ctx.regionSeq, Time: ctx.time(ev), Stack: ctx.stack(ev.Stk), Arg: &Arg{ev.Args[0]}})
+	ctx.emit(&ViewerEvent{Name: name, Category: "region", Phase: "e", Tid: ctx.proc(ev), ID: ctx.regionSeq, Time: ctx.time(end), Stack: ctx.stack(end.Stk)})
+}
+
+// emitUserLog emits an event of trace.Log as an instant.
+func (ctx *traceContext) emitUserLog(ev *trace.Event) {
+	type Arg struct {
+		TaskID   uint64
+		Category string
+		Message  string
+	}
+	name := "log"
+	if ev.SArgs[0] != "" {
+		name = "log: " + ev.SArgs[0]
+	}
+	ctx.emit(&ViewerEvent{Name: name, Phase: "I", Scope: "t", Time: ctx.time(ev), Tid: ctx.proc(ev), Stack: ctx.stack(ev.Stk), Arg: &Arg{ev.Args[0], ev.SArgs[0], ev.SArgs[1]}})
+}
+
 func (ctx *traceContext) stack(stk []*trace.Frame) int {
 	return ctx.buildBranch(ctx.frameTree, stk)
 }
@@ -738,12 +846,12 @@ func (ctx *traceContext) buildBranch(parent frameNode, stk []*trace.Frame) int {
 	frame := stk[last]
 	stk = stk[:last]
 
-	node, ok := parent._children[frame.PC]
+	node, ok := parent.children[frame.PC]
 	if !ok {
 		ctx.frameSeq++
 		node.id = ctx.frameSeq
-		node._children = make(map[uint64]frameNode)
-		parent._children[frame.PC] = node
+		node.children = make(map[uint64]frameNode)
+		parent.children[frame.PC] = node
 		ctx.data.Frames[strconv.Itoa(node.id)] = ViewerFrame{fmt.Sprintf("%v:%v", frame.Fn, frame.Line), parent.id}
 	}
 	return ctx.buildBranch(node, stk)
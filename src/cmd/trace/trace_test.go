@@ -167,3 +167,50 @@ func TestPreemptedMarkAssist(t *testing.T) {
 		t.Errorf("Got %v MARK ASSIST events, want %v", marks, 2)
 	}
 }
+
+func TestSearchTasks(t *testing.T) {
+	w := trace.NewWriter()
+	w.Emit(trace.EvBatch, 0, 0)  // start of per-P batch event [pid, timestamp]
+	w.Emit(trace.EvFrequency, 1) // [ticks per second]
+
+	var s stacks
+	// goroutine 10 expands subtree 0 of search 1, goroutine 20 steals
+	// subtree 1 from it and does not finish it before trace stop.
+	w.Emit(trace.EvGoCreate, 1, 10, s.add("pkg.f1"), s.add("main.f1")) // [timestamp, new goroutine id, new stack id, stack id]
+	w.Emit(trace.EvGoCreate, 1, 20, s.add("pkg.f2"), s.add("main.f2"))
+	w.Emit(trace.EvGoStartLocal, 1, 10)         // [timestamp, goroutine id]
+	w.Emit(trace.EvSearchTaskStart, 1, 1, 0, 0) // [timestamp, search id, graph node id, parent graph node id]
+	w.Emit(trace.EvGoSched, 1, s.add("pkg.f1")) // [timestamp, stack]
+	w.Emit(trace.EvGoStartLocal, 1, 20)
+	w.Emit(trace.EvSearchTaskStart, 1, 1, 1, 0)
+	w.Emit(trace.EvGoSched, 1, s.add("pkg.f2"))
+	w.Emit(trace.EvGoStartLocal, 1, 10)
+	w.Emit(trace.EvSearchTaskEnd, 1, 1, 0) // [timestamp, search id, graph node id]
+	w.Emit(trace.EvGoBlock, 1, s.add("pkg.f1"))
+
+	res, err := trace.Parse(w, "")
+	if err != nil {
+		t.Fatalf("failed to parse test trace: %v", err)
+	}
+	res.Stacks = s // use fake stacks
+
+	params := &traceParams{
+		parsed:  res,
+		endTime: int64(1<<63 - 1),
+	}
+
+	viewerData, err := generateTrace(params)
+	if err != nil {
+		t.Fatalf("generateTrace failed: %v", err)
+	}
+
+	var phases string
+	for _, ev := range viewerData.Events {
+		if ev.Category == "search" && ev.Name == "search 1" {
+			phases += ev.Phase
+		}
+	}
+	if phases != "bebe" {
+		t.Errorf("got search events %q, want %q", phases, "bebe")
+	}
+}
@@ -15,7 +15,7 @@ func init() {
 	register("unreachable",
 		"check for unreachable code",
 		checkUnreachable,
-		funcDecl, funcLit)
+		funcDecl, funcLit, searchClause)
 }
 
 type deadState struct {
@@ -38,6 +38,9 @@ func checkUnreachable(f *File, node ast.Node) {
 		body = n.Body
 	case *ast.FuncLit:
 		body = n.Body
+	case *ast.SearchClause:
+		// A search clause is the body of a function.
+		body = &ast.BlockStmt{List: n.Body}
 	}
 	if body == nil {
 		return
@@ -72,8 +75,9 @@ func (d *deadState) findLabels(stmt ast.Stmt) {
 		*ast.GoStmt,
 		*ast.IncDecStmt,
 		*ast.ReturnStmt,
+		*ast.SearchStmt,
 		*ast.SendStmt:
-		// no statements inside
+		// no statements inside; search clauses are checked separately
 
 	case *ast.BlockStmt:
 		for _, stmt := range x.List {
@@ -189,8 +193,9 @@ func (d *deadState) findDead(stmt ast.Stmt) {
 		*ast.EmptyStmt,
 		*ast.GoStmt,
 		*ast.IncDecStmt,
+		*ast.SearchStmt,
 		*ast.SendStmt:
-		// no control flow
+		// no control flow; search clauses are checked separately
 
 	case *ast.BlockStmt:
 		for _, stmt := range x.List {
@@ -34,8 +34,10 @@ start:
 		*ast.GoStmt,
 		*ast.DeferStmt,
 		*ast.EmptyStmt,
-		*ast.AssignStmt:
+		*ast.AssignStmt,
+		*ast.SearchStmt:
 		// No effect on control flow.
+		// (The clauses of a search statement are function bodies.)
 		b.add(s)
 
 	case *ast.ExprStmt:
@@ -16,7 +16,7 @@ func init() {
 	register("lostcancel",
 		"check for failure to call cancelation function returned by context.WithCancel",
 		checkLostCancel,
-		funcDecl, funcLit)
+		funcDecl, funcLit, searchClause)
 }
 
 const debugLostCancel = false
@@ -30,7 +30,8 @@ var contextPackage = "context"
 // does not "use" the cancel function.  Any reference to the variable
 // counts as a use, even within a nested function literal.
 //
-// checkLostCancel analyzes a single named or literal function.
+// checkLostCancel analyzes a single named or literal function, or a
+// search clause.
 func checkLostCancel(f *File, node ast.Node) {
 	// Fast path: bypass check if file doesn't use context.WithCancel.
 	if !hasImport(f.file, contextPackage) {
@@ -44,7 +45,7 @@ func checkLostCancel(f *File, node ast.Node) {
 	stack := make([]ast.Node, 0, 32)
 	ast.Inspect(node, func(n ast.Node) bool {
 		switch n.(type) {
-		case *ast.FuncLit:
+		case *ast.FuncLit, *ast.SearchClause:
 			if len(stack) > 0 {
 				return false // don't stray into nested functions
 			}
@@ -113,6 +114,10 @@ func checkLostCancel(f *File, node ast.Node) {
 	case *ast.FuncLit:
 		sig, _ = f.pkg.types[node.Type].Type.(*types.Signature)
 		g = cfg.New(node.Body, mayReturn)
+	case *ast.SearchClause:
+		// A search clause is the body of a function without
+		// named results.
+		g = cfg.New(&ast.BlockStmt{List: node.Body}, mayReturn)
 	}
 
 	// Print CFG.
@@ -149,6 +149,7 @@ var (
 	interfaceType *ast.InterfaceType
 	rangeStmt     *ast.RangeStmt
 	returnStmt    *ast.ReturnStmt
+	searchClause  *ast.SearchClause
 	structType    *ast.StructType
 
 	// checkers is a two-level map.
@@ -604,6 +605,8 @@ func (f *File) Visit(node ast.Node) ast.Visitor {
 		key = rangeStmt
 	case *ast.ReturnStmt:
 		key = returnStmt
+	case *ast.SearchClause:
+		key = searchClause
 	case *ast.StructType:
 		key = structType
 	}
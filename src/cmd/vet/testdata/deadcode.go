@@ -2119,6 +2119,19 @@ var _ = func() int {
 	println() // ok
 }
 
+// The clauses of a search statement are checked as function bodies.
+func _() int {
+	search 0; int {
+	children:
+		return nil
+		println() // ERROR "unreachable code"
+	accept:
+		panic(2)
+	}
+	println() // ok
+	return 1
+}
+
 var _ = func() {
 	// goto without label used to panic
 	goto
@@ -153,3 +153,23 @@ var _ = func() (ctx context.Context, cancel func()) {
 	ctx, cancel = context.WithCancel()
 	return
 }
+
+// The clauses of a search statement may use the cancel function.
+func _() {
+	ctx, cancel := context.WithCancel()
+	search 0; int; 1; ctx {
+	children:
+		cancel()
+		return nil
+	}
+}
+
+// But a search clause is a function of its own.
+func _() {
+	search 0; int {
+	children:
+		ctx, cancel := context.WithCancel() // ERROR "not used on all paths"
+		print(ctx)
+		return nil // ERROR "may be reached without using the cancel var"
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// This file contains the signing and verification of the TLS 1.3
+// CertificateVerify message. See RFC 8446, Section 4.4.3.
+
+const (
+	serverSignatureContext = "TLS 1.3, server CertificateVerify\x00"
+	clientSignatureContext = "TLS 1.3, client CertificateVerify\x00"
+)
+
+var signaturePadding = []byte{
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+}
+
+// signedMessage returns the digest that a CertificateVerify signature covers:
+// the padding, the context string and the current transcript hash, hashed
+// with sigHash. If sigHash is directSigning, the message is returned unhashed.
+func signedMessage(sigHash crypto.Hash, context string, transcript hash.Hash) []byte {
+	if sigHash == directSigning {
+		b := &bytes.Buffer{}
+		b.Write(signaturePadding)
+		io.WriteString(b, context)
+		b.Write(transcript.Sum(nil))
+		return b.Bytes()
+	}
+	h := sigHash.New()
+	h.Write(signaturePadding)
+	io.WriteString(h, context)
+	h.Write(transcript.Sum(nil))
+	return h.Sum(nil)
+}
+
+// signatureSchemesForCertificate returns the TLS 1.3 signature schemes that
+// can be used with the given certificate key, in order of preference.
+func signatureSchemesForCertificate(cert *Certificate) []SignatureScheme {
+	priv, ok := cert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil
+	}
+
+	switch pub := priv.Public().(type) {
+	case *ecdsa.PublicKey:
+		switch pub.Curve {
+		case elliptic.P256():
+			return []SignatureScheme{ECDSAWithP256AndSHA256}
+		case elliptic.P384():
+			return []SignatureScheme{ECDSAWithP384AndSHA384}
+		case elliptic.P521():
+			return []SignatureScheme{ECDSAWithP521AndSHA512}
+		default:
+			return nil
+		}
+	case ed25519.PublicKey:
+		return []SignatureScheme{Ed25519}
+	case *rsa.PublicKey:
+		// RSASSA-PSS with a salt as long as the hash needs a modulus of at
+		// least 2*hLen+2 bytes, so small keys can't use the bigger hashes.
+		var schemes []SignatureScheme
+		for _, s := range []SignatureScheme{PSSWithSHA256, PSSWithSHA384, PSSWithSHA512} {
+			h, _ := lookupTLSHash(s)
+			if (pub.N.BitLen()+6)/8 >= 2*h.Size()+2 {
+				schemes = append(schemes, s)
+			}
+		}
+		return schemes
+	default:
+		return nil
+	}
+}
+
+// selectSignatureScheme picks the first scheme in peerAlgs that can be used
+// with cert in a TLS 1.3 CertificateVerify.
+func selectSignatureScheme(cert *Certificate, peerAlgs []SignatureScheme) (SignatureScheme, error) {
+	supported := signatureSchemesForCertificate(cert)
+	for _, s := range peerAlgs {
+		if isSupportedSignatureAlgorithm(s, supported) {
+			return s, nil
+		}
+	}
+	return 0, errors.New("tls: peer doesn't support any of the certificate's signature algorithms")
+}
+
+// signHandshake produces a TLS 1.3 CertificateVerify signature over the
+// transcript with key, using the signature scheme sigAlg.
+func signHandshake(rand io.Reader, key crypto.Signer, sigAlg SignatureScheme, context string, transcript hash.Hash) ([]byte, error) {
+	sigHash, err := lookupTLSHash(sigAlg)
+	if err != nil {
+		return nil, err
+	}
+	var signOpts crypto.SignerOpts = sigHash
+	if signatureFromSignatureScheme(sigAlg) == signatureRSAPSS {
+		signOpts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: sigHash}
+	}
+	return key.Sign(rand, signedMessage(sigHash, context, transcript), signOpts)
+}
+
+// verifyHandshakeSignature verifies a TLS 1.3 CertificateVerify signature
+// over the transcript with the peer's public key.
+func verifyHandshakeSignature(pubKey crypto.PublicKey, sigAlg SignatureScheme, context string, transcript hash.Hash, sig []byte) error {
+	// PKCS #1 v1.5 and SHA-1 are not allowed in TLS 1.3 handshake
+	// signatures. See RFC 8446, Section 4.2.3.
+	switch sigAlg {
+	case PKCS1WithSHA1, PKCS1WithSHA256, PKCS1WithSHA384, PKCS1WithSHA512, ECDSAWithSHA1:
+		return errors.New("tls: peer used an illegal signature algorithm for TLS 1.3")
+	}
+	if !isSupportedSignatureAlgorithm(sigAlg, supportedSignatureAlgorithmsTLS13) {
+		return errors.New("tls: peer used an unadvertised signature algorithm")
+	}
+	sigHash, err := lookupTLSHash(sigAlg)
+	if err != nil {
+		return err
+	}
+	digest := signedMessage(sigHash, context, transcript)
+
+	switch pub := pubKey.(type) {
+	case *ecdsa.PublicKey:
+		if signatureFromSignatureScheme(sigAlg) != signatureECDSA {
+			return errors.New("tls: signature algorithm does not match the ECDSA key")
+		}
+		var wantCurve elliptic.Curve
+		switch sigAlg {
+		case ECDSAWithP256AndSHA256:
+			wantCurve = elliptic.P256()
+		case ECDSAWithP384AndSHA384:
+			wantCurve = elliptic.P384()
+		case ECDSAWithP521AndSHA512:
+			wantCurve = elliptic.P521()
+		}
+		if pub.Curve != wantCurve {
+			return errors.New("tls: ECDSA signature algorithm does not match the key's curve")
+		}
+		ecdsaSig := new(ecdsaSignature)
+		if _, err := asn1.Unmarshal(sig, ecdsaSig); err != nil {
+			return err
+		}
+		if ecdsaSig.R.Sign() <= 0 || ecdsaSig.S.Sign() <= 0 {
+			return errors.New("tls: ECDSA signature contained zero or negative values")
+		}
+		if !ecdsa.Verify(pub, digest, ecdsaSig.R, ecdsaSig.S) {
+			return errors.New("tls: ECDSA verification failure")
+		}
+	case *rsa.PublicKey:
+		if signatureFromSignatureScheme(sigAlg) != signatureRSAPSS {
+			return errors.New("tls: signature algorithm does not match the RSA key")
+		}
+		signOpts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}
+		if err := rsa.VerifyPSS(pub, sigHash, digest, sig, signOpts); err != nil {
+			return err
+		}
+	case ed25519.PublicKey:
+		if sigAlg != Ed25519 {
+			return errors.New("tls: signature algorithm does not match the Ed25519 key")
+		}
+		if !ed25519.Verify(pub, digest, sig) {
+			return errors.New("tls: Ed25519 verification failure")
+		}
+	default:
+		return fmt.Errorf("tls: unsupported public key type %T in certificate", pubKey)
+	}
+	return nil
+}
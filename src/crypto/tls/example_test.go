@@ -111,5 +111,8 @@ func ExampleConfig_keyLogWriter() {
 	// preferences.
 
 	// Output:
-	// CLIENT_RANDOM 0000000000000000000000000000000000000000000000000000000000000000 baca0df460a688e44ce018b025183cc2353ae01f89755ef766eedd3ecc302888ee3b3a22962e45f48c20df15a98c0e80
+	// CLIENT_HANDSHAKE_TRAFFIC_SECRET 0000000000000000000000000000000000000000000000000000000000000000 e7d74c91670d33ff3e2a4aeeb02a76c3519d6ffa07d773c1cd5c4a898503c8aa
+	// SERVER_HANDSHAKE_TRAFFIC_SECRET 0000000000000000000000000000000000000000000000000000000000000000 046d75bfe68dc3c771e9925ddb55b0e250bb29c550220abc411eda929a4dae85
+	// CLIENT_TRAFFIC_SECRET_0 0000000000000000000000000000000000000000000000000000000000000000 5ed4a14c4a1ff5233337a2bd252d920e20f6fab586cdaac65275cf49d7382ae8
+	// SERVER_TRAFFIC_SECRET_0 0000000000000000000000000000000000000000000000000000000000000000 ca84377c05d11f096c00380f4a657874ec78af3a5c546a97ac343a13b296f2c1
 }
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/subtle"
 	"crypto/x509"
@@ -17,6 +18,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type clientHandshakeState struct {
@@ -29,26 +31,36 @@ type clientHandshakeState struct {
 	session      *ClientSessionState
 }
 
-func makeClientHello(config *Config) (*clientHelloMsg, error) {
+func (c *Conn) makeClientHello() (*clientHelloMsg, ecdheParameters, error) {
+	config := c.config
 	if len(config.ServerName) == 0 && !config.InsecureSkipVerify {
-		return nil, errors.New("tls: either ServerName or InsecureSkipVerify must be specified in the tls.Config")
+		return nil, nil, errors.New("tls: either ServerName or InsecureSkipVerify must be specified in the tls.Config")
 	}
 
 	nextProtosLength := 0
 	for _, proto := range config.NextProtos {
 		if l := len(proto); l == 0 || l > 255 {
-			return nil, errors.New("tls: invalid NextProtos value")
+			return nil, nil, errors.New("tls: invalid NextProtos value")
 		} else {
 			nextProtosLength += 1 + l
 		}
 	}
 
 	if nextProtosLength > 0xffff {
-		return nil, errors.New("tls: NextProtos values too large")
+		return nil, nil, errors.New("tls: NextProtos values too large")
+	}
+
+	// TLS 1.3 is negotiated through the supported_versions extension, and
+	// the legacy version field is frozen at TLS 1.2. Renegotiation only
+	// exists up to TLS 1.2, so a renegotiation never offers TLS 1.3.
+	maxVers := config.maxVersion()
+	offerTLS13 := maxVers >= VersionTLS13 && c.handshakes == 0
+	if maxVers > VersionTLS12 {
+		maxVers = VersionTLS12
 	}
 
 	hello := &clientHelloMsg{
-		vers:                         config.maxVersion(),
+		vers:                         maxVers,
 		compressionMethods:           []uint8{compressionNone},
 		random:                       make([]byte, 32),
 		ocspStapling:                 true,
@@ -81,14 +93,42 @@ NextCipherSuite:
 
 	_, err := io.ReadFull(config.rand(), hello.random)
 	if err != nil {
-		return nil, errors.New("tls: short read from Rand: " + err.Error())
+		return nil, nil, errors.New("tls: short read from Rand: " + err.Error())
 	}
 
 	if hello.vers >= VersionTLS12 {
 		hello.supportedSignatureAlgorithms = supportedSignatureAlgorithms
+		if config.testingOnlySignatureAlgorithms != nil {
+			hello.supportedSignatureAlgorithms = config.testingOnlySignatureAlgorithms
+		}
+	}
+
+	if !offerTLS13 {
+		return hello, nil, nil
+	}
+
+	hello.supportedVersions = config.supportedVersions()
+	hello.supportedSignatureAlgorithms = supportedSignatureAlgorithmsTLS13
+	hello.cipherSuites = append(hello.cipherSuites, defaultCipherSuitesTLS13()...)
+
+	// A non-empty session ID puts the handshake in middlebox
+	// compatibility mode. See RFC 8446, Appendix D.4.
+	hello.sessionId = make([]byte, 32)
+	if _, err := io.ReadFull(config.rand(), hello.sessionId); err != nil {
+		return nil, nil, errors.New("tls: short read from Rand: " + err.Error())
 	}
 
-	return hello, nil
+	curveID := config.curvePreferences()[0]
+	if _, ok := curveForCurveID(curveID); curveID != X25519 && !ok {
+		return nil, nil, errors.New("tls: CurvePreferences includes unsupported curve")
+	}
+	params, err := generateECDHEParameters(config.rand(), curveID)
+	if err != nil {
+		return nil, nil, err
+	}
+	hello.keyShares = []keyShare{{group: curveID, data: params.PublicKey()}}
+
+	return hello, params, nil
 }
 
 // c.out.Mutex <= L; c.handshakeMutex <= L.
@@ -101,7 +141,7 @@ func (c *Conn) clientHandshake() error {
 	// need to be reset.
 	c.didResume = false
 
-	hello, err := makeClientHello(c.config)
+	hello, ecdheParams, err := c.makeClientHello()
 	if err != nil {
 		return err
 	}
@@ -119,6 +159,9 @@ func (c *Conn) clientHandshake() error {
 
 	if sessionCache != nil {
 		hello.ticketSupported = true
+		if len(hello.supportedVersions) > 0 {
+			hello.pskModes = []uint8{pskModeDHE}
+		}
 	}
 
 	// Session resumption is not allowed if renegotiating because
@@ -142,27 +185,93 @@ func (c *Conn) clientHandshake() error {
 
 			versOk := candidateSession.vers >= c.config.minVersion() &&
 				candidateSession.vers <= c.config.maxVersion()
+			if candidateSession.vers == VersionTLS13 {
+				// TLS 1.3 tickets carry their own lifetime.
+				versOk = versOk && len(hello.supportedVersions) > 0 &&
+					c.config.time().Before(candidateSession.useBy)
+			}
 			if versOk && cipherSuiteOk {
 				session = candidateSession
 			}
 		}
 	}
 
-	if session != nil {
+	var earlySecret, binderKey []byte
+	if session != nil && session.vers == VersionTLS13 {
+		// In TLS 1.3 the ticket is offered as a pre-shared key, bound
+		// to the ClientHello by a binder. See RFC 8446, Section 4.2.11.
+		suite := cipherSuiteTLS13ByID(session.cipherSuite)
+		ticketAge := uint32(c.config.time().Sub(session.receivedAt) / time.Millisecond)
+		hello.pskIdentities = []pskIdentity{{
+			label:               session.sessionTicket,
+			obfuscatedTicketAge: ticketAge + session.ageAdd,
+		}}
+		hello.pskBinders = [][]byte{make([]byte, suite.hash.Size())}
+
+		psk := suite.expandLabel(session.masterSecret, "resumption", session.nonce, suite.hash.Size())
+		earlySecret = suite.extract(psk, nil)
+		binderKey = suite.deriveSecret(earlySecret, resumptionBinderLabel, nil)
+		transcript := suite.hash.New()
+		transcript.Write(hello.marshalWithoutBinders())
+		hello.updateBinders([][]byte{suite.finishedHash(binderKey, transcript)})
+	} else if session != nil {
 		hello.sessionTicket = session.sessionTicket
 		// A random session ID is used to detect when the
 		// server accepted the ticket and is resuming a session
-		// (see RFC 5077).
-		hello.sessionId = make([]byte, 16)
-		if _, err := io.ReadFull(c.config.rand(), hello.sessionId); err != nil {
-			return errors.New("tls: short read from Rand: " + err.Error())
+		// (see RFC 5077). When offering TLS 1.3 one is already set.
+		if len(hello.sessionId) == 0 {
+			hello.sessionId = make([]byte, 16)
+			if _, err := io.ReadFull(c.config.rand(), hello.sessionId); err != nil {
+				return errors.New("tls: short read from Rand: " + err.Error())
+			}
 		}
 	}
 
+	// send ClientHello
+	if _, err := c.writeRecord(recordTypeHandshake, hello.marshal()); err != nil {
+		return err
+	}
+
+	msg, err := c.readHandshake()
+	if err != nil {
+		return err
+	}
+
+	serverHello, ok := msg.(*serverHelloMsg)
+	if !ok {
+		c.sendAlert(alertUnexpectedMessage)
+		return unexpectedMessageError(serverHello, msg)
+	}
+
+	if err := c.pickTLSVersion(hello, serverHello); err != nil {
+		return err
+	}
+
+	if c.vers == VersionTLS13 {
+		hs := &clientHandshakeStateTLS13{
+			c:           c,
+			serverHello: serverHello,
+			hello:       hello,
+			ecdheParams: ecdheParams,
+			session:     session,
+			earlySecret: earlySecret,
+			binderKey:   binderKey,
+		}
+
+		// In TLS 1.3, session tickets are delivered after the
+		// handshake and cached by handleNewSessionTicket.
+		return hs.handshake()
+	}
+
+	if session != nil && session.vers == VersionTLS13 {
+		session = nil
+	}
+
 	hs := &clientHandshakeState{
-		c:       c,
-		hello:   hello,
-		session: session,
+		c:           c,
+		serverHello: serverHello,
+		hello:       hello,
+		session:     session,
 	}
 
 	if err = hs.handshake(); err != nil {
@@ -178,32 +287,57 @@ func (c *Conn) clientHandshake() error {
 	return nil
 }
 
-// Does the handshake, either a full one or resumes old session.
-// Requires hs.c, hs.hello, and, optionally, hs.session to be set.
-func (hs *clientHandshakeState) handshake() error {
-	c := hs.c
-
-	// send ClientHello
-	if _, err := c.writeRecord(recordTypeHandshake, hs.hello.marshal()); err != nil {
-		return err
+// pickTLSVersion sets c.vers from the version selected in serverHello, either
+// through the TLS 1.3 supported_versions extension or the legacy version
+// field.
+func (c *Conn) pickTLSVersion(hello *clientHelloMsg, serverHello *serverHelloMsg) error {
+	var vers uint16
+	var ok bool
+	if serverHello.supportedVersion != 0 {
+		for _, v := range hello.supportedVersions {
+			if v == serverHello.supportedVersion && v >= VersionTLS13 {
+				vers, ok = v, true
+				break
+			}
+		}
+		if !ok || serverHello.vers != VersionTLS12 {
+			c.sendAlert(alertIllegalParameter)
+			return fmt.Errorf("tls: server selected unsupported protocol version %x", serverHello.supportedVersion)
+		}
+	} else {
+		vers, ok = c.config.mutualVersion(serverHello.vers)
+		if !ok || vers < VersionTLS10 || serverHello.vers > VersionTLS12 {
+			// TLS 1.0 is the minimum version supported as a client.
+			c.sendAlert(alertProtocolVersion)
+			return fmt.Errorf("tls: server selected unsupported protocol version %x", serverHello.vers)
+		}
+
+		// A server that supports TLS 1.3 signals in its random that it
+		// was asked to negotiate a lower version, which protects
+		// against downgrade attacks. See RFC 8446, Section 4.1.3.
+		if len(hello.supportedVersions) > 0 {
+			canary := string(serverHello.random[24:])
+			if (vers == VersionTLS12 && canary == downgradeCanaryTLS12) ||
+				(vers <= VersionTLS11 && canary == downgradeCanaryTLS11) {
+				c.sendAlert(alertIllegalParameter)
+				return errors.New("tls: downgrade attempt detected, possibly due to a MitM attack or a broken middlebox")
+			}
+		}
 	}
 
-	msg, err := c.readHandshake()
-	if err != nil {
-		return err
-	}
+	c.vers = vers
+	c.haveVers = true
 
-	var ok bool
-	if hs.serverHello, ok = msg.(*serverHelloMsg); !ok {
-		c.sendAlert(alertUnexpectedMessage)
-		return unexpectedMessageError(hs.serverHello, msg)
-	}
+	return nil
+}
 
-	if err = hs.pickTLSVersion(); err != nil {
-		return err
-	}
+// Does the handshake, either a full one or resumes old session.
+// Requires hs.c, hs.hello, hs.serverHello, and, optionally, hs.session to be
+// set.
+func (hs *clientHandshakeState) handshake() error {
+	c := hs.c
 
-	if err = hs.pickCipherSuite(); err != nil {
+	if err := hs.pickCipherSuite(); err != nil {
 		return err
 	}
 
@@ -271,20 +405,6 @@ func (hs *clientHandshakeState) handshake() error {
 	return nil
 }
 
-func (hs *clientHandshakeState) pickTLSVersion() error {
-	vers, ok := hs.c.config.mutualVersion(hs.serverHello.vers)
-	if !ok || vers < VersionTLS10 {
-		// TLS 1.0 is the minimum version supported as a client.
-		hs.c.sendAlert(alertProtocolVersion)
-		return fmt.Errorf("tls: server selected unsupported protocol version %x", hs.serverHello.vers)
-	}
-
-	hs.c.vers = vers
-	hs.c.haveVers = true
-
-	return nil
-}
-
 func (hs *clientHandshakeState) pickCipherSuite() error {
 	if hs.suite = mutualCipherSuite(hs.hello.cipherSuites, hs.serverHello.cipherSuite); hs.suite == nil {
 		hs.c.sendAlert(alertHandshakeFailure)
@@ -312,53 +432,9 @@ func (hs *clientHandshakeState) doFullHandshake() error {
 	if c.handshakes == 0 {
 		// If this is the first handshake on a connection, process and
 		// (optionally) verify the server's certificates.
-		certs := make([]*x509.Certificate, len(certMsg.certificates))
-		for i, asn1Data := range certMsg.certificates {
-			cert, err := x509.ParseCertificate(asn1Data)
-			if err != nil {
-				c.sendAlert(alertBadCertificate)
-				return errors.New("tls: failed to parse certificate from server: " + err.Error())
-			}
-			certs[i] = cert
-		}
-
-		if !c.config.InsecureSkipVerify {
-			opts := x509.VerifyOptions{
-				Roots:         c.config.RootCAs,
-				CurrentTime:   c.config.time(),
-				DNSName:       c.config.ServerName,
-				Intermediates: x509.NewCertPool(),
-			}
-
-			for i, cert := range certs {
-				if i == 0 {
-					continue
-				}
-				opts.Intermediates.AddCert(cert)
-			}
-			c.verifiedChains, err = certs[0].Verify(opts)
-			if err != nil {
-				c.sendAlert(alertBadCertificate)
-				return err
-			}
-		}
-
-		if c.config.VerifyPeerCertificate != nil {
-			if err := c.config.VerifyPeerCertificate(certMsg.certificates, c.verifiedChains); err != nil {
-				c.sendAlert(alertBadCertificate)
-				return err
-			}
-		}
-
-		switch certs[0].PublicKey.(type) {
-		case *rsa.PublicKey, *ecdsa.PublicKey:
-			break
-		default:
-			c.sendAlert(alertUnsupportedCertificate)
-			return fmt.Errorf("tls: server's certificate contains an unsupported type of public key: %T", certs[0].PublicKey)
+		if err := c.verifyServerCertificate(certMsg.certificates); err != nil {
+			return err
 		}
-
-		c.peerCertificates = certs
 	} else {
 		// This is a renegotiation handshake. We require that the
 		// server's identity (i.e. leaf certificate) is unchanged and
@@ -426,7 +502,7 @@ func (hs *clientHandshakeState) doFullHandshake() error {
 		certRequested = true
 		hs.finishedHash.Write(certReq.marshal())
 
-		if chainToSend, err = hs.getCertificate(certReq); err != nil {
+		if chainToSend, err = c.getClientCertificate(certReq); err != nil {
 			c.sendAlert(alertInternalError)
 			return err
 		}
@@ -483,6 +559,12 @@ func (hs *clientHandshakeState) doFullHandshake() error {
 		switch key.Public().(type) {
 		case *ecdsa.PublicKey:
 			signatureType = signatureECDSA
+		case ed25519.PublicKey:
+			if !certVerify.hasSignatureAndHash {
+				c.sendAlert(alertInternalError)
+				return errors.New("tls: Ed25519 client certificates require TLS 1.2 or later")
+			}
+			signatureType = signatureEd25519
 		case *rsa.PublicKey:
 			signatureType = signatureRSA
 		default:
@@ -516,7 +598,7 @@ func (hs *clientHandshakeState) doFullHandshake() error {
 	}
 
 	hs.masterSecret = masterFromPreMasterSecret(c.vers, hs.suite, preMasterSecret, hs.hello.random, hs.serverHello.random)
-	if err := c.config.writeKeyLog(hs.hello.random, hs.masterSecret); err != nil {
+	if err := c.config.writeKeyLog(keyLogLabelTLS12, hs.hello.random, hs.masterSecret); err != nil {
 		c.sendAlert(alertInternalError)
 		return errors.New("tls: failed to write to key log: " + err.Error())
 	}
@@ -715,6 +797,61 @@ func (hs *clientHandshakeState) sendFinished(out []byte) error {
 	return nil
 }
 
+// verifyServerCertificate parses and verifies the provided chain, setting
+// c.verifiedChains and c.peerCertificates or sending the appropriate alert.
+func (c *Conn) verifyServerCertificate(certificates [][]byte) error {
+	certs := make([]*x509.Certificate, len(certificates))
+	for i, asn1Data := range certificates {
+		cert, err := x509.ParseCertificate(asn1Data)
+		if err != nil {
+			c.sendAlert(alertBadCertificate)
+			return errors.New("tls: failed to parse certificate from server: " + err.Error())
+		}
+		certs[i] = cert
+	}
+
+	if !c.config.InsecureSkipVerify {
+		opts := x509.VerifyOptions{
+			Roots:         c.config.RootCAs,
+			CurrentTime:   c.config.time(),
+			DNSName:       c.config.ServerName,
+			Intermediates: x509.NewCertPool(),
+		}
+
+		for i, cert := range certs {
+			if i == 0 {
+				continue
+			}
+			opts.Intermediates.AddCert(cert)
+		}
+		var err error
+		c.verifiedChains, err = certs[0].Verify(opts)
+		if err != nil {
+			c.sendAlert(alertBadCertificate)
+			return err
+		}
+	}
+
+	if c.config.VerifyPeerCertificate != nil {
+		if err := c.config.VerifyPeerCertificate(certificates, c.verifiedChains); err != nil {
+			c.sendAlert(alertBadCertificate)
+			return err
+		}
+	}
+
+	switch certs[0].PublicKey.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		break
+	default:
+		c.sendAlert(alertUnsupportedCertificate)
+		return fmt.Errorf("tls: server's certificate contains an unsupported type of public key: %T", certs[0].PublicKey)
+	}
+
+	c.peerCertificates = certs
+
+	return nil
+}
+
 // tls11SignatureSchemes contains the signature schemes that we synthesise for
 // a TLS <= 1.1 connection, based on the supported certificate types.
 var tls11SignatureSchemes = []SignatureScheme{ECDSAWithP256AndSHA256, ECDSAWithP384AndSHA384, ECDSAWithP521AndSHA512, PKCS1WithSHA256, PKCS1WithSHA384, PKCS1WithSHA512, PKCS1WithSHA1}
@@ -728,9 +865,7 @@ const (
 	tls11SignatureSchemesNumRSA = 4
 )
 
-func (hs *clientHandshakeState) getCertificate(certReq *certificateRequestMsg) (*Certificate, error) {
-	c := hs.c
-
+func (c *Conn) getClientCertificate(certReq *certificateRequestMsg) (*Certificate, error) {
 	var rsaAvail, ecdsaAvail bool
 	for _, certType := range certReq.certificateTypes {
 		switch certType {
@@ -801,6 +936,7 @@ findCert:
 			switch {
 			case rsaAvail && x509Cert.PublicKeyAlgorithm == x509.RSA:
 			case ecdsaAvail && x509Cert.PublicKeyAlgorithm == x509.ECDSA:
+			case ecdsaAvail && x509Cert.PublicKeyAlgorithm == x509.Ed25519 && certReq.hasSignatureAndHash:
 			default:
 				continue findCert
 			}
@@ -7,6 +7,7 @@ package tls
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
@@ -163,21 +164,28 @@ func (test *clientTest) connFromCommand() (conn *recordingConn, child *exec.Cmd,
 	var derBytes []byte
 	switch key := key.(type) {
 	case *rsa.PrivateKey:
-		pemType = "RSA"
+		pemType = "RSA PRIVATE KEY"
 		derBytes = x509.MarshalPKCS1PrivateKey(key)
 	case *ecdsa.PrivateKey:
-		pemType = "EC"
+		pemType = "EC PRIVATE KEY"
 		var err error
 		derBytes, err = x509.MarshalECPrivateKey(key)
 		if err != nil {
 			panic(err)
 		}
+	case ed25519.PrivateKey:
+		pemType = "PRIVATE KEY"
+		var err error
+		derBytes, err = x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			panic(err)
+		}
 	default:
 		panic("unknown key type")
 	}
 
 	var pemOut bytes.Buffer
-	pem.Encode(&pemOut, &pem.Block{Type: pemType + " PRIVATE KEY", Bytes: derBytes})
+	pem.Encode(&pemOut, &pem.Block{Type: pemType, Bytes: derBytes})
 
 	keyPath := tempFile(string(pemOut.Bytes()))
 	defer os.Remove(keyPath)
@@ -463,10 +471,33 @@ func runClientTestTLS12(t *testing.T, template *clientTest) {
 	runClientTestForVersion(t, template, "TLSv12-", "-tls1_2")
 }
 
+func runClientTestTLS13(t *testing.T, template *clientTest) {
+	// testConfig caps the version at TLS 1.2 so that the older recordings
+	// stay valid; TLS 1.3 tests need it raised.
+	test := *template
+	if test.config == nil {
+		test.config = testConfig
+	}
+	test.config = test.config.Clone()
+	test.config.MaxVersion = VersionTLS13
+	runClientTestForVersion(t, &test, "TLSv13-", "-tls1_3")
+}
+
 func TestHandshakeClientRSARC4(t *testing.T) {
+	// These recordings predate Ed25519 support and cannot be made again
+	// against an OpenSSL built without enable-weak-ssl-ciphers, so the
+	// client advertises the signature algorithms it advertised when they
+	// were made.
+	config := testConfig.Clone()
+	for _, alg := range supportedSignatureAlgorithms {
+		if alg != Ed25519 {
+			config.testingOnlySignatureAlgorithms = append(config.testingOnlySignatureAlgorithms, alg)
+		}
+	}
 	test := &clientTest{
 		name:    "RSA-RC4",
 		command: []string{"openssl", "s_server", "-cipher", "RC4-SHA"},
+		config:  config,
 	}
 	runClientTestTLS10(t, test)
 	runClientTestTLS11(t, test)
@@ -521,6 +552,17 @@ func TestHandshakeClientECDHEECDSAAESGCM(t *testing.T) {
 	runClientTestTLS12(t, test)
 }
 
+func TestHandshakeClientEd25519(t *testing.T) {
+	test := &clientTest{
+		name:    "Ed25519",
+		command: []string{"openssl", "s_server"},
+		cert:    testEd25519Certificate,
+		key:     testEd25519PrivateKey,
+	}
+	runClientTestTLS12(t, test)
+	runClientTestTLS13(t, test)
+}
+
 func TestHandshakeClientAES256GCMSHA384(t *testing.T) {
 	test := &clientTest{
 		name:    "ECDHE-ECDSA-AES256-GCM-SHA384",
@@ -598,6 +640,60 @@ func TestHandshakeClientECDHEECDSAChaCha20(t *testing.T) {
 	runClientTestTLS12(t, test)
 }
 
+func TestHandshakeClientTLS13AES128GCM(t *testing.T) {
+	test := &clientTest{
+		name:    "AES128-SHA256",
+		command: []string{"openssl", "s_server", "-ciphersuites", "TLS_AES_128_GCM_SHA256"},
+	}
+	runClientTestTLS13(t, test)
+}
+
+func TestHandshakeClientTLS13AES256GCM(t *testing.T) {
+	test := &clientTest{
+		name:    "AES256-SHA384",
+		command: []string{"openssl", "s_server", "-ciphersuites", "TLS_AES_256_GCM_SHA384"},
+	}
+	runClientTestTLS13(t, test)
+}
+
+func TestHandshakeClientTLS13ChaCha20(t *testing.T) {
+	test := &clientTest{
+		name:    "CHACHA20-SHA256",
+		command: []string{"openssl", "s_server", "-ciphersuites", "TLS_CHACHA20_POLY1305_SHA256"},
+	}
+	runClientTestTLS13(t, test)
+}
+
+func TestHandshakeClientTLS13ECDSA(t *testing.T) {
+	test := &clientTest{
+		name:    "ECDSA",
+		command: []string{"openssl", "s_server"},
+		cert:    testECDSACertificate,
+		key:     testECDSAPrivateKey,
+	}
+	runClientTestTLS13(t, test)
+}
+
+func TestHandshakeClientTLS13HelloRetryRequest(t *testing.T) {
+	config := testConfig.Clone()
+	config.CurvePreferences = []CurveID{X25519, CurveP256}
+
+	test := &clientTest{
+		name: "HelloRetryRequest",
+		// The client's X25519 key share is not acceptable to the
+		// server, which has to ask for a P-256 one.
+		command: []string{"openssl", "s_server", "-curves", "P-256"},
+		config:  config,
+		validate: func(state ConnectionState) error {
+			if state.Version != VersionTLS13 {
+				return fmt.Errorf("got version %x, wanted %x", state.Version, VersionTLS13)
+			}
+			return nil
+		},
+	}
+	runClientTestTLS13(t, test)
+}
+
 func TestHandshakeClientCertRSA(t *testing.T) {
 	config := testConfig.Clone()
 	cert, _ := X509KeyPair([]byte(clientCertificatePEM), []byte(clientKeyPEM))
@@ -612,6 +708,14 @@ func TestHandshakeClientCertRSA(t *testing.T) {
 	runClientTestTLS10(t, test)
 	runClientTestTLS12(t, test)
 
+	test = &clientTest{
+		name:    "ClientCert-RSA-RSA",
+		command: []string{"openssl", "s_server", "-verify", "1"},
+		config:  config,
+	}
+
+	runClientTestTLS13(t, test)
+
 	test = &clientTest{
 		name:    "ClientCert-RSA-ECDSA",
 		command: []string{"openssl", "s_server", "-cipher", "ECDHE-ECDSA-AES128-SHA", "-verify", "1"},
@@ -648,6 +752,14 @@ func TestHandshakeClientCertECDSA(t *testing.T) {
 	runClientTestTLS10(t, test)
 	runClientTestTLS12(t, test)
 
+	test = &clientTest{
+		name:    "ClientCert-ECDSA-RSA",
+		command: []string{"openssl", "s_server", "-verify", "1"},
+		config:  config,
+	}
+
+	runClientTestTLS13(t, test)
+
 	test = &clientTest{
 		name:    "ClientCert-ECDSA-ECDSA",
 		command: []string{"openssl", "s_server", "-cipher", "ECDHE-ECDSA-AES128-SHA", "-verify", "1"},
@@ -660,8 +772,35 @@ func TestHandshakeClientCertECDSA(t *testing.T) {
 	runClientTestTLS12(t, test)
 }
 
+func TestHandshakeClientCertEd25519(t *testing.T) {
+	config := testConfig.Clone()
+	cert, err := X509KeyPair([]byte(clientEd25519CertificatePEM), []byte(clientEd25519KeyPEM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Certificates = []Certificate{cert}
+
+	test := &clientTest{
+		name:    "ClientCert-Ed25519-RSA",
+		command: []string{"openssl", "s_server", "-verify", "1"},
+		config:  config,
+	}
+
+	runClientTestTLS12(t, test)
+	runClientTestTLS13(t, test)
+}
+
 func TestClientResumption(t *testing.T) {
+	testResumption(t, VersionTLS12)
+}
+
+func TestClientResumptionTLS13(t *testing.T) {
+	testResumption(t, VersionTLS13)
+}
+
+func testResumption(t *testing.T, version uint16) {
 	serverConfig := &Config{
+		MaxVersion:   version,
 		CipherSuites: []uint16{TLS_RSA_WITH_RC4_128_SHA, TLS_ECDHE_RSA_WITH_RC4_128_SHA},
 		Certificates: testConfig.Certificates,
 	}
@@ -679,6 +818,8 @@ func TestClientResumption(t *testing.T) {
 		ClientSessionCache: NewLRUClientSessionCache(32),
 		RootCAs:            rootCAs,
 		ServerName:         "example.golang",
+		// Pin the clock within the validity of the test certificate.
+		Time: func() time.Time { return time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC) },
 	}
 
 	testResumeState := func(test string, didResume bool) {
@@ -708,7 +849,8 @@ func TestClientResumption(t *testing.T) {
 	testResumeState("Handshake", false)
 	ticket := getTicket()
 	testResumeState("Resume", true)
-	if !bytes.Equal(ticket, getTicket()) {
+	// TLS 1.3 servers issue a new ticket on every connection.
+	if version != VersionTLS13 && !bytes.Equal(ticket, getTicket()) {
 		t.Fatal("first ticket doesn't match ticket after resumption")
 	}
 
@@ -730,6 +872,7 @@ func TestClientResumption(t *testing.T) {
 	// Reset serverConfig to ensure that calling SetSessionTicketKeys
 	// before the serverConfig is used works.
 	serverConfig = &Config{
+		MaxVersion:   version,
 		CipherSuites: []uint16{TLS_RSA_WITH_RC4_128_SHA, TLS_ECDHE_RSA_WITH_RC4_128_SHA},
 		Certificates: testConfig.Certificates,
 	}
@@ -737,9 +880,12 @@ func TestClientResumption(t *testing.T) {
 
 	testResumeState("FreshConfig", true)
 
-	clientConfig.CipherSuites = []uint16{TLS_ECDHE_RSA_WITH_RC4_128_SHA}
-	testResumeState("DifferentCipherSuite", false)
-	testResumeState("DifferentCipherSuiteRecovers", true)
+	// TLS 1.3 cipher suites are not configurable.
+	if version != VersionTLS13 {
+		clientConfig.CipherSuites = []uint16{TLS_ECDHE_RSA_WITH_RC4_128_SHA}
+		testResumeState("DifferentCipherSuite", false)
+		testResumeState("DifferentCipherSuiteRecovers", true)
+	}
 
 	clientConfig.ClientSessionCache = nil
 	testResumeState("WithoutSessionCache", false)
@@ -861,6 +1007,7 @@ func TestHandshakeClientALPNMatch(t *testing.T) {
 		},
 	}
 	runClientTestTLS12(t, test)
+	runClientTestTLS13(t, test)
 }
 
 // sctsBase64 contains data from `openssl s_client -serverinfo 18 -connect ritter.vg:443`
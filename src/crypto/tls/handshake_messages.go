@@ -28,6 +28,15 @@ type clientHelloMsg struct {
 	secureRenegotiation          []byte
 	secureRenegotiationSupported bool
 	alpnProtocols                []string
+
+	// TLS 1.3 extensions. See RFC 8446, Section 4.2.
+	supportedVersions []uint16
+	cookie            []byte
+	keyShares         []keyShare
+	earlyData         bool
+	pskModes          []uint8
+	pskIdentities     []pskIdentity
+	pskBinders        [][]byte
 }
 
 func (m *clientHelloMsg) equal(i interface{}) bool {
@@ -53,7 +62,14 @@ func (m *clientHelloMsg) equal(i interface{}) bool {
 		eqSignatureAlgorithms(m.supportedSignatureAlgorithms, m1.supportedSignatureAlgorithms) &&
 		m.secureRenegotiationSupported == m1.secureRenegotiationSupported &&
 		bytes.Equal(m.secureRenegotiation, m1.secureRenegotiation) &&
-		eqStrings(m.alpnProtocols, m1.alpnProtocols)
+		eqStrings(m.alpnProtocols, m1.alpnProtocols) &&
+		eqUint16s(m.supportedVersions, m1.supportedVersions) &&
+		bytes.Equal(m.cookie, m1.cookie) &&
+		eqKeyShares(m.keyShares, m1.keyShares) &&
+		m.earlyData == m1.earlyData &&
+		bytes.Equal(m.pskModes, m1.pskModes) &&
+		eqPSKIdentities(m.pskIdentities, m1.pskIdentities) &&
+		eqByteSlices(m.pskBinders, m1.pskBinders)
 }
 
 func (m *clientHelloMsg) marshal() []byte {
@@ -109,8 +125,10 @@ func (m *clientHelloMsg) marshal() []byte {
 	if m.scts {
 		numExtensions++
 	}
-	if numExtensions > 0 {
-		extensionsLength += 4 * numExtensions
+	tls13Extensions := m.marshalTLS13Extensions()
+	hasExtensions := numExtensions > 0 || len(tls13Extensions) > 0
+	if hasExtensions {
+		extensionsLength += 4*numExtensions + len(tls13Extensions)
 		length += 2 + extensionsLength
 	}
 
@@ -136,7 +154,7 @@ func (m *clientHelloMsg) marshal() []byte {
 	copy(z[1:], m.compressionMethods)
 
 	z = z[1+len(m.compressionMethods):]
-	if numExtensions > 0 {
+	if hasExtensions {
 		z[0] = byte(extensionsLength >> 8)
 		z[1] = byte(extensionsLength)
 		z = z[2:]
@@ -291,12 +309,119 @@ func (m *clientHelloMsg) marshal() []byte {
 		// zero uint16 for the zero-length extension_data
 		z = z[4:]
 	}
+	copy(z, tls13Extensions)
 
 	m.raw = x
 
 	return x
 }
 
+// marshalTLS13Extensions returns the encoding of the TLS 1.3 extensions of m,
+// including their headers. The pre_shared_key extension, if present, is
+// always last, as required by RFC 8446, Section 4.2.11.
+func (m *clientHelloMsg) marshalTLS13Extensions() []byte {
+	var x []byte
+	if len(m.supportedVersions) > 0 {
+		x = appendUint16(x, extensionSupportedVersions)
+		x = appendUint16(x, uint16(1+2*len(m.supportedVersions)))
+		x = append(x, byte(2*len(m.supportedVersions)))
+		for _, vers := range m.supportedVersions {
+			x = appendUint16(x, vers)
+		}
+	}
+	if len(m.cookie) > 0 {
+		x = appendUint16(x, extensionCookie)
+		x = appendUint16(x, uint16(2+len(m.cookie)))
+		x = appendUint16(x, uint16(len(m.cookie)))
+		x = append(x, m.cookie...)
+	}
+	if len(m.keyShares) > 0 {
+		sharesLen := 0
+		for _, ks := range m.keyShares {
+			sharesLen += 2 + 2 + len(ks.data)
+		}
+		x = appendUint16(x, extensionKeyShare)
+		x = appendUint16(x, uint16(2+sharesLen))
+		x = appendUint16(x, uint16(sharesLen))
+		for _, ks := range m.keyShares {
+			x = appendUint16(x, uint16(ks.group))
+			x = appendUint16(x, uint16(len(ks.data)))
+			x = append(x, ks.data...)
+		}
+	}
+	if m.earlyData {
+		x = appendUint16(x, extensionEarlyData)
+		x = appendUint16(x, 0)
+	}
+	if len(m.pskModes) > 0 {
+		x = appendUint16(x, extensionPSKModes)
+		x = appendUint16(x, uint16(1+len(m.pskModes)))
+		x = append(x, byte(len(m.pskModes)))
+		x = append(x, m.pskModes...)
+	}
+	if len(m.pskIdentities) > 0 {
+		identitiesLen := 0
+		for _, psk := range m.pskIdentities {
+			identitiesLen += 2 + len(psk.label) + 4
+		}
+		bindersLen := 0
+		for _, binder := range m.pskBinders {
+			bindersLen += 1 + len(binder)
+		}
+		x = appendUint16(x, extensionPreSharedKey)
+		x = appendUint16(x, uint16(2+identitiesLen+2+bindersLen))
+		x = appendUint16(x, uint16(identitiesLen))
+		for _, psk := range m.pskIdentities {
+			x = appendUint16(x, uint16(len(psk.label)))
+			x = append(x, psk.label...)
+			x = appendUint32(x, psk.obfuscatedTicketAge)
+		}
+		x = appendUint16(x, uint16(bindersLen))
+		for _, binder := range m.pskBinders {
+			x = append(x, byte(len(binder)))
+			x = append(x, binder...)
+		}
+	}
+	return x
+}
+
+// marshalWithoutBinders returns the ClientHello through the
+// PreSharedKeyExtension.identities field, according to RFC 8446, Section
+// 4.2.11.2. Note that m.pskBinders must be set to slices of the correct length.
+func (m *clientHelloMsg) marshalWithoutBinders() []byte {
+	bindersLen := 2 // uint16 length prefix
+	for _, binder := range m.pskBinders {
+		bindersLen += 1 // uint8 length prefix
+		bindersLen += len(binder)
+	}
+
+	fullMessage := m.marshal()
+	return fullMessage[:len(fullMessage)-bindersLen]
+}
+
+// updateBinders updates the m.pskBinders field, if necessary updating the
+// cached marshalled representation. The supplied binders must have the same
+// length as the current m.pskBinders.
+func (m *clientHelloMsg) updateBinders(pskBinders [][]byte) {
+	if len(pskBinders) != len(m.pskBinders) {
+		panic("tls: internal error: pskBinders length mismatch")
+	}
+	for i := range m.pskBinders {
+		if len(pskBinders[i]) != len(m.pskBinders[i]) {
+			panic("tls: internal error: pskBinders length mismatch")
+		}
+	}
+	m.pskBinders = pskBinders
+	if m.raw != nil {
+		z := m.raw[len(m.marshalWithoutBinders())+2:]
+		for _, binder := range m.pskBinders {
+			z[0] = byte(len(binder))
+			copy(z[1:], binder)
+			z = z[1+len(binder):]
+		}
+	}
+}
+
 func (m *clientHelloMsg) unmarshal(data []byte) bool {
 	if len(data) < 42 {
 		return false
@@ -347,6 +472,13 @@ func (m *clientHelloMsg) unmarshal(data []byte) bool {
 	m.supportedSignatureAlgorithms = nil
 	m.alpnProtocols = nil
 	m.scts = false
+	m.supportedVersions = nil
+	m.cookie = nil
+	m.keyShares = nil
+	m.earlyData = false
+	m.pskModes = nil
+	m.pskIdentities = nil
+	m.pskBinders = nil
 
 	if len(data) == 0 {
 		// ClientHello is optionally followed by extension data
@@ -496,6 +628,85 @@ func (m *clientHelloMsg) unmarshal(data []byte) bool {
 			if length != 0 {
 				return false
 			}
+		case extensionSupportedVersions:
+			// RFC 8446, Section 4.2.1
+			d, rest, ok := readUint8LengthPrefixed(data[:length])
+			if !ok || len(rest) != 0 || len(d) == 0 || len(d)%2 != 0 {
+				return false
+			}
+			for ; len(d) > 0; d = d[2:] {
+				m.supportedVersions = append(m.supportedVersions, uint16(d[0])<<8|uint16(d[1]))
+			}
+		case extensionCookie:
+			// RFC 8446, Section 4.2.2
+			d, rest, ok := readUint16LengthPrefixed(data[:length])
+			if !ok || len(rest) != 0 || len(d) == 0 {
+				return false
+			}
+			m.cookie = d
+		case extensionKeyShare:
+			// RFC 8446, Section 4.2.8
+			d, rest, ok := readUint16LengthPrefixed(data[:length])
+			if !ok || len(rest) != 0 {
+				return false
+			}
+			for len(d) > 0 {
+				if len(d) < 2 {
+					return false
+				}
+				var ks keyShare
+				ks.group = CurveID(d[0])<<8 | CurveID(d[1])
+				ks.data, d, ok = readUint16LengthPrefixed(d[2:])
+				if !ok || len(ks.data) == 0 {
+					return false
+				}
+				m.keyShares = append(m.keyShares, ks)
+			}
+		case extensionEarlyData:
+			// RFC 8446, Section 4.2.10
+			if length != 0 {
+				return false
+			}
+			m.earlyData = true
+		case extensionPSKModes:
+			// RFC 8446, Section 4.2.9
+			d, rest, ok := readUint8LengthPrefixed(data[:length])
+			if !ok || len(rest) != 0 {
+				return false
+			}
+			m.pskModes = d
+		case extensionPreSharedKey:
+			// RFC 8446, Section 4.2.11
+			if len(data) != length {
+				return false // pre_shared_key must be the last extension
+			}
+			identities, rest, ok := readUint16LengthPrefixed(data)
+			if !ok || len(identities) == 0 {
+				return false
+			}
+			for len(identities) > 0 {
+				var psk pskIdentity
+				psk.label, identities, ok = readUint16LengthPrefixed(identities)
+				if !ok || len(psk.label) == 0 || len(identities) < 4 {
+					return false
+				}
+				psk.obfuscatedTicketAge = uint32(identities[0])<<24 | uint32(identities[1])<<16 |
+					uint32(identities[2])<<8 | uint32(identities[3])
+				identities = identities[4:]
+				m.pskIdentities = append(m.pskIdentities, psk)
+			}
+			binders, rest, ok := readUint16LengthPrefixed(rest)
+			if !ok || len(rest) != 0 || len(binders) == 0 {
+				return false
+			}
+			for len(binders) > 0 {
+				var binder []byte
+				binder, binders, ok = readUint8LengthPrefixed(binders)
+				if !ok || len(binder) == 0 {
+					return false
+				}
+				m.pskBinders = append(m.pskBinders, binder)
+			}
 		}
 		data = data[length:]
 	}
@@ -518,6 +729,16 @@ type serverHelloMsg struct {
 	secureRenegotiation          []byte
 	secureRenegotiationSupported bool
 	alpnProtocol                 string
+
+	// TLS 1.3 extensions. See RFC 8446, Section 4.2.
+	supportedVersion        uint16
+	serverShare             keyShare
+	selectedIdentityPresent bool
+	selectedIdentity        uint16
+
+	// HelloRetryRequest extensions
+	cookie        []byte
+	selectedGroup CurveID
 }
 
 func (m *serverHelloMsg) equal(i interface{}) bool {
@@ -547,7 +768,14 @@ func (m *serverHelloMsg) equal(i interface{}) bool {
 		m.ticketSupported == m1.ticketSupported &&
 		m.secureRenegotiationSupported == m1.secureRenegotiationSupported &&
 		bytes.Equal(m.secureRenegotiation, m1.secureRenegotiation) &&
-		m.alpnProtocol == m1.alpnProtocol
+		m.alpnProtocol == m1.alpnProtocol &&
+		m.supportedVersion == m1.supportedVersion &&
+		m.serverShare.group == m1.serverShare.group &&
+		bytes.Equal(m.serverShare.data, m1.serverShare.data) &&
+		m.selectedIdentityPresent == m1.selectedIdentityPresent &&
+		m.selectedIdentity == m1.selectedIdentity &&
+		bytes.Equal(m.cookie, m1.cookie) &&
+		m.selectedGroup == m1.selectedGroup
 }
 
 func (m *serverHelloMsg) marshal() []byte {
@@ -593,9 +821,11 @@ func (m *serverHelloMsg) marshal() []byte {
 		extensionsLength += 2 + sctLen
 		numExtensions++
 	}
+	tls13Extensions := m.marshalTLS13Extensions()
+	hasExtensions := numExtensions > 0 || len(tls13Extensions) > 0
 
-	if numExtensions > 0 {
-		extensionsLength += 4 * numExtensions
+	if hasExtensions {
+		extensionsLength += 4*numExtensions + len(tls13Extensions)
 		length += 2 + extensionsLength
 	}
 
@@ -615,7 +845,7 @@ func (m *serverHelloMsg) marshal() []byte {
 	z[2] = m.compressionMethod
 
 	z = z[3:]
-	if numExtensions > 0 {
+	if hasExtensions {
 		z[0] = byte(extensionsLength >> 8)
 		z[1] = byte(extensionsLength)
 		z = z[2:]
@@ -688,12 +918,48 @@ func (m *serverHelloMsg) marshal() []byte {
 			z = z[len(sct)+2:]
 		}
 	}
+	copy(z, tls13Extensions)
 
 	m.raw = x
 
 	return x
 }
 
+// marshalTLS13Extensions returns the encoding of the TLS 1.3 extensions of m,
+// including their headers.
+func (m *serverHelloMsg) marshalTLS13Extensions() []byte {
+	var x []byte
+	if m.supportedVersion != 0 {
+		x = appendUint16(x, extensionSupportedVersions)
+		x = appendUint16(x, 2)
+		x = appendUint16(x, m.supportedVersion)
+	}
+	if m.serverShare.group != 0 {
+		x = appendUint16(x, extensionKeyShare)
+		x = appendUint16(x, uint16(2+2+len(m.serverShare.data)))
+		x = appendUint16(x, uint16(m.serverShare.group))
+		x = appendUint16(x, uint16(len(m.serverShare.data)))
+		x = append(x, m.serverShare.data...)
+	}
+	if m.selectedIdentityPresent {
+		x = appendUint16(x, extensionPreSharedKey)
+		x = appendUint16(x, 2)
+		x = appendUint16(x, m.selectedIdentity)
+	}
+	if len(m.cookie) > 0 {
+		x = appendUint16(x, extensionCookie)
+		x = appendUint16(x, uint16(2+len(m.cookie)))
+		x = appendUint16(x, uint16(len(m.cookie)))
+		x = append(x, m.cookie...)
+	}
+	if m.selectedGroup != 0 {
+		x = appendUint16(x, extensionKeyShare)
+		x = appendUint16(x, 2)
+		x = appendUint16(x, uint16(m.selectedGroup))
+	}
+	return x
+}
+
 func (m *serverHelloMsg) unmarshal(data []byte) bool {
 	if len(data) < 42 {
 		return false
@@ -720,6 +986,12 @@ func (m *serverHelloMsg) unmarshal(data []byte) bool {
 	m.scts = nil
 	m.ticketSupported = false
 	m.alpnProtocol = ""
+	m.supportedVersion = 0
+	m.serverShare = keyShare{}
+	m.selectedIdentityPresent = false
+	m.selectedIdentity = 0
+	m.cookie = nil
+	m.selectedGroup = 0
 
 	if len(data) == 0 {
 		// ServerHello is optionally followed by extension data
@@ -827,6 +1099,40 @@ func (m *serverHelloMsg) unmarshal(data []byte) bool {
 				m.scts = append(m.scts, d[:sctLen])
 				d = d[sctLen:]
 			}
+		case extensionSupportedVersions:
+			if length != 2 {
+				return false
+			}
+			m.supportedVersion = uint16(data[0])<<8 | uint16(data[1])
+		case extensionKeyShare:
+			// This extension has different formats in SH and HRR, accept
+			// either and let the handshake logic decide. See RFC 8446,
+			// Section 4.2.8.
+			if length == 2 {
+				m.selectedGroup = CurveID(data[0])<<8 | CurveID(data[1])
+				break
+			}
+			if length < 2 {
+				return false
+			}
+			d, rest, ok := readUint16LengthPrefixed(data[2:length])
+			if !ok || len(rest) != 0 || len(d) == 0 {
+				return false
+			}
+			m.serverShare.group = CurveID(data[0])<<8 | CurveID(data[1])
+			m.serverShare.data = d
+		case extensionPreSharedKey:
+			if length != 2 {
+				return false
+			}
+			m.selectedIdentityPresent = true
+			m.selectedIdentity = uint16(data[0])<<8 | uint16(data[1])
+		case extensionCookie:
+			d, rest, ok := readUint16LengthPrefixed(data[:length])
+			if !ok || len(rest) != 0 || len(d) == 0 {
+				return false
+			}
+			m.cookie = d
 		}
 		data = data[length:]
 	}
@@ -1491,6 +1797,502 @@ func (m *newSessionTicketMsg) unmarshal(data []byte) bool {
 	return true
 }
 
+type encryptedExtensionsMsg struct {
+	raw          []byte
+	alpnProtocol string
+}
+
+func (m *encryptedExtensionsMsg) equal(i interface{}) bool {
+	m1, ok := i.(*encryptedExtensionsMsg)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(m.raw, m1.raw) &&
+		m.alpnProtocol == m1.alpnProtocol
+}
+
+func (m *encryptedExtensionsMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+
+	// See RFC 8446, Section 4.3.1.
+	var extensions []byte
+	if len(m.alpnProtocol) > 0 {
+		if len(m.alpnProtocol) >= 256 {
+			panic("invalid ALPN protocol")
+		}
+		extensions = appendUint16(extensions, extensionALPN)
+		extensions = appendUint16(extensions, uint16(2+1+len(m.alpnProtocol)))
+		extensions = appendUint16(extensions, uint16(1+len(m.alpnProtocol)))
+		extensions = append(extensions, byte(len(m.alpnProtocol)))
+		extensions = append(extensions, m.alpnProtocol...)
+	}
+
+	x := handshakeMessageHeader(typeEncryptedExtensions, 2+len(extensions))
+	x = appendUint16(x, uint16(len(extensions)))
+	x = append(x, extensions...)
+
+	m.raw = x
+	return x
+}
+
+func (m *encryptedExtensionsMsg) unmarshal(data []byte) bool {
+	m.raw = data
+	m.alpnProtocol = ""
+
+	if len(data) < 4 {
+		return false
+	}
+	extensions, rest, ok := readUint16LengthPrefixed(data[4:])
+	if !ok || len(rest) != 0 {
+		return false
+	}
+
+	for len(extensions) > 0 {
+		if len(extensions) < 2 {
+			return false
+		}
+		extension := uint16(extensions[0])<<8 | uint16(extensions[1])
+		var d []byte
+		d, extensions, ok = readUint16LengthPrefixed(extensions[2:])
+		if !ok {
+			return false
+		}
+
+		switch extension {
+		case extensionALPN:
+			protos, rest, ok := readUint16LengthPrefixed(d)
+			if !ok || len(rest) != 0 {
+				return false
+			}
+			proto, rest, ok := readUint8LengthPrefixed(protos)
+			if !ok || len(rest) != 0 || len(proto) == 0 {
+				return false
+			}
+			m.alpnProtocol = string(proto)
+		}
+	}
+
+	return true
+}
+
+// certificateMsgTLS13 is the TLS 1.3 Certificate message, which adds a
+// certificate_request_context and per-certificate extensions to the TLS 1.2
+// one. See RFC 8446, Section 4.4.2.
+type certificateMsgTLS13 struct {
+	raw          []byte
+	certificate  Certificate
+	ocspStapling bool
+	scts         bool
+}
+
+func (m *certificateMsgTLS13) equal(i interface{}) bool {
+	m1, ok := i.(*certificateMsgTLS13)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(m.raw, m1.raw) &&
+		eqByteSlices(m.certificate.Certificate, m1.certificate.Certificate) &&
+		bytes.Equal(m.certificate.OCSPStaple, m1.certificate.OCSPStaple) &&
+		eqByteSlices(m.certificate.SignedCertificateTimestamps, m1.certificate.SignedCertificateTimestamps) &&
+		m.ocspStapling == m1.ocspStapling &&
+		m.scts == m1.scts
+}
+
+func (m *certificateMsgTLS13) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+
+	var certificateList []byte
+	for i, cert := range m.certificate.Certificate {
+		certificateList = appendUint24(certificateList, len(cert))
+		certificateList = append(certificateList, cert...)
+
+		var extensions []byte
+		if i == 0 {
+			if m.ocspStapling {
+				// RFC 8446, Section 4.4.2.1
+				extensions = appendUint16(extensions, extensionStatusRequest)
+				extensions = appendUint16(extensions, uint16(1+3+len(m.certificate.OCSPStaple)))
+				extensions = append(extensions, statusTypeOCSP)
+				extensions = appendUint24(extensions, len(m.certificate.OCSPStaple))
+				extensions = append(extensions, m.certificate.OCSPStaple...)
+			}
+			if m.scts {
+				// RFC 8446, Section 4.4.2
+				var sctList []byte
+				for _, sct := range m.certificate.SignedCertificateTimestamps {
+					sctList = appendUint16(sctList, uint16(len(sct)))
+					sctList = append(sctList, sct...)
+				}
+				extensions = appendUint16(extensions, extensionSCT)
+				extensions = appendUint16(extensions, uint16(2+len(sctList)))
+				extensions = appendUint16(extensions, uint16(len(sctList)))
+				extensions = append(extensions, sctList...)
+			}
+		}
+		certificateList = appendUint16(certificateList, uint16(len(extensions)))
+		certificateList = append(certificateList, extensions...)
+	}
+
+	x := handshakeMessageHeader(typeCertificate, 1+3+len(certificateList))
+	x = append(x, 0) // certificate_request_context
+	x = appendUint24(x, len(certificateList))
+	x = append(x, certificateList...)
+
+	m.raw = x
+	return x
+}
+
+func (m *certificateMsgTLS13) unmarshal(data []byte) bool {
+	*m = certificateMsgTLS13{raw: data}
+
+	if len(data) < 4 {
+		return false
+	}
+	context, rest, ok := readUint8LengthPrefixed(data[4:])
+	if !ok || len(context) != 0 {
+		return false
+	}
+	certificateList, rest, ok := readUint24LengthPrefixed(rest)
+	if !ok || len(rest) != 0 {
+		return false
+	}
+
+	for len(certificateList) > 0 {
+		var cert, extensions []byte
+		cert, certificateList, ok = readUint24LengthPrefixed(certificateList)
+		if !ok || len(cert) == 0 {
+			return false
+		}
+		m.certificate.Certificate = append(m.certificate.Certificate, cert)
+		extensions, certificateList, ok = readUint16LengthPrefixed(certificateList)
+		if !ok {
+			return false
+		}
+		if len(m.certificate.Certificate) > 1 {
+			// The extensions of intermediate certificates are ignored.
+			continue
+		}
+
+		for len(extensions) > 0 {
+			if len(extensions) < 2 {
+				return false
+			}
+			extension := uint16(extensions[0])<<8 | uint16(extensions[1])
+			var d []byte
+			d, extensions, ok = readUint16LengthPrefixed(extensions[2:])
+			if !ok {
+				return false
+			}
+
+			switch extension {
+			case extensionStatusRequest:
+				if len(d) < 1 || d[0] != statusTypeOCSP {
+					return false
+				}
+				staple, rest, ok := readUint24LengthPrefixed(d[1:])
+				if !ok || len(rest) != 0 || len(staple) == 0 {
+					return false
+				}
+				m.ocspStapling = true
+				m.certificate.OCSPStaple = staple
+			case extensionSCT:
+				sctList, rest, ok := readUint16LengthPrefixed(d)
+				if !ok || len(rest) != 0 || len(sctList) == 0 {
+					return false
+				}
+				m.scts = true
+				for len(sctList) > 0 {
+					var sct []byte
+					sct, sctList, ok = readUint16LengthPrefixed(sctList)
+					if !ok || len(sct) == 0 {
+						return false
+					}
+					m.certificate.SignedCertificateTimestamps = append(
+						m.certificate.SignedCertificateTimestamps, sct)
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// certificateRequestMsgTLS13 is the TLS 1.3 CertificateRequest message, which
+// carries its parameters in extensions. See RFC 8446, Section 4.3.2.
+type certificateRequestMsgTLS13 struct {
+	raw                          []byte
+	ocspStapling                 bool
+	scts                         bool
+	supportedSignatureAlgorithms []SignatureScheme
+	certificateAuthorities       [][]byte
+}
+
+func (m *certificateRequestMsgTLS13) equal(i interface{}) bool {
+	m1, ok := i.(*certificateRequestMsgTLS13)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(m.raw, m1.raw) &&
+		m.ocspStapling == m1.ocspStapling &&
+		m.scts == m1.scts &&
+		eqSignatureAlgorithms(m.supportedSignatureAlgorithms, m1.supportedSignatureAlgorithms) &&
+		eqByteSlices(m.certificateAuthorities, m1.certificateAuthorities)
+}
+
+func (m *certificateRequestMsgTLS13) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+
+	var extensions []byte
+	if m.ocspStapling {
+		extensions = appendUint16(extensions, extensionStatusRequest)
+		extensions = appendUint16(extensions, 0)
+	}
+	if m.scts {
+		extensions = appendUint16(extensions, extensionSCT)
+		extensions = appendUint16(extensions, 0)
+	}
+	if len(m.supportedSignatureAlgorithms) > 0 {
+		extensions = appendUint16(extensions, extensionSignatureAlgorithms)
+		extensions = appendUint16(extensions, uint16(2+2*len(m.supportedSignatureAlgorithms)))
+		extensions = appendUint16(extensions, uint16(2*len(m.supportedSignatureAlgorithms)))
+		for _, sigAlgo := range m.supportedSignatureAlgorithms {
+			extensions = appendUint16(extensions, uint16(sigAlgo))
+		}
+	}
+	if len(m.certificateAuthorities) > 0 {
+		var authorities []byte
+		for _, ca := range m.certificateAuthorities {
+			authorities = appendUint16(authorities, uint16(len(ca)))
+			authorities = append(authorities, ca...)
+		}
+		extensions = appendUint16(extensions, extensionCertificateAuthorities)
+		extensions = appendUint16(extensions, uint16(2+len(authorities)))
+		extensions = appendUint16(extensions, uint16(len(authorities)))
+		extensions = append(extensions, authorities...)
+	}
+
+	x := handshakeMessageHeader(typeCertificateRequest, 1+2+len(extensions))
+	x = append(x, 0) // certificate_request_context
+	x = appendUint16(x, uint16(len(extensions)))
+	x = append(x, extensions...)
+
+	m.raw = x
+	return x
+}
+
+func (m *certificateRequestMsgTLS13) unmarshal(data []byte) bool {
+	*m = certificateRequestMsgTLS13{raw: data}
+
+	if len(data) < 4 {
+		return false
+	}
+	context, rest, ok := readUint8LengthPrefixed(data[4:])
+	if !ok || len(context) != 0 {
+		return false
+	}
+	extensions, rest, ok := readUint16LengthPrefixed(rest)
+	if !ok || len(rest) != 0 {
+		return false
+	}
+
+	for len(extensions) > 0 {
+		if len(extensions) < 2 {
+			return false
+		}
+		extension := uint16(extensions[0])<<8 | uint16(extensions[1])
+		var d []byte
+		d, extensions, ok = readUint16LengthPrefixed(extensions[2:])
+		if !ok {
+			return false
+		}
+
+		switch extension {
+		case extensionStatusRequest:
+			m.ocspStapling = true
+		case extensionSCT:
+			m.scts = true
+		case extensionSignatureAlgorithms:
+			sigAndAlgs, rest, ok := readUint16LengthPrefixed(d)
+			if !ok || len(rest) != 0 || len(sigAndAlgs) == 0 || len(sigAndAlgs)%2 != 0 {
+				return false
+			}
+			for ; len(sigAndAlgs) > 0; sigAndAlgs = sigAndAlgs[2:] {
+				m.supportedSignatureAlgorithms = append(m.supportedSignatureAlgorithms,
+					SignatureScheme(sigAndAlgs[0])<<8|SignatureScheme(sigAndAlgs[1]))
+			}
+		case extensionCertificateAuthorities:
+			auths, rest, ok := readUint16LengthPrefixed(d)
+			if !ok || len(rest) != 0 || len(auths) == 0 {
+				return false
+			}
+			for len(auths) > 0 {
+				var ca []byte
+				ca, auths, ok = readUint16LengthPrefixed(auths)
+				if !ok || len(ca) == 0 {
+					return false
+				}
+				m.certificateAuthorities = append(m.certificateAuthorities, ca)
+			}
+		}
+	}
+
+	return true
+}
+
+// newSessionTicketMsgTLS13 is the TLS 1.3 NewSessionTicket message, sent by
+// the server after the handshake. See RFC 8446, Section 4.6.1.
+type newSessionTicketMsgTLS13 struct {
+	raw          []byte
+	lifetime     uint32
+	ageAdd       uint32
+	nonce        []byte
+	label        []byte
+	maxEarlyData uint32
+}
+
+func (m *newSessionTicketMsgTLS13) equal(i interface{}) bool {
+	m1, ok := i.(*newSessionTicketMsgTLS13)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(m.raw, m1.raw) &&
+		m.lifetime == m1.lifetime &&
+		m.ageAdd == m1.ageAdd &&
+		bytes.Equal(m.nonce, m1.nonce) &&
+		bytes.Equal(m.label, m1.label) &&
+		m.maxEarlyData == m1.maxEarlyData
+}
+
+func (m *newSessionTicketMsgTLS13) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+
+	var extensions []byte
+	if m.maxEarlyData > 0 {
+		extensions = appendUint16(extensions, extensionEarlyData)
+		extensions = appendUint16(extensions, 4)
+		extensions = appendUint32(extensions, m.maxEarlyData)
+	}
+
+	length := 4 + 4 + 1 + len(m.nonce) + 2 + len(m.label) + 2 + len(extensions)
+	x := handshakeMessageHeader(typeNewSessionTicket, length)
+	x = appendUint32(x, m.lifetime)
+	x = appendUint32(x, m.ageAdd)
+	x = append(x, byte(len(m.nonce)))
+	x = append(x, m.nonce...)
+	x = appendUint16(x, uint16(len(m.label)))
+	x = append(x, m.label...)
+	x = appendUint16(x, uint16(len(extensions)))
+	x = append(x, extensions...)
+
+	m.raw = x
+	return x
+}
+
+func (m *newSessionTicketMsgTLS13) unmarshal(data []byte) bool {
+	*m = newSessionTicketMsgTLS13{raw: data}
+
+	if len(data) < 4+4+4 {
+		return false
+	}
+	d := data[4:]
+	m.lifetime = uint32(d[0])<<24 | uint32(d[1])<<16 | uint32(d[2])<<8 | uint32(d[3])
+	m.ageAdd = uint32(d[4])<<24 | uint32(d[5])<<16 | uint32(d[6])<<8 | uint32(d[7])
+	var ok bool
+	m.nonce, d, ok = readUint8LengthPrefixed(d[8:])
+	if !ok {
+		return false
+	}
+	m.label, d, ok = readUint16LengthPrefixed(d)
+	if !ok || len(m.label) == 0 {
+		return false
+	}
+	extensions, rest, ok := readUint16LengthPrefixed(d)
+	if !ok || len(rest) != 0 {
+		return false
+	}
+
+	for len(extensions) > 0 {
+		if len(extensions) < 2 {
+			return false
+		}
+		extension := uint16(extensions[0])<<8 | uint16(extensions[1])
+		var d []byte
+		d, extensions, ok = readUint16LengthPrefixed(extensions[2:])
+		if !ok {
+			return false
+		}
+
+		switch extension {
+		case extensionEarlyData:
+			if len(d) != 4 {
+				return false
+			}
+			m.maxEarlyData = uint32(d[0])<<24 | uint32(d[1])<<16 | uint32(d[2])<<8 | uint32(d[3])
+		}
+	}
+
+	return true
+}
+
+// keyUpdateMsg is the TLS 1.3 KeyUpdate message. See RFC 8446, Section 4.6.3.
+type keyUpdateMsg struct {
+	raw             []byte
+	updateRequested bool
+}
+
+func (m *keyUpdateMsg) equal(i interface{}) bool {
+	m1, ok := i.(*keyUpdateMsg)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(m.raw, m1.raw) &&
+		m.updateRequested == m1.updateRequested
+}
+
+func (m *keyUpdateMsg) marshal() []byte {
+	if m.raw != nil {
+		return m.raw
+	}
+
+	x := []byte{typeKeyUpdate, 0, 0, 1, 0}
+	if m.updateRequested {
+		x[4] = 1
+	}
+
+	m.raw = x
+	return x
+}
+
+func (m *keyUpdateMsg) unmarshal(data []byte) bool {
+	m.raw = data
+	if len(data) != 5 {
+		return false
+	}
+
+	switch data[4] {
+	case 0:
+		m.updateRequested = false
+	case 1:
+		m.updateRequested = true
+	default:
+		return false
+	}
+	return true
+}
+
 type helloRequestMsg struct {
 }
 
@@ -1561,3 +2363,84 @@ func eqSignatureAlgorithms(x, y []SignatureScheme) bool {
 	}
 	return true
 }
+
+func eqKeyShares(x, y []keyShare) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i].group != y[i].group || !bytes.Equal(x[i].data, y[i].data) {
+			return false
+		}
+	}
+	return true
+}
+
+func eqPSKIdentities(x, y []pskIdentity) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i].obfuscatedTicketAge != y[i].obfuscatedTicketAge || !bytes.Equal(x[i].label, y[i].label) {
+			return false
+		}
+	}
+	return true
+}
+
+func appendUint16(x []byte, v uint16) []byte {
+	return append(x, byte(v>>8), byte(v))
+}
+
+func appendUint24(x []byte, v int) []byte {
+	return append(x, byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint32(x []byte, v uint32) []byte {
+	return append(x, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// readUint8LengthPrefixed splits data into a body prefixed by its one-byte
+// length and the remaining bytes.
+func readUint8LengthPrefixed(data []byte) (body, rest []byte, ok bool) {
+	if len(data) < 1 {
+		return nil, nil, false
+	}
+	l := int(data[0])
+	if len(data) < 1+l {
+		return nil, nil, false
+	}
+	return data[1 : 1+l], data[1+l:], true
+}
+
+// readUint16LengthPrefixed splits data into a body prefixed by its two-byte
+// length and the remaining bytes.
+func readUint16LengthPrefixed(data []byte) (body, rest []byte, ok bool) {
+	if len(data) < 2 {
+		return nil, nil, false
+	}
+	l := int(data[0])<<8 | int(data[1])
+	if len(data) < 2+l {
+		return nil, nil, false
+	}
+	return data[2 : 2+l], data[2+l:], true
+}
+
+// readUint24LengthPrefixed splits data into a body prefixed by its three-byte
+// length and the remaining bytes.
+func readUint24LengthPrefixed(data []byte) (body, rest []byte, ok bool) {
+	if len(data) < 3 {
+		return nil, nil, false
+	}
+	l := int(data[0])<<16 | int(data[1])<<8 | int(data[2])
+	if len(data) < 3+l {
+		return nil, nil, false
+	}
+	return data[3 : 3+l], data[3+l:], true
+}
+
+// handshakeMessageHeader returns the four byte header of a handshake message
+// of type typ with a body of length bytes.
+func handshakeMessageHeader(typ uint8, length int) []byte {
+	return []byte{typ, byte(length >> 16), byte(length >> 8), byte(length)}
+}
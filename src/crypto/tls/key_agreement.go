@@ -7,6 +7,7 @@ package tls
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/md5"
 	"crypto/rsa"
@@ -114,13 +115,20 @@ func md5SHA1Hash(slices [][]byte) []byte {
 // is only used for >= TLS 1.2 and identifies the hash function to use.
 func hashForServerKeyExchange(sigType uint8, signatureAlgorithm SignatureScheme, version uint16, slices ...[]byte) ([]byte, crypto.Hash, error) {
 	if version >= VersionTLS12 {
-		if !isSupportedSignatureAlgorithm(signatureAlgorithm, supportedSignatureAlgorithms) {
+		if !isSupportedSignatureAlgorithm(signatureAlgorithm, supportedSignatureAlgorithmsTLS13) {
 			return nil, crypto.Hash(0), errors.New("tls: unsupported hash function used by peer")
 		}
 		hashFunc, err := lookupTLSHash(signatureAlgorithm)
 		if err != nil {
 			return nil, crypto.Hash(0), err
 		}
+		if hashFunc == directSigning {
+			var signed []byte
+			for _, slice := range slices {
+				signed = append(signed, slice...)
+			}
+			return signed, directSigning, nil
+		}
 		h := hashFunc.New()
 		for _, slice := range slices {
 			h.Write(slice)
@@ -247,11 +255,26 @@ NextCandidate:
 	serverECDHParams[3] = byte(len(ecdhePublic))
 	copy(serverECDHParams[4:], ecdhePublic)
 
+	priv, ok := cert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("tls: certificate private key does not implement crypto.Signer")
+	}
+
+	// Ed25519 certificates are used with the ECDHE_ECDSA cipher suites, but
+	// only in TLS 1.2 and later. See RFC 8422, Section 5.1.3.
+	sigType := ka.sigType
+	if _, ok := priv.Public().(ed25519.PublicKey); ok && ka.sigType == signatureECDSA {
+		if ka.version < VersionTLS12 {
+			return nil, errors.New("tls: Ed25519 certificates require TLS 1.2 or later")
+		}
+		sigType = signatureEd25519
+	}
+
 	var signatureAlgorithm SignatureScheme
 
 	if ka.version >= VersionTLS12 {
 		var err error
-		signatureAlgorithm, err = pickTLS12HashForSignature(ka.sigType, clientHello.supportedSignatureAlgorithms)
+		signatureAlgorithm, err = pickTLS12HashForSignature(sigType, clientHello.supportedSignatureAlgorithms)
 		if err != nil {
 			return nil, err
 		}
@@ -262,17 +285,15 @@ NextCandidate:
 		return nil, err
 	}
 
-	priv, ok := cert.PrivateKey.(crypto.Signer)
-	if !ok {
-		return nil, errors.New("tls: certificate private key does not implement crypto.Signer")
-	}
 	var sig []byte
-	switch ka.sigType {
+	switch sigType {
 	case signatureECDSA:
 		_, ok := priv.Public().(*ecdsa.PublicKey)
 		if !ok {
 			return nil, errors.New("tls: ECDHE ECDSA requires an ECDSA server key")
 		}
+	case signatureEd25519:
+		// The key type was checked above.
 	case signatureRSA:
 		_, ok := priv.Public().(*rsa.PublicKey)
 		if !ok {
@@ -377,10 +398,19 @@ func (ka *ecdheKeyAgreement) processServerKeyExchange(config *Config, clientHell
 	}
 
 	var signatureAlgorithm SignatureScheme
+	sigType := ka.sigType
 	if ka.version >= VersionTLS12 {
 		// handle SignatureAndHashAlgorithm
 		signatureAlgorithm = SignatureScheme(sig[0])<<8 | SignatureScheme(sig[1])
-		if signatureFromSignatureScheme(signatureAlgorithm) != ka.sigType {
+		if !isSupportedSignatureAlgorithm(signatureAlgorithm, clientHello.supportedSignatureAlgorithms) {
+			return errors.New("tls: server selected an unadvertised signature algorithm")
+		}
+		// A client that offered TLS 1.3 also advertises RSASSA-PSS, which
+		// a TLS 1.2 server may then use in place of PKCS #1 v1.5.
+		sigType = signatureFromSignatureScheme(signatureAlgorithm)
+		if sigType != ka.sigType &&
+			!(sigType == signatureRSAPSS && ka.sigType == signatureRSA) &&
+			!(sigType == signatureEd25519 && ka.sigType == signatureECDSA) {
 			return errServerKeyExchange
 		}
 		sig = sig[2:]
@@ -400,6 +430,16 @@ func (ka *ecdheKeyAgreement) processServerKeyExchange(config *Config, clientHell
 	}
 	switch ka.sigType {
 	case signatureECDSA:
+		if sigType == signatureEd25519 {
+			pubKey, ok := cert.PublicKey.(ed25519.PublicKey)
+			if !ok {
+				return errors.New("tls: ECDHE Ed25519 requires an Ed25519 server public key")
+			}
+			if !ed25519.Verify(pubKey, digest, sig) {
+				return errors.New("tls: Ed25519 verification failure")
+			}
+			break
+		}
 		pubKey, ok := cert.PublicKey.(*ecdsa.PublicKey)
 		if !ok {
 			return errors.New("tls: ECDHE ECDSA requires a ECDSA server public key")
@@ -419,6 +459,13 @@ func (ka *ecdheKeyAgreement) processServerKeyExchange(config *Config, clientHell
 		if !ok {
 			return errors.New("tls: ECDHE RSA requires a RSA server public key")
 		}
+		if sigType == signatureRSAPSS {
+			signOpts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}
+			if err := rsa.VerifyPSS(pubKey, hashFunc, digest, sig, signOpts); err != nil {
+				return err
+			}
+			break
+		}
 		if err := rsa.VerifyPKCS1v15(pubKey, hashFunc, digest, sig); err != nil {
 			return err
 		}
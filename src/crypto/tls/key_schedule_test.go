@@ -0,0 +1,80 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// The test vectors are from RFC 8448, Section 3 ("Simple 1-RTT Handshake").
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(strings.Replace(s, " ", "", -1))
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestKeyScheduleExtract(t *testing.T) {
+	suite := cipherSuiteTLS13ByID(TLS_AES_128_GCM_SHA256)
+
+	tests := []struct {
+		newSecret, currentSecret, want string
+	}{
+		{
+			// Early Secret, with no PSK.
+			"", "",
+			"33ad0a1c607ec03b09e6cd9893680ce210adf300aa1f2660e1b22e10f170f92a",
+		},
+		{
+			// Handshake Secret, from the derived secret and the ECDHE
+			// shared secret.
+			"8bd4054fb55b9d63fdfbacf9f04b9f0d35e6d63f537563efd46272900f89492d",
+			"6f2615a108c702c5678f54fc9dbab69716c076189c48250cebeac3576c3611ba",
+			"1dc826e93606aa6fdc0aadc12f741b01046aa6b99f691ed221a9f0ca043fbeac",
+		},
+	}
+	for i, test := range tests {
+		var newSecret, currentSecret []byte
+		if test.newSecret != "" {
+			newSecret = mustDecodeHex(test.newSecret)
+		}
+		if test.currentSecret != "" {
+			currentSecret = mustDecodeHex(test.currentSecret)
+		}
+		if got := suite.extract(newSecret, currentSecret); !bytes.Equal(got, mustDecodeHex(test.want)) {
+			t.Errorf("#%d: got %x, want %s", i, got, test.want)
+		}
+	}
+}
+
+func TestKeyScheduleDeriveSecret(t *testing.T) {
+	suite := cipherSuiteTLS13ByID(TLS_AES_128_GCM_SHA256)
+
+	earlySecret := mustDecodeHex("33ad0a1c607ec03b09e6cd9893680ce210adf300aa1f2660e1b22e10f170f92a")
+	want := mustDecodeHex("6f2615a108c702c5678f54fc9dbab69716c076189c48250cebeac3576c3611ba")
+	if got := suite.deriveSecret(earlySecret, "derived", nil); !bytes.Equal(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestKeyScheduleTrafficKey(t *testing.T) {
+	suite := cipherSuiteTLS13ByID(TLS_AES_128_GCM_SHA256)
+
+	trafficSecret := mustDecodeHex("b67b7d690cc16c4e75e54213cb2d37b4e9c912bcded9105d42befd59d391ad38")
+	wantKey := mustDecodeHex("3fce516009c21727d0f2e4e86ee403bc")
+	wantIV := mustDecodeHex("5d313eb2671276ee13000b30")
+	key, iv := suite.trafficKey(trafficSecret)
+	if !bytes.Equal(key, wantKey) {
+		t.Errorf("key: got %x, want %x", key, wantKey)
+	}
+	if !bytes.Equal(iv, wantIV) {
+		t.Errorf("iv: got %x, want %x", iv, wantIV)
+	}
+}
@@ -176,6 +176,10 @@ func keysFromMasterSecret(version uint16, suite *cipherSuite, masterSecret, clie
 	return
 }
 
+// directSigning is returned by lookupTLSHash for signature algorithms, like
+// Ed25519, that sign the message itself rather than a digest of it.
+const directSigning crypto.Hash = 0
+
 // lookupTLSHash looks up the corresponding crypto.Hash for a given
 // hash from a TLS SignatureScheme.
 func lookupTLSHash(signatureAlgorithm SignatureScheme) (crypto.Hash, error) {
@@ -188,6 +192,8 @@ func lookupTLSHash(signatureAlgorithm SignatureScheme) (crypto.Hash, error) {
 		return crypto.SHA384, nil
 	case PKCS1WithSHA512, PSSWithSHA512, ECDSAWithP521AndSHA512:
 		return crypto.SHA512, nil
+	case Ed25519:
+		return directSigning, nil
 	default:
 		return 0, fmt.Errorf("tls: unsupported signature algorithm: %#04x", signatureAlgorithm)
 	}
@@ -343,6 +349,9 @@ func (h finishedHash) hashForClientCertificate(sigType uint8, signatureAlgorithm
 		if err != nil {
 			return nil, 0, err
 		}
+		if hashAlg == directSigning {
+			return h.buffer, directSigning, nil
+		}
 		hash := hashAlg.New()
 		hash.Write(h.buffer)
 		return hash.Sum(nil), hashAlg, nil
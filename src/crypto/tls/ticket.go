@@ -13,6 +13,7 @@ import (
 	"crypto/subtle"
 	"errors"
 	"io"
+	"time"
 )
 
 // sessionState contains the information that is serialized into a session
@@ -129,8 +130,85 @@ func (s *sessionState) unmarshal(data []byte) bool {
 	return len(data) == 0
 }
 
-func (c *Conn) encryptTicket(state *sessionState) ([]byte, error) {
-	serialized := state.marshal()
+// maxSessionTicketLifetime is the maximum allowed lifetime of a TLS 1.3 session
+// ticket, and the lifetime we set for tickets we send. See RFC 8446, Section 4.6.1.
+const maxSessionTicketLifetime = 7 * 24 * time.Hour
+
+// sessionStateTLS13 is the content of a TLS 1.3 session ticket. It starts with
+// the version like sessionState, so that the two can't be confused.
+type sessionStateTLS13 struct {
+	cipherSuite      uint16
+	createdAt        uint64
+	resumptionSecret []byte
+	certificates     [][]byte
+}
+
+func (s *sessionStateTLS13) equal(i interface{}) bool {
+	s1, ok := i.(*sessionStateTLS13)
+	if !ok {
+		return false
+	}
+
+	return s.cipherSuite == s1.cipherSuite &&
+		s.createdAt == s1.createdAt &&
+		bytes.Equal(s.resumptionSecret, s1.resumptionSecret) &&
+		eqByteSlices(s.certificates, s1.certificates)
+}
+
+func (s *sessionStateTLS13) marshal() []byte {
+	var x []byte
+	x = appendUint16(x, VersionTLS13)
+	x = appendUint16(x, s.cipherSuite)
+	x = appendUint32(x, uint32(s.createdAt>>32))
+	x = appendUint32(x, uint32(s.createdAt))
+	x = append(x, byte(len(s.resumptionSecret)))
+	x = append(x, s.resumptionSecret...)
+	x = appendUint16(x, uint16(len(s.certificates)))
+	for _, cert := range s.certificates {
+		x = appendUint24(x, len(cert))
+		x = append(x, cert...)
+	}
+	return x
+}
+
+func (s *sessionStateTLS13) unmarshal(data []byte) bool {
+	*s = sessionStateTLS13{}
+	if len(data) < 12 {
+		return false
+	}
+	if vers := uint16(data[0])<<8 | uint16(data[1]); vers != VersionTLS13 {
+		return false
+	}
+	s.cipherSuite = uint16(data[2])<<8 | uint16(data[3])
+	for _, b := range data[4:12] {
+		s.createdAt = s.createdAt<<8 | uint64(b)
+	}
+
+	var ok bool
+	s.resumptionSecret, data, ok = readUint8LengthPrefixed(data[12:])
+	if !ok || len(s.resumptionSecret) == 0 {
+		return false
+	}
+
+	if len(data) < 2 {
+		return false
+	}
+	numCerts := int(data[0])<<8 | int(data[1])
+	data = data[2:]
+	for i := 0; i < numCerts; i++ {
+		var cert []byte
+		if cert, data, ok = readUint24LengthPrefixed(data); !ok {
+			return false
+		}
+		s.certificates = append(s.certificates, cert)
+	}
+
+	return len(data) == 0
+}
+
+// encryptTicket encrypts and authenticates a marshaled sessionState or
+// sessionStateTLS13 with the current session ticket key.
+func (c *Conn) encryptTicket(serialized []byte) ([]byte, error) {
 	encrypted := make([]byte, ticketKeyNameLen+aes.BlockSize+len(serialized)+sha256.Size)
 	keyName := encrypted[:ticketKeyNameLen]
 	iv := encrypted[ticketKeyNameLen : ticketKeyNameLen+aes.BlockSize]
@@ -154,7 +232,11 @@ func (c *Conn) encryptTicket(state *sessionState) ([]byte, error) {
 	return encrypted, nil
 }
 
-func (c *Conn) decryptTicket(encrypted []byte) (*sessionState, bool) {
+// decryptTicket returns the plaintext of a ticket produced by encryptTicket, or
+// nil if it can't be authenticated. usedOldKey reports whether the ticket was
+// encrypted with an older key and thus should be refreshed. Note that the
+// ticket is decrypted in place.
+func (c *Conn) decryptTicket(encrypted []byte) (plaintext []byte, usedOldKey bool) {
 	if c.config.SessionTicketsDisabled ||
 		len(encrypted) < ticketKeyNameLen+aes.BlockSize+sha256.Size {
 		return nil, false
@@ -191,10 +273,8 @@ func (c *Conn) decryptTicket(encrypted []byte) (*sessionState, bool) {
 		return nil, false
 	}
 	ciphertext := encrypted[ticketKeyNameLen+aes.BlockSize : len(encrypted)-sha256.Size]
-	plaintext := ciphertext
+	plaintext = ciphertext
 	cipher.NewCTR(block, iv).XORKeyStream(plaintext, ciphertext)
 
-	state := &sessionState{usedOldKey: keyIndex > 0}
-	ok := state.unmarshal(plaintext)
-	return state, ok
+	return plaintext, keyIndex > 0
 }
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"crypto/dsa"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -496,6 +497,11 @@ func TestCreateSelfSignedCertificate(t *testing.T) {
 		t.Fatalf("Failed to generate ECDSA key: %s", err)
 	}
 
+	ed25519Pub, ed25519Priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate Ed25519 key: %s", err)
+	}
+
 	tests := []struct {
 		name      string
 		pub, priv interface{}
@@ -509,6 +515,9 @@ func TestCreateSelfSignedCertificate(t *testing.T) {
 		{"RSAPSS/RSAPSS", &testPrivateKey.PublicKey, testPrivateKey, true, SHA256WithRSAPSS},
 		{"ECDSA/RSAPSS", &ecdsaPriv.PublicKey, testPrivateKey, false, SHA256WithRSAPSS},
 		{"RSAPSS/ECDSA", &testPrivateKey.PublicKey, ecdsaPriv, false, ECDSAWithSHA384},
+		{"Ed25519/Ed25519", ed25519Pub, ed25519Priv, true, PureEd25519},
+		{"Ed25519/ECDSA", ed25519Pub, ecdsaPriv, false, ECDSAWithSHA256},
+		{"ECDSA/Ed25519", &ecdsaPriv.PublicKey, ed25519Priv, false, PureEd25519},
 	}
 
 	testExtKeyUsage := []ExtKeyUsage{ExtKeyUsageClientAuth, ExtKeyUsageServerAuth}
@@ -982,6 +991,66 @@ KVcg7fBd484ht/sS+l0dsB4KDOSpd8JzVDMF8OZqlaydizoJO0yWr9GbCN1+OKq5
 EhLrEqU=
 -----END CERTIFICATE-----`
 
+// ed25519SelfSignedPEM was generated using:
+//   openssl req -x509 -newkey ed25519 -nodes -subj "/O=Acme Co/CN=Ed25519 test" -days 36500
+const ed25519SelfSignedPEM = `-----BEGIN CERTIFICATE-----
+MIIBaTCCARugAwIBAgIUfxzf2deVvAw2UdcuYE7gZbG2QaIwBQYDK2VwMCkxEDAO
+BgNVBAoMB0FjbWUgQ28xFTATBgNVBAMMDEVkMjU1MTkgdGVzdDAgFw0yNjEwMTgx
+NjEyMzFaGA8yMTI2MDkyNDE2MTIzMVowKTEQMA4GA1UECgwHQWNtZSBDbzEVMBMG
+A1UEAwwMRWQyNTUxOSB0ZXN0MCowBQYDK2VwAyEATcxkRrqi6fqwc4SbCxuAw+7X
+gsDyCYTtZOW5rYzUvgijUzBRMB0GA1UdDgQWBBSIsSeLksFRPBYOAevppGH+ZPt6
+CTAfBgNVHSMEGDAWgBSIsSeLksFRPBYOAevppGH+ZPt6CTAPBgNVHRMBAf8EBTAD
+AQH/MAUGAytlcANBAEYA85WoGj/NEm87ihQsrgZpiv6HWdto0x81t2BW+4CA8uLW
+YTRPZCVAPD2lK9CzFO/P8fJNZyn/BQizDN0i0g0=
+-----END CERTIFICATE-----`
+
+// ed25519PublicKeyPEM is the public key of ed25519SelfSignedPEM.
+const ed25519PublicKeyPEM = `-----BEGIN PUBLIC KEY-----
+MCowBQYDK2VwAyEATcxkRrqi6fqwc4SbCxuAw+7XgsDyCYTtZOW5rYzUvgg=
+-----END PUBLIC KEY-----`
+
+func TestEd25519SelfSigned(t *testing.T) {
+	der, _ := pem.Decode([]byte(ed25519SelfSignedPEM))
+	if der == nil {
+		t.Fatal("Failed to find PEM block")
+	}
+
+	cert, err := ParseCertificate(der.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert.PublicKeyAlgorithm != Ed25519 {
+		t.Fatalf("Parsed key algorithm was not Ed25519")
+	}
+	parsedKey, ok := cert.PublicKey.(ed25519.PublicKey)
+	if !ok {
+		t.Fatalf("Parsed key was not an Ed25519 key: %T", cert.PublicKey)
+	}
+	if cert.SignatureAlgorithm != PureEd25519 {
+		t.Fatalf("Parsed signature algorithm was not Ed25519")
+	}
+
+	if err = cert.CheckSignatureFrom(cert); err != nil {
+		t.Fatal(err)
+	}
+
+	block, _ := pem.Decode([]byte(ed25519PublicKeyPEM))
+	pub, err := ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pub.(ed25519.PublicKey), parsedKey) {
+		t.Errorf("ParsePKIXPublicKey returned %x, want %x", pub, parsedKey)
+	}
+	pubBytes, err := MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pubBytes, block.Bytes) {
+		t.Errorf("Reserialization of public key didn't match. got %x, want %x", pubBytes, block.Bytes)
+	}
+}
+
 func TestCRLCreation(t *testing.T) {
 	block, _ := pem.Decode([]byte(pemPrivateKey))
 	priv, _ := ParsePKCS1PrivateKey(block.Bytes)
@@ -1121,6 +1190,11 @@ func TestCreateCertificateRequest(t *testing.T) {
 		t.Fatalf("Failed to generate ECDSA key: %s", err)
 	}
 
+	_, ed25519Priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate Ed25519 key: %s", err)
+	}
+
 	tests := []struct {
 		name    string
 		priv    interface{}
@@ -1130,6 +1204,7 @@ func TestCreateCertificateRequest(t *testing.T) {
 		{"ECDSA-256", ecdsa256Priv, ECDSAWithSHA1},
 		{"ECDSA-384", ecdsa384Priv, ECDSAWithSHA1},
 		{"ECDSA-521", ecdsa521Priv, ECDSAWithSHA1},
+		{"Ed25519", ed25519Priv, PureEd25519},
 	}
 
 	for _, test := range tests {
@@ -476,18 +476,18 @@ func indexRune(s string, r rune) int {
 
 // consume reads the next rune in the input and reports whether it is in the ok string.
 // If accept is true, it puts the character into the input token.
-func (s *ss) consume(ok string, _accept bool) bool {
+func (s *ss) consume(ok string, accept bool) bool {
 	r := s.getRune()
 	if r == eof {
 		return false
 	}
 	if indexRune(ok, r) >= 0 {
-		if _accept {
+		if accept {
 			s.buf.WriteRune(r)
 		}
 		return true
 	}
-	if r != eof && _accept {
+	if r != eof && accept {
 		s.UnreadRune()
 	}
 	return false
@@ -512,7 +512,7 @@ func (s *ss) notEOF() {
 
 // accept checks the next rune in the input. If it's a byte (sic) in the string, it puts it in the
 // buffer and returns true. Otherwise it return false.
-func (s *ss) _accept(ok string) bool {
+func (s *ss) accept(ok string) bool {
 	return s.consume(ok, true)
 }
 
@@ -541,12 +541,12 @@ func (s *ss) scanBool(verb rune) bool {
 	case '1':
 		return true
 	case 't', 'T':
-		if s._accept("rR") && (!s._accept("uU") || !s._accept("eE")) {
+		if s.accept("rR") && (!s.accept("uU") || !s.accept("eE")) {
 			s.error(boolError)
 		}
 		return true
 	case 'f', 'F':
-		if s._accept("aA") && (!s._accept("lL") || !s._accept("sS") || !s._accept("eE")) {
+		if s.accept("aA") && (!s.accept("lL") || !s.accept("sS") || !s.accept("eE")) {
 			s.error(boolError)
 		}
 		return false
@@ -588,11 +588,11 @@ func (s *ss) getBase(verb rune) (base int, digits string) {
 func (s *ss) scanNumber(digits string, haveDigits bool) string {
 	if !haveDigits {
 		s.notEOF()
-		if !s._accept(digits) {
+		if !s.accept(digits) {
 			s.errorString("expected integer")
 		}
 	}
-	for s._accept(digits) {
+	for s.accept(digits) {
 	}
 	return string(s.buf)
 }
@@ -616,7 +616,7 @@ func (s *ss) scanBasePrefix() (base int, digits string, found bool) {
 	if !s.peek("0") {
 		return 10, decimalDigits, false
 	}
-	s._accept("0")
+	s.accept("0")
 	found = true // We've put a digit into the token buffer.
 	// Special cases for '0' && '0x'
 	base, digits = 8, octalDigits
@@ -642,7 +642,7 @@ func (s *ss) scanInt(verb rune, bitSize int) int64 {
 			s.errorString("bad unicode format ")
 		}
 	} else {
-		s._accept(sign) // If there's a sign, it will be left in the token buffer.
+		s.accept(sign) // If there's a sign, it will be left in the token buffer.
 		if verb == 'v' {
 			base, digits, haveDigits = s.scanBasePrefix()
 		}
@@ -696,30 +696,30 @@ func (s *ss) scanUint(verb rune, bitSize int) uint64 {
 func (s *ss) floatToken() string {
 	s.buf = s.buf[:0]
 	// NaN?
-	if s._accept("nN") && s._accept("aA") && s._accept("nN") {
+	if s.accept("nN") && s.accept("aA") && s.accept("nN") {
 		return string(s.buf)
 	}
 	// leading sign?
-	s._accept(sign)
+	s.accept(sign)
 	// Inf?
-	if s._accept("iI") && s._accept("nN") && s._accept("fF") {
+	if s.accept("iI") && s.accept("nN") && s.accept("fF") {
 		return string(s.buf)
 	}
 	// digits?
-	for s._accept(decimalDigits) {
+	for s.accept(decimalDigits) {
 	}
 	// decimal point?
-	if s._accept(period) {
+	if s.accept(period) {
 		// fraction?
-		for s._accept(decimalDigits) {
+		for s.accept(decimalDigits) {
 		}
 	}
 	// exponent?
-	if s._accept(exponent) {
+	if s.accept(exponent) {
 		// leading sign?
-		s._accept(sign)
+		s.accept(sign)
 		// digits?
-		for s._accept(decimalDigits) {
+		for s.accept(decimalDigits) {
 		}
 	}
 	return string(s.buf)
@@ -730,20 +730,20 @@ func (s *ss) floatToken() string {
 // number and there are no spaces within.
 func (s *ss) complexTokens() (real, imag string) {
 	// TODO: accept N and Ni independently?
-	parens := s._accept("(")
+	parens := s.accept("(")
 	real = s.floatToken()
 	s.buf = s.buf[:0]
 	// Must now have a sign.
-	if !s._accept("+-") {
+	if !s.accept("+-") {
 		s.error(complexError)
 	}
 	// Sign is now in buffer
 	imagSign := string(s.buf)
 	imag = s.floatToken()
-	if !s._accept("i") {
+	if !s.accept("i") {
 		s.error(complexError)
 	}
-	if parens && !s._accept(")") {
+	if parens && !s.accept(")") {
 		s.error(complexError)
 	}
 	return real, imagSign + imag
@@ -666,10 +666,35 @@ type (
 		Body   *BlockStmt // CaseClauses only
 	}
 
+	// A SearchClause represents a clause of a search statement.
+	SearchClause struct {
+		Label *Ident    // clause label ("children", "accept", "reject", "results", "ordered", "strategy", "priority", "key", "cost", "best", or "stats")
+		Colon token.Pos // position of ":"
+		Body  []Stmt    // statement list; or nil
+	}
+
+	// A SearchStmt node represents a search statement.
 	SearchStmt struct {
-		Search token.Pos
-		// Init   Stmt // initialization statement; or nil
-		Body *BlockStmt
+		Search        token.Pos     // position of "search" keyword
+		Root          Expr          // root node
+		UType         Expr          // node type
+		Concurrency   Expr          // maximum number of engine goroutines; or nil
+		Context       Expr          // context.Context ending the search when done; or nil
+		MaxDepth      Expr          // maxDepth option limiting the length of solutions; or nil
+		MaxNodes      Expr          // maxNodes option limiting the number of nodes expanded; or nil
+		Deterministic Expr          // deterministic option selecting reproducible concurrent search; or nil
+		Lbrace        token.Pos     // position of "{"
+		Children      *SearchClause // children clause; or nil
+		Accept        *SearchClause // accept clause; or nil
+		Reject        *SearchClause // reject clause; or nil
+		Results       *SearchClause // results or ordered clause; or nil
+		Strategy      *SearchClause // strategy clause; or nil
+		Priority      *SearchClause // priority clause; or nil
+		Key           *SearchClause // key clause; or nil
+		Cost          *SearchClause // cost clause; or nil
+		Best          *SearchClause // best clause; or nil
+		Stats         *SearchClause // stats clause; or nil
+		Rbrace        token.Pos     // position of "}"
 	}
 
 	// An TypeSwitchStmt node represents a type switch statement.
@@ -732,6 +757,7 @@ func (s *BlockStmt) Pos() token.Pos      { return s.Lbrace }
 func (s *IfStmt) Pos() token.Pos         { return s.If }
 func (s *CaseClause) Pos() token.Pos     { return s.Case }
 func (s *SwitchStmt) Pos() token.Pos     { return s.Switch }
+func (s *SearchClause) Pos() token.Pos   { return s.Label.Pos() }
 func (s *SearchStmt) Pos() token.Pos     { return s.Search }
 func (s *TypeSwitchStmt) Pos() token.Pos { return s.Switch }
 func (s *CommClause) Pos() token.Pos     { return s.Case }
@@ -781,8 +807,14 @@ func (s *CaseClause) End() token.Pos {
 	}
 	return s.Colon + 1
 }
-func (s *SwitchStmt) End() token.Pos     { return s.Body.End() }
-func (s *SearchStmt) End() token.Pos     { return s.Body.End() }
+func (s *SwitchStmt) End() token.Pos { return s.Body.End() }
+func (s *SearchClause) End() token.Pos {
+	if n := len(s.Body); n > 0 {
+		return s.Body[n-1].End()
+	}
+	return s.Colon + 1
+}
+func (s *SearchStmt) End() token.Pos     { return s.Rbrace + 1 }
 func (s *TypeSwitchStmt) End() token.Pos { return s.Body.End() }
 func (s *CommClause) End() token.Pos {
 	if n := len(s.Body); n > 0 {
@@ -813,6 +845,7 @@ func (*BlockStmt) stmtNode()      {}
 func (*IfStmt) stmtNode()         {}
 func (*CaseClause) stmtNode()     {}
 func (*SwitchStmt) stmtNode()     {}
+func (*SearchClause) stmtNode()   {}
 func (*SearchStmt) stmtNode()     {}
 func (*TypeSwitchStmt) stmtNode() {}
 func (*CommClause) stmtNode()     {}
@@ -820,6 +853,24 @@ func (*SelectStmt) stmtNode()     {}
 func (*ForStmt) stmtNode()        {}
 func (*RangeStmt) stmtNode()      {}
 
+// Clauses returns the clauses of the search statement s in source order.
+func (s *SearchStmt) Clauses() []*SearchClause {
+	var list []*SearchClause
+	for _, c := range []*SearchClause{s.Children, s.Accept, s.Reject, s.Results, s.Strategy, s.Priority, s.Key, s.Cost, s.Best, s.Stats} {
+		if c == nil {
+			continue
+		}
+		// insert c in order of position; there are only a few clauses
+		i := len(list)
+		list = append(list, c)
+		for ; i > 0 && list[i-1].Pos() > c.Pos(); i-- {
+			list[i] = list[i-1]
+		}
+		list[i] = c
+	}
+	return list
+}
+
 // ----------------------------------------------------------------------------
 // Declarations
 
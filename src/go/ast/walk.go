@@ -246,6 +246,32 @@ func Walk(v Visitor, node Node) {
 		}
 		Walk(v, n.Body)
 
+	case *SearchClause:
+		Walk(v, n.Label)
+		walkStmtList(v, n.Body)
+
+	case *SearchStmt:
+		Walk(v, n.Root)
+		Walk(v, n.UType)
+		if n.Concurrency != nil {
+			Walk(v, n.Concurrency)
+		}
+		if n.Context != nil {
+			Walk(v, n.Context)
+		}
+		if n.MaxDepth != nil {
+			Walk(v, n.MaxDepth)
+		}
+		if n.MaxNodes != nil {
+			Walk(v, n.MaxNodes)
+		}
+		if n.Deterministic != nil {
+			Walk(v, n.Deterministic)
+		}
+		for _, c := range n.Clauses() {
+			Walk(v, c)
+		}
+
 	case *TypeSwitchStmt:
 		if n.Init != nil {
 			Walk(v, n.Init)
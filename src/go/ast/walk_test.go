@@ -0,0 +1,53 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ast_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestInspectSearch(t *testing.T) {
+	const src = `package p
+
+func _() {
+	search a; b; c; d {
+	children:
+		return e
+	strategy:
+		bestFirst
+	priority:
+		return f
+	accept:
+		return g
+	reject:
+	stats:
+		h()
+	}
+}
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The header expressions and the clauses are visited in source order.
+	var names []string
+	ast.Inspect(f.Decls[0], func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name != "_" {
+			names = append(names, id.Name)
+		}
+		return true
+	})
+	got := strings.Join(names, " ")
+	want := "a b c d children e strategy bestFirst priority f accept g reject stats h"
+	if got != want {
+		t.Errorf("got %s; want %s", got, want)
+	}
+}
@@ -181,10 +181,10 @@ var pkgDeps = map[string][]string{
 	"regexp/syntax":  {"L2"},
 	"runtime/debug":  {"L2", "fmt", "io/ioutil", "os", "time"},
 	"runtime/pprof":  {"L2", "compress/gzip", "context", "encoding/binary", "fmt", "io/ioutil", "os", "text/tabwriter", "time"},
-	"runtime/trace":  {"L0"},
+	"runtime/trace":  {"L0", "context", "fmt"},
 	"text/tabwriter": {"L2"},
 
-	"testing":          {"L2", "flag", "fmt", "internal/race", "os", "runtime/debug", "runtime/pprof", "runtime/trace", "time"},
+	"testing":          {"L2", "flag", "fmt", "internal/race", "os", "reflect", "runtime/debug", "runtime/pprof", "runtime/trace", "time"},
 	"testing/iotest":   {"L2", "log"},
 	"testing/quick":    {"L2", "flag", "fmt", "reflect", "time"},
 	"internal/testenv": {"L2", "OS", "flag", "testing", "syscall"},
@@ -262,6 +262,7 @@ var pkgDeps = map[string][]string{
 	"image/jpeg":               {"L4", "image/internal/imageutil"},
 	"image/png":                {"L4", "compress/zlib"},
 	"index/suffixarray":        {"L4", "regexp"},
+	"internal/fuzz":            {"L4", "OS", "context"},
 	"internal/singleflight":    {"sync"},
 	"internal/trace":           {"L4", "OS"},
 	"math/big":                 {"L4"},
@@ -270,10 +271,11 @@ var pkgDeps = map[string][]string{
 	"net/internal/socktest":    {"L4", "OS", "syscall", "internal/syscall/windows"},
 	"net/url":                  {"L4"},
 	"plugin":                   {"L0", "OS", "CGO"},
-	"runtime/pprof/internal/profile": {"L4", "OS", "compress/gzip", "regexp"},
-	"testing/internal/testdeps":      {"L4", "internal/testlog", "runtime/pprof", "regexp"},
-	"text/scanner":                   {"L4", "OS"},
-	"text/template/parse":            {"L4"},
+	"runtime/pprof/internal/profile":      {"L4", "OS", "compress/gzip", "regexp"},
+	"testing/internal/testdeps":           {"L4", "OS", "context", "internal/fuzz", "internal/testlog", "runtime/pprof", "regexp"},
+	"testing/internal/testdeps/interrupt": {"L4", "OS", "os/signal", "testing/internal/testdeps"},
+	"text/scanner":                        {"L4", "OS"},
+	"text/template/parse":                 {"L4"},
 
 	"html/template": {
 		"L4", "OS", "encoding/json", "html", "text/template",
@@ -348,6 +350,7 @@ var pkgDeps = map[string][]string{
 		"crypto/sha512",
 		"golang_org/x/crypto/chacha20poly1305",
 		"golang_org/x/crypto/curve25519",
+		"golang_org/x/crypto/hkdf",
 		"golang_org/x/crypto/poly1305",
 	},
 
@@ -363,10 +366,15 @@ var pkgDeps = map[string][]string{
 	"crypto/elliptic": {"L4", "CRYPTO", "math/big"},
 	"crypto/rsa":      {"L4", "CRYPTO", "crypto/rand", "math/big"},
 
+	// Ed25519 doesn't need math/big, but it does use crypto/rand.
+	"crypto/ed25519":                       {"L3", "CRYPTO", "crypto/rand", "crypto/ed25519/internal/edwards25519"},
+	"crypto/ed25519/internal/edwards25519": {"encoding/binary"},
+
 	"CRYPTO-MATH": {
 		"CRYPTO",
 		"crypto/dsa",
 		"crypto/ecdsa",
+		"crypto/ed25519",
 		"crypto/elliptic",
 		"crypto/rand",
 		"crypto/rsa",
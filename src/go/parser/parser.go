@@ -21,7 +21,6 @@ import (
 	"go/ast"
 	"go/scanner"
 	"go/token"
-	"log"
 	"strconv"
 	"strings"
 	"unicode"
@@ -69,6 +68,7 @@ type parser struct {
 	// (maintained by open/close LabelScope)
 	labelScope  *ast.Scope     // label scope for current function
 	targetStack [][]*ast.Ident // stack of unresolved labels
+	clauseScope *ast.Scope     // label scope for current search clause
 }
 
 func (p *parser) init(fset *token.FileSet, filename string, src []byte, mode Mode) {
@@ -109,7 +109,11 @@ func (p *parser) closeLabelScope() {
 	for _, ident := range p.targetStack[n] {
 		ident.Obj = scope.Lookup(ident.Name)
 		if ident.Obj == nil && p.mode&DeclarationErrors != 0 {
-			p.error(ident.Pos(), fmt.Sprintf("label %s undefined", ident.Name))
+			msg := fmt.Sprintf("label %s undefined", ident.Name)
+			if scope == p.clauseScope && isSearchClause(ident.Name) {
+				msg += fmt.Sprintf(" (%s: directly in a search statement starts the %s clause)", ident.Name, ident.Name)
+			}
+			p.error(ident.Pos(), msg)
 		}
 	}
 	// pop label scope
@@ -1649,6 +1653,7 @@ const (
 	basic = iota
 	labelOk
 	rangeOk
+	searchClauseOk
 )
 
 // parseSimpleStmt returns true as 2nd result if it parsed the assignment
@@ -1698,7 +1703,11 @@ func (p *parser) parseSimpleStmt(mode int) (ast.Stmt, bool) {
 		// labeled statement
 		colon := p.pos
 		p.next()
-		if label, isIdent := x[0].(*ast.Ident); mode == labelOk && isIdent {
+		label, isIdent := x[0].(*ast.Ident)
+		if mode == searchClauseOk && isIdent && isSearchClause(label.Name) {
+			return &ast.SearchClause{Label: label, Colon: colon}, false
+		}
+		if (mode == labelOk || mode == searchClauseOk) && isIdent {
 			// Go spec: The scope of a label is the body of the function
 			// in which it is declared and excludes the body of any nested
 			// function.
@@ -1937,16 +1946,152 @@ func (p *parser) isTypeSwitchGuard(s ast.Stmt) bool {
 	return false
 }
 
+func isSearchClause(name string) bool {
+	switch name {
+	case "children", "accept", "reject", "results", "ordered", "strategy", "priority", "key", "cost", "best", "stats":
+		return true
+	}
+	return false
+}
+
 func (p *parser) parseSearchStmt() ast.Stmt {
-	log.Println("WINNING")
-	pos := p.expect(token.SWITCH)
+	if p.trace {
+		defer un(trace(p, "SearchStmt"))
+	}
+
+	pos := p.expect(token.SEARCH)
 	p.openScope()
 	defer p.closeScope()
 
-	body := p.parseBlockStmt()
-	// p.expectSemi()
+	root := p.parseRhs()
+	p.expect(token.SEMICOLON)
+	typ := p.parseType()
+	s := &ast.SearchStmt{Search: pos, Root: root, UType: typ}
+	// The concurrency may be omitted if a context or an option follows.
+	// Options of the form name = x follow the other header expressions.
+	prevLev := p.exprLev
+	p.exprLev = -1
+	for i := 0; p.tok == token.SEMICOLON; i++ {
+		p.next()
+		if i == 0 && p.tok == token.SEMICOLON {
+			continue
+		}
+		// Not parseRhs: "=" introduces the value of an option.
+		x := p.checkExpr(p.parseExpr(false))
+		if name, isIdent := x.(*ast.Ident); isIdent && p.tok == token.ASSIGN {
+			p.next()
+			p.addSearchOption(s, name, p.parseRhs())
+			continue
+		}
+		switch {
+		case s.MaxDepth != nil || s.MaxNodes != nil || s.Deterministic != nil:
+			p.error(x.Pos(), "search header expression after option")
+		case i == 0:
+			s.Concurrency = x
+		case i == 1:
+			s.Context = x
+		default:
+			p.error(x.Pos(), "too many expressions in search header")
+		}
+	}
+	p.exprLev = prevLev
 
-	return &ast.SearchStmt{Search: pos, Body: body}
+	s.Lbrace = p.expect(token.LBRACE)
+	// Each clause is the body of a function of its own, with its own
+	// label scope.
+	outerClauseScope := p.clauseScope
+	var clause *ast.SearchClause
+	for p.tok != token.RBRACE && p.tok != token.EOF {
+		var stmt ast.Stmt
+		if p.tok == token.IDENT {
+			// The clause names are not keywords: they are recognized
+			// as labels of statements directly inside the search body.
+			// Nested statements may use them as labels of their own.
+			stmt, _ = p.parseSimpleStmt(searchClauseOk)
+			if c, isClause := stmt.(*ast.SearchClause); isClause {
+				if clause != nil {
+					p.closeLabelScope()
+					p.closeScope()
+				}
+				clause = c
+				p.openScope()
+				p.openLabelScope()
+				p.clauseScope = p.labelScope
+				p.addSearchClause(s, c)
+				continue
+			}
+			if _, isLabeledStmt := stmt.(*ast.LabeledStmt); !isLabeledStmt {
+				p.expectSemi()
+			}
+		} else {
+			stmt = p.parseStmt()
+		}
+		if clause == nil {
+			p.errorExpected(stmt.Pos(), "search clause")
+			continue
+		}
+		clause.Body = append(clause.Body, stmt)
+	}
+	if clause != nil {
+		p.closeLabelScope()
+		p.closeScope()
+	}
+	p.clauseScope = outerClauseScope
+	s.Rbrace = p.expect(token.RBRACE)
+	p.expectSemi()
+
+	return s
+}
+
+func (p *parser) addSearchOption(s *ast.SearchStmt, name *ast.Ident, x ast.Expr) {
+	var field *ast.Expr
+	switch name.Name {
+	case "maxDepth":
+		field = &s.MaxDepth
+	case "maxNodes":
+		field = &s.MaxNodes
+	case "deterministic":
+		field = &s.Deterministic
+	default:
+		p.error(name.Pos(), fmt.Sprintf("unknown search option %s", name.Name))
+		return
+	}
+	if *field != nil {
+		p.error(name.Pos(), fmt.Sprintf("duplicate %s option in search statement", name.Name))
+		return
+	}
+	*field = x
+}
+
+func (p *parser) addSearchClause(s *ast.SearchStmt, c *ast.SearchClause) {
+	var field **ast.SearchClause
+	switch c.Label.Name {
+	case "children":
+		field = &s.Children
+	case "accept":
+		field = &s.Accept
+	case "reject":
+		field = &s.Reject
+	case "results", "ordered":
+		field = &s.Results
+	case "strategy":
+		field = &s.Strategy
+	case "priority":
+		field = &s.Priority
+	case "key":
+		field = &s.Key
+	case "cost":
+		field = &s.Cost
+	case "best":
+		field = &s.Best
+	case "stats":
+		field = &s.Stats
+	}
+	if *field != nil {
+		p.error(c.Pos(), fmt.Sprintf("duplicate %s clause in search statement", (*field).Label.Name))
+		return
+	}
+	*field = c
 }
 
 func (p *parser) parseSwitchStmt() ast.Stmt {
@@ -48,6 +48,24 @@ var valids = []string{
 	`package p; var _ = map[*P]int{&P{}:0, {}:1}`,
 	`package p; type T = int`,
 	`package p; type (T = p.T; _ = struct{}; x = *T)`,
+	`package p; func f() { search T{}; T { children: return nil } };`,
+	`package p; func f() { search 0; int; n { children: return nil; accept: return true; reject: return false } };`,
+	`package p; func f() { search 0; int { reject: accept := false; return accept; children: return nil } };`,
+	`package p; func f() { search 0; int { children: L: for {}; return nil } };`,
+	`package p; func f() { search 0; int; n; ctx { children: return nil } };`,
+	`package p; func f() { search 0; int { children: return nil; accept: return true; results: println(solution) } };`,
+	`package p; func f() { search 0; int { children: return nil; accept: return true; ordered: } };`,
+	`package p; func f() { search 0; int; ; r.Context() { children: return nil } };`,
+	`package p; func f() { search 0; int { strategy: bestFirst; children: return nil; priority: return 0 } };`,
+	`package p; func f() { search 0; int { strategy: iterativeDeepening(n + 1); children: return nil } };`,
+	`package p; func f() { search 0; int { children: return nil; key: return node % 10 } };`,
+	`package p; func f() { search 0; int { children: return nil; stats: println(stats.Expanded) } };`,
+	`package p; func f() { search 0; int; maxDepth = 10 { children: return nil } };`,
+	`package p; func f() { search 0; int; 4; ctx; maxNodes = 1 << 20; maxDepth = n + 1 { children: return nil } };`,
+	`package p; func f() { search 0; int; 4; deterministic = true { children: return nil } };`,
+	`package p; func f() { search 0; int { children: return nil; accept: return true; cost: return 0; best: println(solution) } };`,
+	`package p; func f() { search 0; int { children: { key: for { continue key } }; return nil } };`,
+	`package p; func f(l net.Listener) { children, accept := 0, l.Accept; _, _ = children, accept };`,
 }
 
 func TestValid(t *testing.T) {
@@ -127,6 +145,24 @@ var invalids = []string{
 	// issue 13475
 	`package p; func f() { if true {} else ; /* ERROR "expected if statement or block" */ }`,
 	`package p; func f() { if true {} else defer /* ERROR "expected if statement or block" */ f() }`,
+
+	// search statements
+	`package p; func f() { search 0 int /* ERROR "expected ';'" */ { children: return nil } };`,
+	`package p; func f() { search 0; int { return /* ERROR "expected search clause" */ nil } };`,
+	`package p; func f() { search 0; int { children: return nil; accept: return true; accept /* ERROR "duplicate accept clause" */ : return true } };`,
+	`package p; func f() { search 0; int { children: return nil; reject: return true; reject /* ERROR "duplicate reject clause" */ : return true } };`,
+	`package p; func f() { search 0; int { children: return nil; results: ; ordered /* ERROR "duplicate results clause" */ : } };`,
+	`package p; func f() { search 0; int { strategy: breadthFirst; children: return nil; strategy /* ERROR "duplicate strategy clause" */ : bestFirst } };`,
+	`package p; func f() { search 0; int { children: return nil; stats: ; stats /* ERROR "duplicate stats clause" */ : } };`,
+	`package p; func f() { search 0; int { children: return nil; cost: return 0; cost /* ERROR "duplicate cost clause" */ : return 1 } };`,
+	`package p; func f() { search 0; int { children: return nil; best: ; best /* ERROR "duplicate best clause" */ : } };`,
+	`package p; func f() { search 0; int; maxDepth = 1; maxDepth /* ERROR "duplicate maxDepth option" */ = 2 { children: return nil } };`,
+	`package p; func f() { search 0; int; deterministic = true; deterministic /* ERROR "duplicate deterministic option" */ = false { children: return nil } };`,
+	`package p; func f() { search 0; int; depth /* ERROR "unknown search option depth" */ = 2 { children: return nil } };`,
+	`package p; func f() { search 0; int; maxNodes = 1; ctx /* ERROR "search header expression after option" */ { children: return nil } };`,
+	`package p; func f() { search 0; int; 1; ctx; x /* ERROR "too many expressions in search header" */ { children: return nil } };`,
+	`package p; func f() { L: search 0; int { children: for { break L /* ERROR "label L undefined" */ }; return nil } };`,
+	`package p; func f() { search 0; int { children: return nil; key: for { continue key /* ERROR "label key undefined \(key: directly in a search statement starts the key clause\)" */ }; return node } };`,
 }
 
 func TestInvalid(t *testing.T) {
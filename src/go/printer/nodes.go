@@ -1237,6 +1237,48 @@ func (p *printer) stmt(stmt ast.Stmt, nextIsRBrace bool) {
 		p.controlClause(false, s.Init, s.Tag, nil)
 		p.block(s.Body, 0)
 
+	case *ast.SearchClause:
+		p.expr(s.Label)
+		p.print(s.Colon, token.COLON)
+		p.stmtList(s.Body, 1, nextIsRBrace)
+
+	case *ast.SearchStmt:
+		p.print(token.SEARCH, blank)
+		p.expr(s.Root)
+		p.print(token.SEMICOLON, blank)
+		p.expr(s.UType)
+		if s.Concurrency != nil || s.Context != nil {
+			// the concurrency may be omitted if a context follows
+			p.print(token.SEMICOLON, blank)
+			if s.Concurrency != nil {
+				p.expr(s.Concurrency)
+			}
+			if s.Context != nil {
+				p.print(token.SEMICOLON, blank)
+				p.expr(s.Context)
+			}
+		}
+		if s.MaxDepth != nil {
+			p.print(token.SEMICOLON, blank, ast.NewIdent("maxDepth"), blank, token.ASSIGN, blank)
+			p.expr(s.MaxDepth)
+		}
+		if s.MaxNodes != nil {
+			p.print(token.SEMICOLON, blank, ast.NewIdent("maxNodes"), blank, token.ASSIGN, blank)
+			p.expr(s.MaxNodes)
+		}
+		if s.Deterministic != nil {
+			p.print(token.SEMICOLON, blank, ast.NewIdent("deterministic"), blank, token.ASSIGN, blank)
+			p.expr(s.Deterministic)
+		}
+		p.print(blank, s.Lbrace, token.LBRACE)
+		var list []ast.Stmt
+		for _, c := range s.Clauses() {
+			list = append(list, c)
+		}
+		p.stmtList(list, 0, true)
+		p.linebreak(p.lineFor(s.Rbrace), 1, ignore, true)
+		p.print(s.Rbrace, token.RBRACE)
+
 	case *ast.TypeSwitchStmt:
 		p.print(token.SWITCH)
 		if s.Init != nil {
@@ -193,6 +193,7 @@ var data = []entry{
 	{"expressions.input", "expressions.raw", rawFormat | idempotent},
 	{"declarations.input", "declarations.golden", 0},
 	{"statements.input", "statements.golden", 0},
+	{"search.input", "search.golden", idempotent},
 	{"slow.input", "slow.golden", idempotent},
 }
 
@@ -210,6 +210,8 @@ type Info struct {
 	//     *ast.CommClause
 	//     *ast.ForStmt
 	//     *ast.RangeStmt
+	//     *ast.SearchStmt
+	//     *ast.SearchClause
 	//
 	Scopes map[ast.Node]*Scope
 
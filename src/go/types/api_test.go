@@ -509,6 +509,12 @@ func TestScopesInfo(t *testing.T) {
 		{`package p20; var s int; func _(a []int) { for i, x := range a { s += x; _ = i } }`, []string{
 			"file:", "func:a", "range:i x", "block:",
 		}},
+		{`package p21; func _() { search 0; int { children: var c chan int; return c; accept: x := node; return x > 0 } }`, []string{
+			"file:", "func:", "search:__GraphNode __SearchStats", "clause:c done gid node solution stop", "clause:done gid node solution stop x",
+		}},
+		{`package p22; func _() { search 0; int { children: return nil; stats: _ = stats } }`, []string{
+			"file:", "func:", "search:__GraphNode __SearchStats", "clause:done gid node solution stop", "clause:stats",
+		}},
 	}
 
 	for _, test := range tests {
@@ -544,6 +550,10 @@ func TestScopesInfo(t *testing.T) {
 				kind = "for"
 			case *ast.RangeStmt:
 				kind = "range"
+			case *ast.SearchStmt:
+				kind = "search"
+			case *ast.SearchClause:
+				kind = "clause"
 			}
 
 			// look for matching scope description
@@ -87,6 +87,7 @@ var tests = [][]string{
 	{"testdata/stmt1.src"},
 	{"testdata/gotos.src"},
 	{"testdata/labels.src"},
+	{"testdata/search.src"},
 	{"testdata/issues.src"},
 	{"testdata/blank.src"},
 }
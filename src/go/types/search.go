@@ -0,0 +1,316 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements typechecking of search statements.
+
+package types
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+)
+
+// searchStmt typechecks a search statement. The clauses of a search
+// statement behave like function literals: each clause body is checked
+// as the body of a function with the implicit parameters
+//
+//	node     T
+//	solution []T
+//	gid      *__GraphNode
+//	stop     func()
+//	done     <-chan struct{}
+//
+// where T is the search node type. The children clause returns the
+// children of node as a value of type <-chan T, []T, or func() (T, bool),
+// or nil (see searchReturn); the accept and reject clauses return a bool.
+// The results (or ordered) clause receives each accepted solution and
+// returns nothing. The strategy clause selects the traversal order
+// (see searchStrategy); a best-first search ranks the nodes by the
+// float64 returned by its priority clause. The key clause maps a node
+// to an interface{} value which must be comparable at run time. The cost
+// clause turns the search into a branch-and-bound search: it returns a
+// float64 lower bound on the cost of the solutions through node, and the
+// best clause, which requires it, receives the cheapest solution
+// accepted. The cost clause requires an accept clause. The stats clause
+// has the single implicit parameter
+//
+//	stats __SearchStats
+//
+// and is called with the statistics of the search once it is complete.
+// Calling stop terminates the entire search, as does the end of the
+// optional search context, or reaching the limit set by the maxDepth or
+// maxNodes header option. Like the concurrency, these options must be
+// non-negative integers. The deterministic option is a boolean; it
+// requires the depthFirst strategy and excludes the key, ordered, and
+// cost clauses.
+func (check *Checker) searchStmt(s *ast.SearchStmt) {
+	check.openScope(s, "search")
+	defer check.closeScope()
+
+	T := check.typ(s.UType)
+
+	var x operand
+	check.expr(&x, s.Root)
+	check.assignment(&x, T, "search root")
+
+	if s.Concurrency != nil {
+		check.searchInt(s.Concurrency, "concurrency")
+	}
+	if s.MaxDepth != nil {
+		check.searchInt(s.MaxDepth, "maxDepth")
+	}
+	if s.MaxNodes != nil {
+		check.searchInt(s.MaxNodes, "maxNodes")
+	}
+	if s.Deterministic != nil {
+		check.expr(&x, s.Deterministic)
+		check.assignment(&x, nil, "search deterministic")
+		if x.mode != invalid && !isBoolean(x.typ) {
+			check.errorf(x.pos(), "search deterministic %s must be boolean", &x)
+		}
+	}
+
+	if s.Context != nil {
+		check.expr(&x, s.Context)
+		check.assignment(&x, searchContext, "search context")
+	}
+
+	gid := check.searchGraphNode(s)
+	stats := check.searchStats(s)
+
+	strategy := "depthFirst"
+	if s.Strategy != nil {
+		strategy = check.searchStrategy(s.Strategy)
+	}
+
+	if s.Children == nil {
+		check.errorf(s.Pos(), "missing children clause in search statement")
+	} else {
+		check.searchClause(s.Children, T, gid, nil)
+	}
+	if s.Accept != nil {
+		check.searchClause(s.Accept, T, gid, Typ[Bool])
+	}
+	if s.Reject != nil {
+		check.searchClause(s.Reject, T, gid, Typ[Bool])
+	}
+	switch {
+	case strategy == "bestFirst" && s.Priority == nil:
+		check.errorf(s.Strategy.Pos(), "bestFirst search without priority clause")
+	case strategy != "bestFirst" && s.Priority != nil:
+		check.errorf(s.Priority.Pos(), "priority clause requires bestFirst strategy")
+	}
+	if s.Priority != nil {
+		check.searchClause(s.Priority, T, gid, Typ[Float64])
+	}
+	if s.Key != nil {
+		check.searchClause(s.Key, T, gid, &emptyInterface)
+	}
+	if s.Results != nil {
+		if s.Accept == nil {
+			check.errorf(s.Results.Pos(), "%s clause without accept clause in search statement", s.Results.Label.Name)
+		}
+		if s.Results.Label.Name == "ordered" && strategy == "bestFirst" {
+			check.errorf(s.Results.Pos(), "ordered clause with bestFirst strategy")
+		}
+		check.searchClause(s.Results, T, gid, nil)
+	}
+	if s.Cost != nil {
+		if s.Accept == nil {
+			check.errorf(s.Cost.Pos(), "cost clause without accept clause in search statement")
+		}
+		check.searchClause(s.Cost, T, gid, Typ[Float64])
+	}
+	if s.Best != nil {
+		if s.Cost == nil {
+			check.errorf(s.Best.Pos(), "best clause without cost clause in search statement")
+		}
+		check.searchClause(s.Best, T, gid, nil)
+	}
+	if s.Deterministic != nil {
+		// Only the depth-first engine has a deterministic mode.
+		if strategy != "depthFirst" && strategy != "" {
+			check.errorf(s.Strategy.Pos(), "deterministic search with %s strategy", strategy)
+		}
+		if s.Key != nil {
+			check.errorf(s.Key.Pos(), "key clause in deterministic search")
+		}
+		if s.Results != nil && s.Results.Label.Name == "ordered" {
+			check.errorf(s.Results.Pos(), "ordered clause in deterministic search")
+		}
+		if s.Cost != nil {
+			check.errorf(s.Cost.Pos(), "cost clause in deterministic search")
+		}
+	}
+	if s.Stats != nil {
+		check.searchBody(s.Stats, []*Var{NewParam(s.Stats.Pos(), check.pkg, "stats", stats)}, nil)
+	}
+}
+
+// searchInt typechecks the search header expression e, the concurrency
+// or an option named what, which must be a non-negative integer.
+func (check *Checker) searchInt(e ast.Expr, what string) {
+	var x operand
+	check.expr(&x, e)
+	// The value is assigned to an invisible temporary (as the
+	// compiler does), so untyped constants assume their default type.
+	check.assignment(&x, nil, "search "+what)
+	if x.mode == invalid {
+		return
+	}
+	if !isInteger(x.typ) {
+		check.errorf(x.pos(), "search %s %s must be integer", what, &x)
+	} else if x.mode == constant_ && constant.Sign(x.val) < 0 {
+		check.errorf(x.pos(), "search %s %s must not be negative", what, &x)
+	}
+}
+
+// searchStrategy checks the strategy clause c and returns the name of
+// the strategy it selects. The clause consists of one of the statements
+//
+//	depthFirst
+//	breadthFirst
+//	bestFirst
+//	iterativeDeepening
+//	iterativeDeepening(bound)
+//
+// where the optional depth bound is an int.
+func (check *Checker) searchStrategy(c *ast.SearchClause) string {
+	if len(c.Body) == 1 {
+		if s, ok := c.Body[0].(*ast.ExprStmt); ok {
+			switch x := s.X.(type) {
+			case *ast.Ident:
+				switch x.Name {
+				case "depthFirst", "breadthFirst", "bestFirst", "iterativeDeepening":
+					return x.Name
+				}
+			case *ast.CallExpr:
+				if fn, ok := x.Fun.(*ast.Ident); ok && fn.Name == "iterativeDeepening" && len(x.Args) == 1 && !x.Ellipsis.IsValid() {
+					var bound operand
+					check.expr(&bound, x.Args[0])
+					check.assignment(&bound, Typ[Int], "search depth bound")
+					return fn.Name
+				}
+			}
+		}
+	}
+	check.errorf(c.Pos(), "invalid search strategy; expected depthFirst, breadthFirst, bestFirst, or iterativeDeepening")
+	return ""
+}
+
+// searchContext is the interface a search context must implement.
+// Only the Done method of context.Context is used by the engine.
+var searchContext = NewInterface([]*Func{
+	NewFunc(token.NoPos, nil, "Done", NewSignature(nil, nil, NewTuple(NewVar(token.NoPos, nil, "", NewChan(RecvOnly, NewStruct(nil, nil)))), false)),
+}, nil).Complete()
+
+// searchGraphNode declares the __GraphNode type describing the engine
+// goroutine that executes a clause. Like in the compiler, every search
+// statement declares its own type, visible in the clause bodies.
+func (check *Checker) searchGraphNode(s *ast.SearchStmt) *Named {
+	obj := NewTypeName(s.Pos(), check.pkg, "__GraphNode", nil)
+	fields := []*Var{
+		NewField(s.Pos(), check.pkg, "Active", Typ[Bool], false),
+		NewField(s.Pos(), check.pkg, "ID", Typ[Int], false),
+		NewField(s.Pos(), check.pkg, "Parent", Typ[Int], false),
+	}
+	typ := NewNamed(obj, NewStruct(fields, nil), nil)
+	check.declare(check.scope, nil, obj, s.Lbrace)
+	return typ
+}
+
+// searchStats declares the __SearchStats type of the statistics passed
+// to the stats clause. Like __GraphNode, it is declared by every search
+// statement.
+func (check *Checker) searchStats(s *ast.SearchStmt) *Named {
+	obj := NewTypeName(s.Pos(), check.pkg, "__SearchStats", nil)
+	fields := []*Var{
+		NewField(s.Pos(), check.pkg, "Expanded", Typ[Int], false),
+		NewField(s.Pos(), check.pkg, "Rejected", Typ[Int], false),
+		NewField(s.Pos(), check.pkg, "Pruned", Typ[Int], false),
+		NewField(s.Pos(), check.pkg, "Accepted", Typ[Int], false),
+		NewField(s.Pos(), check.pkg, "MaxDepth", Typ[Int], false),
+		NewField(s.Pos(), check.pkg, "Goroutines", Typ[Int], false),
+		NewField(s.Pos(), check.pkg, "Lifetimes", NewSlice(Typ[Int64]), false),
+		NewField(s.Pos(), check.pkg, "Limit", Typ[String], false),
+	}
+	typ := NewNamed(obj, NewStruct(fields, nil), nil)
+	check.declare(check.scope, nil, obj, s.Lbrace)
+	return typ
+}
+
+// searchClause typechecks the body of clause c as the body of a function
+// with the implicit search parameters and a single result of type result,
+// if any.
+func (check *Checker) searchClause(c *ast.SearchClause, T Type, gid *Named, result Type) {
+	params := []*Var{
+		NewParam(c.Pos(), check.pkg, "node", T),
+		NewParam(c.Pos(), check.pkg, "solution", NewSlice(T)),
+		NewParam(c.Pos(), check.pkg, "gid", NewPointer(gid)),
+		NewParam(c.Pos(), check.pkg, "stop", NewSignature(nil, nil, nil, false)),
+		NewParam(c.Pos(), check.pkg, "done", NewChan(RecvOnly, NewStruct(nil, nil))),
+	}
+	check.searchBody(c, params, result)
+}
+
+// searchBody typechecks the body of clause c as the body of a function
+// with the given parameters and a single result of type result, if any.
+func (check *Checker) searchBody(c *ast.SearchClause, params []*Var, result Type) {
+	scope := NewScope(check.scope, c.Pos(), c.End(), "search clause")
+	scope.isFunc = true
+	check.recordScope(c, scope)
+
+	for _, par := range params {
+		check.declare(scope, nil, par, c.Colon)
+	}
+	sig := &Signature{
+		scope:  scope,
+		params: NewTuple(params...),
+	}
+	if result != nil || c.Label.Name == "children" {
+		// The result of the children clause is typed by searchReturn.
+		sig.results = NewTuple(NewParam(c.Pos(), check.pkg, "", result))
+	}
+
+	body := &ast.BlockStmt{Lbrace: c.Colon, List: c.Body, Rbrace: c.End()}
+	check.funcBody(check.decl, c.Label.Name+" clause", sig, body)
+
+	if res := sig.results; res.Len() == 1 && res.vars[0].typ == nil {
+		// only nil is returned: no children
+		res.vars[0].typ = NewSlice(params[0].typ)
+	}
+}
+
+// searchReturn checks the return statement s of a children clause whose
+// result res is not typed yet. The children are returned in one of the
+// forms <-chan T, []T, or func() (T, bool), where T is the search node
+// type: the form of the first value returned other than nil, to which
+// the values of the later return statements must be assignable.
+func (check *Checker) searchReturn(s *ast.ReturnStmt, res *Var) {
+	if len(s.Results) != 1 {
+		check.errorf(s.Pos(), "wrong number of return values (want 1, got %d)", len(s.Results))
+		check.use(s.Results...)
+		return
+	}
+	var x operand
+	check.expr(&x, s.Results[0])
+	if x.mode == invalid || x.isNil() {
+		return
+	}
+	T := check.sig.params.vars[0].typ
+	forms := []Type{
+		NewChan(RecvOnly, T),
+		NewSlice(T),
+		NewSignature(nil, nil, NewTuple(NewVar(token.NoPos, nil, "", T), NewVar(token.NoPos, nil, "", Typ[Bool])), false),
+	}
+	for _, typ := range forms {
+		if x.assignableTo(check.conf, typ, nil) {
+			res.typ = typ
+			check.assignment(&x, typ, "return statement")
+			return
+		}
+	}
+	check.errorf(x.pos(), "cannot use %s as <-chan %s, []%s, or func() (%s, bool) value in return statement", &x, T, T, T)
+}
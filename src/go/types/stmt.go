@@ -71,7 +71,7 @@ func (check *Checker) usage(scope *Scope) {
 		check.softErrorf(v.pos, "%s declared but not used", v.name)
 	}
 
-	for _, scope := range scope._children {
+	for _, scope := range scope.children {
 		// Don't go inside closure scopes a second time;
 		// they are handled explicitly by funcBody.
 		if !scope.isFunc {
@@ -432,7 +432,9 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 
 	case *ast.ReturnStmt:
 		res := check.sig.results
-		if res.Len() > 0 {
+		if res.Len() == 1 && res.vars[0].typ == nil {
+			check.searchReturn(s, res.vars[0])
+		} else if res.Len() > 0 {
 			// function returns results
 			// (if one, say the first, result parameter is named, all of them are named)
 			if len(s.Results) == 0 && res.vars[0].name != "" {
@@ -668,6 +670,9 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 			}
 		}
 
+	case *ast.SearchStmt:
+		check.searchStmt(s)
+
 	case *ast.SelectStmt:
 		inner |= breakOk
 
@@ -0,0 +1,97 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fuzz
+
+import (
+	"sort"
+	"sync/atomic"
+)
+
+// coverage turns the counters inserted by cmd/cover into a signal
+// that tells the fuzzer whether an input exercised new behavior.
+//
+// The counters are never reset: they still feed the report of
+// 'go test -cover'. Instead, each input is judged by how much it
+// incremented each counter. The increment is reduced to one of eight
+// buckets, in the style of AFL, so that running a loop 10 times
+// instead of 11 is not news but running it once instead of never is.
+type coverage struct {
+	counters [][]uint32
+	last     []uint32 // counter values before the current input
+	seen     []uint8  // buckets seen so far, one bit per bucket
+}
+
+// newCoverage returns a coverage tracking the given counters,
+// keyed by file name as in testing.Cover.
+func newCoverage(counters map[string][]uint32) *coverage {
+	files := make([]string, 0, len(counters))
+	n := 0
+	for file, c := range counters {
+		files = append(files, file)
+		n += len(c)
+	}
+	sort.Strings(files)
+	c := &coverage{
+		last: make([]uint32, n),
+		seen: make([]uint8, n),
+	}
+	for _, file := range files {
+		c.counters = append(c.counters, counters[file])
+	}
+	return c
+}
+
+// enabled reports whether there are any counters to watch.
+func (c *coverage) enabled() bool {
+	return len(c.last) > 0
+}
+
+// start records the counters before running an input.
+func (c *coverage) start() {
+	i := 0
+	for _, counters := range c.counters {
+		for j := range counters {
+			c.last[i] = atomic.LoadUint32(&counters[j])
+			i++
+		}
+	}
+}
+
+// update folds the counter increments since start into the buckets
+// seen so far, and reports whether any of them were new.
+func (c *coverage) update() bool {
+	i := 0
+	isNew := false
+	for _, counters := range c.counters {
+		for j := range counters {
+			if d := atomic.LoadUint32(&counters[j]) - c.last[i]; d != 0 {
+				if b := bucket(d); c.seen[i]&b == 0 {
+					c.seen[i] |= b
+					isNew = true
+				}
+			}
+			i++
+		}
+	}
+	return isNew
+}
+
+// bucket returns the bit for the bucket that the increment d falls into.
+func bucket(d uint32) uint8 {
+	switch {
+	case d <= 3:
+		return 1 << (d - 1)
+	case d <= 7:
+		return 1 << 3
+	case d <= 15:
+		return 1 << 4
+	case d <= 31:
+		return 1 << 5
+	case d <= 127:
+		return 1 << 6
+	default:
+		return 1 << 7
+	}
+}
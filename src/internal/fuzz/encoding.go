@@ -0,0 +1,240 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fuzz
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// encVersion1 is the first line of a corpus file. Each following line
+// holds one value, written as a Go conversion expression such as
+// int(-1), []byte("\x00") or float64(1.5).
+const encVersion1 = "go test fuzz v1"
+
+// marshalCorpusFile encodes vals into the text of a corpus file.
+func marshalCorpusFile(vals ...interface{}) []byte {
+	if len(vals) == 0 {
+		panic("must have at least one value to marshal")
+	}
+	b := bytes.NewBuffer([]byte(encVersion1 + "\n"))
+	for _, val := range vals {
+		switch t := val.(type) {
+		case int, int8, int16, int64, uint, uint16, uint32, uint64, bool:
+			fmt.Fprintf(b, "%T(%v)\n", t, t)
+		case float32:
+			if math.IsNaN(float64(t)) || math.IsInf(float64(t), 0) {
+				fmt.Fprintf(b, "math.Float32frombits(0x%x)\n", math.Float32bits(t))
+			} else {
+				fmt.Fprintf(b, "float32(%s)\n", strconv.FormatFloat(float64(t), 'g', -1, 32))
+			}
+		case float64:
+			if math.IsNaN(t) || math.IsInf(t, 0) {
+				fmt.Fprintf(b, "math.Float64frombits(0x%x)\n", math.Float64bits(t))
+			} else {
+				fmt.Fprintf(b, "float64(%s)\n", strconv.FormatFloat(t, 'g', -1, 64))
+			}
+		case string:
+			fmt.Fprintf(b, "string(%q)\n", t)
+		case rune: // int32
+			// Values that are not valid runes would not survive
+			// quoting, so write those as plain integers.
+			if utf8.ValidRune(t) {
+				fmt.Fprintf(b, "rune(%q)\n", t)
+			} else {
+				fmt.Fprintf(b, "int32(%v)\n", t)
+			}
+		case byte: // uint8
+			fmt.Fprintf(b, "byte(%q)\n", t)
+		case []byte: // []uint8
+			fmt.Fprintf(b, "[]byte(%q)\n", t)
+		default:
+			panic(fmt.Sprintf("unsupported type: %T", t))
+		}
+	}
+	return b.Bytes()
+}
+
+// unmarshalCorpusFile decodes corpus file contents into the values it holds.
+func unmarshalCorpusFile(b []byte) ([]interface{}, error) {
+	if len(b) == 0 {
+		return nil, fmt.Errorf("cannot unmarshal empty string")
+	}
+	lines := bytes.Split(b, []byte("\n"))
+	if len(lines) < 2 {
+		return nil, fmt.Errorf("must include version and at least one value")
+	}
+	if string(bytes.TrimSpace(lines[0])) != encVersion1 {
+		return nil, fmt.Errorf("unknown encoding version: %s", lines[0])
+	}
+	var vals []interface{}
+	for _, line := range lines[1:] {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		v, err := parseCorpusValue(line)
+		if err != nil {
+			return nil, fmt.Errorf("malformed line %q: %v", line, err)
+		}
+		vals = append(vals, v)
+	}
+	if len(vals) == 0 {
+		return nil, fmt.Errorf("must include at least one value")
+	}
+	return vals, nil
+}
+
+// parseCorpusValue parses a single line of a corpus file. The line is
+// taken apart by hand rather than with go/parser, since every test binary
+// reads corpus files; only the forms written by marshalCorpusFile are
+// accepted.
+func parseCorpusValue(line []byte) (interface{}, error) {
+	s := string(line)
+	i := strings.IndexByte(s, '(')
+	if i < 0 || !strings.HasSuffix(s, ")") {
+		return nil, fmt.Errorf("expected call expression")
+	}
+	typ, val := strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:len(s)-1])
+	if val == "" {
+		return nil, fmt.Errorf("expected call expression with 1 argument")
+	}
+
+	switch typ {
+	case "[]byte":
+		if !isStringLit(val) {
+			return nil, fmt.Errorf("string literal required for type []byte")
+		}
+		s, err := strconv.Unquote(val)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(s), nil
+	case "math.Float64frombits":
+		u, err := strconv.ParseUint(val, 0, 64)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(u), nil
+	case "math.Float32frombits":
+		u, err := strconv.ParseUint(val, 0, 32)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(uint32(u)), nil
+	case "bool":
+		switch val {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("true or false required for type bool")
+	case "string":
+		if !isStringLit(val) {
+			return nil, fmt.Errorf("string literal value required for type string")
+		}
+		return strconv.Unquote(val)
+	case "byte", "rune":
+		if len(val) < 3 || val[0] != '\'' || val[len(val)-1] != '\'' {
+			return nil, fmt.Errorf("character literal required for type %s", typ)
+		}
+		r, _, tail, err := strconv.UnquoteChar(val[1:len(val)-1], '\'')
+		if err != nil {
+			return nil, err
+		}
+		if tail != "" {
+			return nil, fmt.Errorf("only one character allowed in character literal")
+		}
+		if typ == "rune" {
+			return r, nil
+		}
+		if r > math.MaxUint8 {
+			return nil, fmt.Errorf("character literal out of range for type byte")
+		}
+		return byte(r), nil
+	case "int", "int8", "int16", "int32", "int64":
+		if !isNumberLit(val) {
+			return nil, fmt.Errorf("integer literal required for type %s", typ)
+		}
+		return parseInt(val, typ)
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		if !isNumberLit(val) {
+			return nil, fmt.Errorf("integer literal required for type %s", typ)
+		}
+		return parseUint(val, typ)
+	case "float32", "float64":
+		if !isNumberLit(val) {
+			return nil, fmt.Errorf("float or integer literal required for type %s", typ)
+		}
+		if typ == "float32" {
+			f, err := strconv.ParseFloat(val, 32)
+			return float32(f), err
+		}
+		return strconv.ParseFloat(val, 64)
+	}
+	return nil, fmt.Errorf("unsupported type %s", typ)
+}
+
+// isStringLit reports whether val looks like an interpreted or raw
+// string literal.
+func isStringLit(val string) bool {
+	return val[0] == '"' || val[0] == '`'
+}
+
+// isNumberLit reports whether val looks like an integer or floating-point
+// literal, possibly negated. The strconv functions parsing it accept
+// no other forms, except for infinities and NaN, which start with a
+// letter.
+func isNumberLit(val string) bool {
+	if val[0] == '-' {
+		val = val[1:]
+	}
+	return val != "" && ('0' <= val[0] && val[0] <= '9' || val[0] == '.')
+}
+
+// parseInt returns an integer of value val and type typ.
+func parseInt(val, typ string) (interface{}, error) {
+	switch typ {
+	case "int":
+		i, err := strconv.ParseInt(val, 0, strconv.IntSize)
+		return int(i), err
+	case "int8":
+		i, err := strconv.ParseInt(val, 0, 8)
+		return int8(i), err
+	case "int16":
+		i, err := strconv.ParseInt(val, 0, 16)
+		return int16(i), err
+	case "int32":
+		i, err := strconv.ParseInt(val, 0, 32)
+		return int32(i), err
+	default:
+		return strconv.ParseInt(val, 0, 64)
+	}
+}
+
+// parseUint returns an unsigned integer of value val and type typ.
+func parseUint(val, typ string) (interface{}, error) {
+	switch typ {
+	case "uint":
+		i, err := strconv.ParseUint(val, 0, strconv.IntSize)
+		return uint(i), err
+	case "uint8":
+		i, err := strconv.ParseUint(val, 0, 8)
+		return uint8(i), err
+	case "uint16":
+		i, err := strconv.ParseUint(val, 0, 16)
+		return uint16(i), err
+	case "uint32":
+		i, err := strconv.ParseUint(val, 0, 32)
+		return uint32(i), err
+	default:
+		return strconv.ParseUint(val, 0, 64)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fuzz
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMarshalUnmarshal(t *testing.T) {
+	vals := []interface{}{
+		[]byte("\x00\xff hello\n"),
+		[]byte{},
+		"string with \"quotes\" and ünïcode",
+		true,
+		false,
+		byte('a'),
+		byte(0x80),
+		rune('☺'),
+		int32(-1),
+		int(-42),
+		int8(math.MinInt8),
+		int16(math.MaxInt16),
+		int64(math.MinInt64),
+		uint(7),
+		uint16(math.MaxUint16),
+		uint32(math.MaxUint32),
+		uint64(math.MaxUint64),
+		float32(-1.5),
+		float64(1e300),
+		math.Inf(-1),
+		float32(math.Inf(1)),
+		math.Copysign(0, -1),
+	}
+	data := marshalCorpusFile(vals...)
+	got, err := unmarshalCorpusFile(data)
+	if err != nil {
+		t.Fatalf("unmarshal %s: %v", data, err)
+	}
+	if !reflect.DeepEqual(got, vals) {
+		t.Errorf("round trip through\n%s\ngot  %#v\nwant %#v", data, got, vals)
+	}
+	if f := got[len(got)-1].(float64); !math.Signbit(f) {
+		t.Errorf("round trip lost the sign of -0")
+	}
+
+	nan := marshalCorpusFile(math.NaN())
+	got, err = unmarshalCorpusFile(nan)
+	if err != nil || len(got) != 1 || !math.IsNaN(got[0].(float64)) {
+		t.Errorf("round trip of NaN through %s: got %v, %v", nan, got, err)
+	}
+}
+
+func TestUnmarshalMalformed(t *testing.T) {
+	for _, data := range []string{
+		"",
+		"go test fuzz v1\n",
+		"go test fuzz v2\nint(1)\n",
+		"go test fuzz v1\nint(\n",
+		"go test fuzz v1\nint(1, 2)\n",
+		"go test fuzz v1\nint(\"1\")\n",
+		"go test fuzz v1\nint8(300)\n",
+		"go test fuzz v1\nbool(1)\n",
+		"go test fuzz v1\nbyte('☺')\n",
+		"go test fuzz v1\n[]int(\"a\")\n",
+		"go test fuzz v1\n[4]byte(\"a\")\n",
+		"go test fuzz v1\nuintptr(1)\n",
+		"go test fuzz v1\nstrings.Repeat(\"a\")\n",
+		"go test fuzz v1\nint(^1)\n",
+		"go test fuzz v1\nint(+1)\n",
+		"go test fuzz v1\nfloat64(Inf)\n",
+		"go test fuzz v1\nstring('a')\n",
+		"go test fuzz v1\nstring(\"a\") + string(\"b\")\n",
+		"go test fuzz v1\nrune('')\n",
+	} {
+		if vals, err := unmarshalCorpusFile([]byte(data)); err == nil {
+			t.Errorf("unmarshal %q = %v, want error", data, vals)
+		}
+	}
+}
+
+func TestReadCorpus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fuzz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	types := []reflect.Type{reflect.TypeOf(""), reflect.TypeOf(0)}
+	path, err := writeToCorpus(dir, []interface{}{"x", 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	corpus, err := ReadCorpus(dir, types)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(corpus) != 1 || corpus[0].Path != path || !reflect.DeepEqual(corpus[0].Values, []interface{}{"x", 1}) {
+		t.Errorf("ReadCorpus = %+v, want the entry written to %s", corpus, path)
+	}
+
+	_, err = ReadCorpus(dir, types[:1])
+	if err == nil || !strings.Contains(err.Error(), "wrong number of values") {
+		t.Errorf("ReadCorpus with wrong types: got error %v, want wrong number of values", err)
+	}
+
+	corpus, err = ReadCorpus(dir+"/missing", types)
+	if err != nil || corpus != nil {
+		t.Errorf("ReadCorpus of missing directory = %v, %v, want nil, nil", corpus, err)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fuzz implements the coverage-guided fuzzing engine behind
+// 'go test -fuzz'. It is used by the testing package through
+// testing/internal/testdeps.
+package fuzz
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+)
+
+// CorpusEntry is one input to a fuzz function.
+//
+// It is an alias so that package testing can declare an identical type
+// without importing this package.
+type CorpusEntry = struct {
+	// Name identifies the entry: "seed#N" for values added with F.Add,
+	// or the file name for entries read from a corpus directory.
+	Name string
+
+	// Path is the file the entry was read from, if any.
+	Path string
+
+	// Values are the arguments passed to the fuzz function,
+	// after its *testing.T.
+	Values []interface{}
+}
+
+// CoordinateFuzzingOpts are the parameters of CoordinateFuzzing.
+type CoordinateFuzzingOpts struct {
+	// Log is where progress is reported.
+	Log io.Writer
+
+	// Timeout is how long to fuzz. Zero means until ctx is done.
+	Timeout time.Duration
+
+	// MinimizeTimeout is how long to spend minimizing a failing input.
+	// Zero disables minimization.
+	MinimizeTimeout time.Duration
+
+	// Seed is the corpus to start from.
+	Seed []CorpusEntry
+
+	// Types are the types of the values passed to the fuzz function.
+	Types []reflect.Type
+
+	// Counters are the coverage counters of the code under test,
+	// keyed by file name as in testing.Cover.
+	Counters map[string][]uint32
+
+	// CorpusDir is where a failing input is written,
+	// usually testdata/fuzz/FuzzXxx.
+	CorpusDir string
+
+	// CacheDir is where inputs that expand coverage are kept
+	// between runs. If empty, they are not kept.
+	CacheDir string
+}
+
+// CoordinateFuzzing runs fn on mutations of the corpus, keeping the
+// mutations that reach new coverage, until fn returns an error, the
+// timeout expires or ctx is done.
+//
+// When fn fails, CoordinateFuzzing minimizes the failing input, writes it
+// to opts.CorpusDir and returns an error describing the failure and how
+// to reproduce it. It returns nil if it ran out of time without failing.
+//
+// fn runs in the calling process, so inputs that crash the process
+// (instead of failing or panicking in the fuzz function) are lost.
+func CoordinateFuzzing(ctx context.Context, opts CoordinateFuzzingOpts, fn func(CorpusEntry) error) error {
+	if opts.Log == nil {
+		opts.Log = ioutil.Discard
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	cov := newCoverage(opts.Counters)
+	if !cov.enabled() {
+		fmt.Fprintf(opts.Log, "fuzz: warning: test binary has no coverage counters; mutating inputs blindly\n")
+	}
+
+	corpus := append([]CorpusEntry(nil), opts.Seed...)
+	if opts.CacheDir != "" {
+		cached, err := ReadCorpus(opts.CacheDir, opts.Types)
+		if err != nil {
+			return err
+		}
+		corpus = append(corpus, cached...)
+	}
+	if len(corpus) == 0 {
+		vals := make([]interface{}, len(opts.Types))
+		for i, t := range opts.Types {
+			vals[i] = reflect.Zero(t).Interface()
+		}
+		corpus = append(corpus, CorpusEntry{Name: "zero", Values: vals})
+	}
+
+	// Run the starting corpus once, to learn the coverage it reaches.
+	for _, e := range corpus {
+		cov.start()
+		if err := fn(CorpusEntry{Name: e.Name, Path: e.Path, Values: copyValues(e.Values)}); err != nil {
+			name := e.Name
+			if e.Path != "" {
+				name = e.Path
+			}
+			return fmt.Errorf("%v\nfuzz: input %s from the starting corpus fails", err, name)
+		}
+		cov.update()
+	}
+
+	start := time.Now()
+	var execs, interesting int64
+	status := func(suffix string) {
+		elapsed := time.Since(start)
+		fmt.Fprintf(opts.Log, "fuzz: elapsed: %s, execs: %d (%.0f/sec), new interesting: %d (total: %d)%s\n",
+			elapsed.Round(time.Second), execs, float64(execs)/elapsed.Seconds(), interesting, len(corpus), suffix)
+	}
+	ticker := time.NewTicker(3 * time.Second)
+	defer ticker.Stop()
+
+	m := newMutator(time.Now().UnixNano())
+	for {
+		select {
+		case <-ctx.Done():
+			status("")
+			return nil
+		case <-ticker.C:
+			status("")
+		default:
+		}
+
+		vals := copyValues(corpus[m.r.Intn(len(corpus))].Values)
+		m.mutate(vals)
+		cov.start()
+		err := fn(CorpusEntry{Values: copyValues(vals)})
+		execs++
+		if err != nil {
+			if opts.MinimizeTimeout > 0 {
+				status(", minimizing")
+				vals, err = minimizeFailure(vals, err, fn, opts.MinimizeTimeout)
+			}
+			path, werr := writeToCorpus(opts.CorpusDir, vals)
+			if werr != nil {
+				return fmt.Errorf("%v\nfuzz: could not write failing input: %v", err, werr)
+			}
+			return fmt.Errorf("%v\nFailing input written to %s\nTo re-run:\ngo test -run=%s/%s",
+				err, path, filepath.Base(opts.CorpusDir), filepath.Base(path))
+		}
+		if cov.update() {
+			interesting++
+			e := CorpusEntry{Values: vals}
+			if opts.CacheDir != "" {
+				path, err := writeToCorpus(opts.CacheDir, vals)
+				if err != nil {
+					return err
+				}
+				e.Name, e.Path = filepath.Base(path), path
+			}
+			corpus = append(corpus, e)
+		}
+	}
+}
+
+// minimizeFailure minimizes the failing input vals and returns the
+// minimized input along with the error it causes. If the minimized
+// input no longer fails (the failure is flaky), it returns vals and err.
+func minimizeFailure(vals []interface{}, err error, fn func(CorpusEntry) error, timeout time.Duration) ([]interface{}, error) {
+	fails := func(vals []interface{}) bool {
+		return fn(CorpusEntry{Values: vals}) != nil
+	}
+	smaller := minimize(vals, fails, time.Now().Add(timeout))
+	if merr := fn(CorpusEntry{Values: copyValues(smaller)}); merr != nil {
+		return smaller, merr
+	}
+	return vals, err
+}
+
+// ReadCorpus reads the corpus entries in dir, checking that they hold
+// values of the given types. It returns no entries and no error if dir
+// does not exist.
+func ReadCorpus(dir string, types []reflect.Type) ([]CorpusEntry, error) {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var corpus []CorpusEntry
+	for _, fi := range files {
+		if fi.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, fi.Name())
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := unmarshalCorpusFile(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		if err := CheckCorpus(vals, types); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		corpus = append(corpus, CorpusEntry{Name: fi.Name(), Path: path, Values: vals})
+	}
+	return corpus, nil
+}
+
+// CheckCorpus reports an error unless vals holds exactly one value
+// of each of the given types, in order.
+func CheckCorpus(vals []interface{}, types []reflect.Type) error {
+	if len(vals) != len(types) {
+		return fmt.Errorf("wrong number of values in corpus entry: %d, want %d", len(vals), len(types))
+	}
+	for i, v := range vals {
+		if t := reflect.TypeOf(v); t != types[i] {
+			return fmt.Errorf("mismatched types in corpus entry: value %d is %v, want %v", i, t, types[i])
+		}
+	}
+	return nil
+}
+
+// writeToCorpus writes vals to a file in dir named after the hash of its
+// contents, creating dir if needed, and returns the file's path.
+func writeToCorpus(dir string, vals []interface{}) (path string, err error) {
+	data := marshalCorpusFile(vals...)
+	h := fnv.New64a()
+	h.Write(data)
+	name := fmt.Sprintf("%016x", h.Sum64())
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return "", err
+	}
+	path = filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, data, 0666); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fuzz
+
+import (
+	"math"
+	"reflect"
+	"time"
+)
+
+// minimize looks for smaller values that still make fails report true,
+// changing one value at a time. It stops at deadline and returns the
+// smallest failing values found so far.
+func minimize(vals []interface{}, fails func([]interface{}) bool, deadline time.Time) []interface{} {
+	vals = copyValues(vals)
+	for i := range vals {
+		// try reports whether replacing vals[i] with v still fails,
+		// and if so, keeps the replacement.
+		try := func(v interface{}) bool {
+			if time.Now().After(deadline) {
+				return false
+			}
+			cand := copyValues(vals)
+			cand[i] = v
+			if !fails(cand) {
+				return false
+			}
+			vals[i] = v
+			return true
+		}
+		switch v := vals[i].(type) {
+		case []byte:
+			minimizeBytes(v, func(b []byte) bool { return try(b) })
+		case string:
+			minimizeBytes([]byte(v), func(b []byte) bool { return try(string(b)) })
+		case bool:
+			if v {
+				try(false)
+			}
+		case float32:
+			minimizeFloat(float64(v), func(f float64) bool { return try(float32(f)) })
+		case float64:
+			minimizeFloat(v, func(f float64) bool { return try(f) })
+		default:
+			minimizeInteger(reflect.ValueOf(v), try)
+		}
+	}
+	return vals
+}
+
+// minimizeBytes removes ever smaller chunks from b for as long as try
+// keeps accepting the result.
+func minimizeBytes(b []byte, try func([]byte) bool) {
+	if len(b) == 0 || try([]byte{}) {
+		return
+	}
+	for n := len(b) / 2; n > 0; n /= 2 {
+		for i := 0; i+n <= len(b); {
+			cand := make([]byte, 0, len(b)-n)
+			cand = append(cand, b[:i]...)
+			cand = append(cand, b[i+n:]...)
+			if try(cand) {
+				b = cand
+			} else {
+				i += n
+			}
+		}
+	}
+}
+
+// minimizeInteger halves the integer v towards zero for as long as try
+// keeps accepting the result.
+func minimizeInteger(v reflect.Value, try func(interface{}) bool) {
+	conv := func(x interface{}) interface{} {
+		return reflect.ValueOf(x).Convert(v.Type()).Interface()
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := v.Int()
+		if n == 0 || try(conv(int64(0))) {
+			return
+		}
+		for n /= 2; n != 0 && try(conv(n)); n /= 2 {
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n := v.Uint()
+		if n == 0 || try(conv(uint64(0))) {
+			return
+		}
+		for n /= 2; n != 0 && try(conv(n)); n /= 2 {
+		}
+	}
+}
+
+// minimizeFloat tries zero, then the integer part of f.
+func minimizeFloat(f float64, try func(float64) bool) {
+	if f == 0 || try(0) {
+		return
+	}
+	if t := math.Trunc(f); t != f {
+		try(t)
+	}
+}
+
+// copyValues returns a copy of vals that shares no []byte storage with it.
+func copyValues(vals []interface{}) []interface{} {
+	c := make([]interface{}, len(vals))
+	for i, v := range vals {
+		if b, ok := v.([]byte); ok {
+			v = append([]byte{}, b...)
+		}
+		c[i] = v
+	}
+	return c
+}
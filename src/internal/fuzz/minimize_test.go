@@ -0,0 +1,90 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fuzz
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMinimize(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		vals  []interface{}
+		fails func([]interface{}) bool
+		want  []interface{}
+	}{
+		{
+			name: "bytes",
+			vals: []interface{}{[]byte("xxxxBUGxxxxxxxxxxx")},
+			fails: func(vals []interface{}) bool {
+				return bytes.Contains(vals[0].([]byte), []byte("BUG"))
+			},
+			want: []interface{}{[]byte("BUG")},
+		},
+		{
+			name: "string",
+			vals: []interface{}{"aaaaaaaa"},
+			fails: func(vals []interface{}) bool {
+				return len(vals[0].(string)) >= 3
+			},
+			want: []interface{}{"aaa"},
+		},
+		{
+			name: "integers",
+			vals: []interface{}{int8(-100), uint(1000), 12345},
+			fails: func(vals []interface{}) bool {
+				return vals[1].(uint) > 10
+			},
+			want: []interface{}{int8(0), uint(15), 0},
+		},
+		{
+			name: "floats and bools",
+			vals: []interface{}{float64(2.5), float32(-3.25), true},
+			fails: func(vals []interface{}) bool {
+				return vals[0].(float64) > 1 && vals[2].(bool)
+			},
+			want: []interface{}{float64(2), float32(0), true},
+		},
+		{
+			name: "several values",
+			vals: []interface{}{"foo bar", []byte("baz"), 3},
+			fails: func(vals []interface{}) bool {
+				return strings.Contains(vals[0].(string), "bar") && vals[2].(int) != 0
+			},
+			want: []interface{}{"bar", []byte{}, 1},
+		},
+	} {
+		got := minimize(tt.vals, tt.fails, time.Now().Add(time.Minute))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: minimize(%#v) = %#v, want %#v", tt.name, tt.vals, got, tt.want)
+		}
+	}
+}
+
+func TestMinimizeDeadline(t *testing.T) {
+	vals := []interface{}{[]byte("abcdef")}
+	got := minimize(vals, func([]interface{}) bool { return true }, time.Now().Add(-time.Second))
+	if !reflect.DeepEqual(got, vals) {
+		t.Errorf("minimize past its deadline = %#v, want %#v", got, vals)
+	}
+}
+
+func TestMinimizeDoesNotShareBytes(t *testing.T) {
+	vals := []interface{}{[]byte("abc")}
+	minimize(vals, func(vals []interface{}) bool {
+		b := vals[0].([]byte)
+		for i := range b {
+			b[i] = 'z'
+		}
+		return false
+	}, time.Now().Add(time.Minute))
+	if string(vals[0].([]byte)) != "abc" {
+		t.Errorf("minimize changed its input to %q", vals[0])
+	}
+}
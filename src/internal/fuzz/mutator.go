@@ -0,0 +1,239 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fuzz
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+// maxBytesLen is the largest []byte or string value the mutator creates.
+const maxBytesLen = 1 << 20
+
+const (
+	maxUint = uint64(^uint(0))
+	maxInt  = int64(maxUint >> 1)
+)
+
+// mutator changes fuzz inputs at random.
+type mutator struct {
+	r *rand.Rand
+}
+
+func newMutator(seed int64) *mutator {
+	return &mutator{r: rand.New(rand.NewSource(seed))}
+}
+
+// chooseLen returns a random length in [1, n], biased towards
+// short lengths.
+func (m *mutator) chooseLen(n int) int {
+	switch x := m.r.Intn(100); {
+	case x < 90:
+		return m.r.Intn(min(8, n)) + 1
+	case x < 99:
+		return m.r.Intn(min(32, n)) + 1
+	default:
+		return m.r.Intn(n) + 1
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// mutate changes one of the values in vals in place.
+func (m *mutator) mutate(vals []interface{}) {
+	i := m.r.Intn(len(vals))
+	switch v := vals[i].(type) {
+	case int:
+		vals[i] = int(m.mutateInt(int64(v), maxInt))
+	case int8:
+		vals[i] = int8(m.mutateInt(int64(v), math.MaxInt8))
+	case int16:
+		vals[i] = int16(m.mutateInt(int64(v), math.MaxInt16))
+	case int32:
+		vals[i] = int32(m.mutateInt(int64(v), math.MaxInt32))
+	case int64:
+		vals[i] = m.mutateInt(v, math.MaxInt64)
+	case uint:
+		vals[i] = uint(m.mutateUint(uint64(v), maxUint))
+	case uint8:
+		vals[i] = uint8(m.mutateUint(uint64(v), math.MaxUint8))
+	case uint16:
+		vals[i] = uint16(m.mutateUint(uint64(v), math.MaxUint16))
+	case uint32:
+		vals[i] = uint32(m.mutateUint(uint64(v), math.MaxUint32))
+	case uint64:
+		vals[i] = m.mutateUint(v, math.MaxUint64)
+	case float32:
+		vals[i] = float32(m.mutateFloat(float64(v), math.MaxFloat32))
+	case float64:
+		vals[i] = m.mutateFloat(v, math.MaxFloat64)
+	case bool:
+		vals[i] = !v
+	case string:
+		vals[i] = string(m.mutateBytes([]byte(v)))
+	case []byte:
+		vals[i] = m.mutateBytes(v)
+	default:
+		panic(fmt.Sprintf("type not supported for mutating: %T", vals[i]))
+	}
+}
+
+func (m *mutator) mutateInt(v, maxValue int64) int64 {
+	switch m.r.Intn(4) {
+	case 0:
+		// Add a small number.
+		return v + int64(m.chooseLen(16))
+	case 1:
+		// Subtract a small number.
+		return v - int64(m.chooseLen(16))
+	case 2:
+		// Flip a bit.
+		return v ^ 1<<uint(m.r.Intn(bits.Len64(uint64(maxValue))))
+	default:
+		// Use an interesting value.
+		switch m.r.Intn(4) {
+		case 0:
+			return 0
+		case 1:
+			return -1
+		case 2:
+			return maxValue
+		default:
+			return -maxValue - 1
+		}
+	}
+}
+
+func (m *mutator) mutateUint(v, maxValue uint64) uint64 {
+	switch m.r.Intn(4) {
+	case 0:
+		return v + uint64(m.chooseLen(16))
+	case 1:
+		return v - uint64(m.chooseLen(16))
+	case 2:
+		return v ^ 1<<uint(m.r.Intn(bits.Len64(maxValue)))
+	default:
+		switch m.r.Intn(3) {
+		case 0:
+			return 0
+		case 1:
+			return 1
+		default:
+			return maxValue
+		}
+	}
+}
+
+func (m *mutator) mutateFloat(v, maxValue float64) float64 {
+	switch m.r.Intn(5) {
+	case 0:
+		return v + float64(m.chooseLen(16))
+	case 1:
+		return v - float64(m.chooseLen(16))
+	case 2:
+		return v * float64(m.chooseLen(16))
+	case 3:
+		return v / float64(m.chooseLen(16))
+	default:
+		switch m.r.Intn(6) {
+		case 0:
+			return 0
+		case 1:
+			return -v
+		case 2:
+			return maxValue
+		case 3:
+			return math.Inf(1)
+		case 4:
+			return math.Inf(-1)
+		default:
+			return math.NaN()
+		}
+	}
+}
+
+// interesting8 are byte values that are likely to sit on boundaries.
+var interesting8 = []byte{0, 1, '0', 'A', 0x7f, 0x80, 0xff}
+
+// mutateBytes mutates b and returns the result, which may share
+// storage with b.
+func (m *mutator) mutateBytes(b []byte) []byte {
+	for {
+		switch m.r.Intn(8) {
+		case 0:
+			// Insert a random byte.
+			if len(b) >= maxBytesLen {
+				continue
+			}
+			pos := m.r.Intn(len(b) + 1)
+			b = append(b, 0)
+			copy(b[pos+1:], b[pos:])
+			b[pos] = byte(m.r.Intn(256))
+		case 1:
+			// Append a few random bytes.
+			n := m.chooseLen(8)
+			if len(b)+n > maxBytesLen {
+				continue
+			}
+			for i := 0; i < n; i++ {
+				b = append(b, byte(m.r.Intn(256)))
+			}
+		case 2:
+			// Remove a range of bytes.
+			if len(b) == 0 {
+				continue
+			}
+			pos := m.r.Intn(len(b))
+			n := m.chooseLen(len(b) - pos)
+			b = append(b[:pos], b[pos+n:]...)
+		case 3:
+			// Duplicate a range of bytes.
+			if len(b) == 0 {
+				continue
+			}
+			src := m.r.Intn(len(b))
+			n := m.chooseLen(len(b) - src)
+			if len(b)+n > maxBytesLen {
+				continue
+			}
+			dst := m.r.Intn(len(b) + 1)
+			chunk := append([]byte(nil), b[src:src+n]...)
+			b = append(b[:dst], append(chunk, b[dst:]...)...)
+		case 4:
+			// Flip a bit.
+			if len(b) == 0 {
+				continue
+			}
+			b[m.r.Intn(len(b))] ^= 1 << uint(m.r.Intn(8))
+		case 5:
+			// Set a byte to a random value.
+			if len(b) == 0 {
+				continue
+			}
+			b[m.r.Intn(len(b))] = byte(m.r.Intn(256))
+		case 6:
+			// Swap two bytes.
+			if len(b) < 2 {
+				continue
+			}
+			i, j := m.r.Intn(len(b)), m.r.Intn(len(b))
+			b[i], b[j] = b[j], b[i]
+		default:
+			// Set a byte to an interesting value.
+			if len(b) == 0 {
+				continue
+			}
+			b[m.r.Intn(len(b))] = interesting8[m.r.Intn(len(interesting8))]
+		}
+		return b
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fuzz
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMutatorKeepsTypes(t *testing.T) {
+	vals := []interface{}{
+		[]byte("abc"), "abc", true, byte(1), rune(1),
+		int(1), int8(1), int16(1), int64(1),
+		uint(1), uint16(1), uint32(1), uint64(1),
+		float32(1), float64(1),
+	}
+	types := make([]reflect.Type, len(vals))
+	for i, v := range vals {
+		types[i] = reflect.TypeOf(v)
+	}
+	m := newMutator(1)
+	changed := make([]bool, len(vals))
+	for i := 0; i < 10000; i++ {
+		before := copyValues(vals)
+		m.mutate(vals)
+		if err := CheckCorpus(vals, types); err != nil {
+			t.Fatalf("after %d mutations: %v", i+1, err)
+		}
+		for j := range vals {
+			if !reflect.DeepEqual(before[j], vals[j]) {
+				changed[j] = true
+			}
+		}
+	}
+	for i, c := range changed {
+		if !c {
+			t.Errorf("value %d of type %v was never mutated", i, types[i])
+		}
+	}
+}
+
+func TestMutateBytesLimit(t *testing.T) {
+	m := newMutator(1)
+	b := make([]byte, maxBytesLen)
+	for i := 0; i < 1000; i++ {
+		b = m.mutateBytes(b)
+		if len(b) > maxBytesLen {
+			t.Fatalf("mutated value has length %d, want at most %d", len(b), maxBytesLen)
+		}
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	counters := map[string][]uint32{"a.go": make([]uint32, 2), "b.go": make([]uint32, 1)}
+	c := newCoverage(counters)
+	run := func(a0, a1, b0 uint32) bool {
+		c.start()
+		counters["a.go"][0] += a0
+		counters["a.go"][1] += a1
+		counters["b.go"][0] += b0
+		return c.update()
+	}
+	for _, tt := range []struct {
+		a0, a1, b0 uint32
+		want       bool
+	}{
+		{1, 0, 0, true},   // first run of a0
+		{1, 0, 0, false},  // same again
+		{0, 0, 0, false},  // nothing
+		{0, 0, 1, true},   // new counter
+		{10, 0, 1, true},  // a0 in a new bucket
+		{12, 0, 1, false}, // a0 in the same bucket
+		{1, 1, 1, true},   // a1 for the first time
+	} {
+		if got := run(tt.a0, tt.a1, tt.b0); got != tt.want {
+			t.Errorf("running %d, %d, %d: got new coverage %v, want %v", tt.a0, tt.a1, tt.b0, got, tt.want)
+		}
+	}
+}
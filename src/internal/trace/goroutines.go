@@ -13,6 +13,33 @@ type GDesc struct {
 	StartTime    int64
 	EndTime      int64
 
+	// Regions are the user regions of the goroutine, in the order
+	// they end.
+	Regions []*UserRegionDesc
+
+	GExecutionStat
+
+	*gdesc // private part
+}
+
+// UserRegionDesc represents a region created by trace.WithRegion or
+// trace.StartRegion and the statistics of the goroutine while in it.
+type UserRegionDesc struct {
+	TaskID uint64
+	Name   string
+
+	// Start is the region start event, or nil if the region starts
+	// before the trace. End is the region end event, or nil if the
+	// region does not end before the goroutine or the trace does.
+	Start *Event
+	End   *Event
+
+	GExecutionStat
+}
+
+// GExecutionStat contains statistics about a goroutine's execution
+// during a period of time.
+type GExecutionStat struct {
 	ExecTime      int64
 	SchedWaitTime int64
 	IOTime        int64
@@ -21,8 +48,18 @@ type GDesc struct {
 	GCTime        int64
 	SweepTime     int64
 	TotalTime     int64
+}
 
-	*gdesc // private part
+func (s GExecutionStat) sub(v GExecutionStat) GExecutionStat {
+	s.ExecTime -= v.ExecTime
+	s.SchedWaitTime -= v.SchedWaitTime
+	s.IOTime -= v.IOTime
+	s.BlockTime -= v.BlockTime
+	s.SyscallTime -= v.SyscallTime
+	s.GCTime -= v.GCTime
+	s.SweepTime -= v.SweepTime
+	s.TotalTime -= v.TotalTime
+	return s
 }
 
 // gdesc is a private part of GDesc that is required only during analysis.
@@ -34,6 +71,56 @@ type gdesc struct {
 	blockSweepTime   int64
 	blockGCTime      int64
 	blockSchedTime   int64
+
+	activeRegions []*activeRegion // stack of regions in progress
+}
+
+// activeRegion is a region in progress with the goroutine statistics
+// at its start.
+type activeRegion struct {
+	*UserRegionDesc
+	start GExecutionStat
+}
+
+// snapshotStat returns the goroutine statistics as of ts, including the
+// times of the states the goroutine is still in. gcStartTime is the
+// start of the GC in progress, if any.
+func (g *GDesc) snapshotStat(ts, gcStartTime int64) GExecutionStat {
+	s := g.GExecutionStat
+	s.TotalTime = ts - g.CreationTime
+	if gcStartTime != 0 {
+		s.GCTime += ts - gcStartTime
+	}
+	if g.lastStartTime != 0 {
+		s.ExecTime += ts - g.lastStartTime
+	}
+	if g.blockNetTime != 0 {
+		s.IOTime += ts - g.blockNetTime
+	}
+	if g.blockSyncTime != 0 {
+		s.BlockTime += ts - g.blockSyncTime
+	}
+	if g.blockSyscallTime != 0 {
+		s.SyscallTime += ts - g.blockSyscallTime
+	}
+	if g.blockSweepTime != 0 {
+		s.SweepTime += ts - g.blockSweepTime
+	}
+	if g.blockSchedTime != 0 {
+		s.SchedWaitTime += ts - g.blockSchedTime
+	}
+	return s
+}
+
+// endRegion ends the innermost region in progress at ts, with the end
+// event ev, which is nil if the region does not end in the trace.
+func (g *GDesc) endRegion(ev *Event, ts, gcStartTime int64) {
+	n := len(g.activeRegions)
+	r := g.activeRegions[n-1]
+	g.activeRegions = g.activeRegions[:n-1]
+	r.End = ev
+	r.GExecutionStat = g.snapshotStat(ts, gcStartTime).sub(r.start)
+	g.Regions = append(g.Regions, r.UserRegionDesc)
 }
 
 // GoroutineStats generates statistics for all goroutines in the trace.
@@ -65,27 +152,36 @@ func GoroutineStats(events []*Event) map[uint64]*GDesc {
 		case EvGoEnd, EvGoStop:
 			g := gs[ev.G]
 			g.ExecTime += ev.Ts - g.lastStartTime
+			g.lastStartTime = 0
 			g.TotalTime = ev.Ts - g.CreationTime
 			g.EndTime = ev.Ts
+			for len(g.activeRegions) > 0 {
+				g.endRegion(nil, ev.Ts, gcStartTime)
+			}
 		case EvGoBlockSend, EvGoBlockRecv, EvGoBlockSelect,
 			EvGoBlockSync, EvGoBlockCond:
 			g := gs[ev.G]
 			g.ExecTime += ev.Ts - g.lastStartTime
+			g.lastStartTime = 0
 			g.blockSyncTime = ev.Ts
 		case EvGoSched, EvGoPreempt:
 			g := gs[ev.G]
 			g.ExecTime += ev.Ts - g.lastStartTime
+			g.lastStartTime = 0
 			g.blockSchedTime = ev.Ts
 		case EvGoSleep, EvGoBlock:
 			g := gs[ev.G]
 			g.ExecTime += ev.Ts - g.lastStartTime
+			g.lastStartTime = 0
 		case EvGoBlockNet:
 			g := gs[ev.G]
 			g.ExecTime += ev.Ts - g.lastStartTime
+			g.lastStartTime = 0
 			g.blockNetTime = ev.Ts
 		case EvGoBlockGC:
 			g := gs[ev.G]
 			g.ExecTime += ev.Ts - g.lastStartTime
+			g.lastStartTime = 0
 			g.blockGCTime = ev.Ts
 		case EvGoUnblock:
 			g := gs[ev.Args[0]]
@@ -101,6 +197,7 @@ func GoroutineStats(events []*Event) map[uint64]*GDesc {
 		case EvGoSysBlock:
 			g := gs[ev.G]
 			g.ExecTime += ev.Ts - g.lastStartTime
+			g.lastStartTime = 0
 			g.blockSyscallTime = ev.Ts
 		case EvGoSysExit:
 			g := gs[ev.G]
@@ -129,10 +226,33 @@ func GoroutineStats(events []*Event) map[uint64]*GDesc {
 					g.GCTime += ev.Ts - gcStartTime
 				}
 			}
+			gcStartTime = 0
+		case EvUserRegion:
+			g := gs[ev.G]
+			if g == nil {
+				break
+			}
+			switch mode := ev.Args[1]; mode {
+			case 0: // region start
+				r := &UserRegionDesc{TaskID: ev.Args[0], Name: ev.SArgs[0], Start: ev}
+				g.activeRegions = append(g.activeRegions, &activeRegion{r, g.snapshotStat(ev.Ts, gcStartTime)})
+			case 1: // region end
+				if len(g.activeRegions) > 0 {
+					g.endRegion(ev, ev.Ts, gcStartTime)
+				} else {
+					// The region started before the trace.
+					r := &UserRegionDesc{TaskID: ev.Args[0], Name: ev.SArgs[0], End: ev}
+					r.GExecutionStat = g.snapshotStat(ev.Ts, gcStartTime)
+					g.Regions = append(g.Regions, r)
+				}
+			}
 		}
 	}
 
 	for _, g := range gs {
+		for len(g.activeRegions) > 0 {
+			g.endRegion(nil, lastTs, gcStartTime)
+		}
 		if g.TotalTime == 0 {
 			g.TotalTime = lastTs - g.CreationTime
 		}
@@ -55,6 +55,9 @@ type Event struct {
 	// for blocking GoSysCall: the associated GoSysExit
 	// for GoSysExit: the next GoStart
 	// for GCMarkAssistStart: the associated GCMarkAssistDone
+	// for SearchTaskStart: the associated SearchTaskEnd
+	// for UserTaskCreate: the UserTaskEnd
+	// for UserRegion start: the corresponding UserRegion end
 	Link *Event
 }
 
@@ -130,9 +133,10 @@ func parse(r io.Reader, bin string) (int, ParseResult, error) {
 
 // rawEvent is a helper type used during parsing.
 type rawEvent struct {
-	off  int
-	typ  byte
-	args []uint64
+	off   int
+	typ   byte
+	args  []uint64
+	sargs []string
 }
 
 // readTrace does wire-format parsing and verification.
@@ -150,12 +154,13 @@ func readTrace(r io.Reader) (ver int, events []rawEvent, strings map[uint64]stri
 		return
 	}
 	switch ver {
-	case 1005, 1007, 1008, 1009, 1010:
+	case 1005, 1007, 1008, 1009, 1010, 1011, 1011 | verSearch:
 		// Note: When adding a new version, add canned traces
 		// from the old version to the test suite using mkcanned.bash.
 		break
 	default:
-		err = fmt.Errorf("unsupported trace file version %v.%v (update Go toolchain) %v", ver/1000, ver%1000, ver)
+		v := ver &^ verSearch
+		err = fmt.Errorf("unsupported trace file version %v.%v (update Go toolchain) %v", v/1000, v%1000, ver)
 		return
 	}
 
@@ -259,13 +264,23 @@ func readTrace(r io.Reader) (ver int, events []rawEvent, strings map[uint64]stri
 				return
 			}
 		}
+		if typ == EvUserLog {
+			// The log message follows the event [length, string].
+			var s string
+			s, off, err = readStr(r, off)
+			if err != nil {
+				return
+			}
+			ev.sargs = append(ev.sargs, s)
+		}
 		events = append(events, ev)
 	}
 	return
 }
 
 // parseHeader parses trace header of the form "go 1.7 trace\x00\x00\x00\x00"
-// and returns parsed version as 1007.
+// and returns parsed version as 1007. A header of the form
+// "go 1.11s trace\x00\x00" is parsed as version 1011 | verSearch.
 func parseHeader(buf []byte) (int, error) {
 	if len(buf) != 16 {
 		return 0, fmt.Errorf("bad header length")
@@ -282,12 +297,23 @@ func parseHeader(buf []byte) (int, error) {
 		ver = ver*10 + int(buf[6+i]-'0')
 	}
 	ver += int(buf[3]-'0') * 1000
+	if buf[6+i] == 's' {
+		ver |= verSearch
+		i++
+	}
 	if !bytes.Equal(buf[6+i:], []byte(" trace\x00\x00\x00\x00")[:10-i]) {
 		return 0, fmt.Errorf("not a trace file")
 	}
 	return ver, nil
 }
 
+// verSearch marks the version of the traces written by this tree, which
+// add the search events to the events of the upstream version. Their
+// header follows the version with an 's', as in "go 1.11s trace", so
+// that upstream tools reject them as a whole instead of failing on the
+// first search event.
+const verSearch = 1 << 16
+
 // Parse events transforms raw events into events.
 // It does analyze and verify per-event-type arguments.
 func parseEvents(ver int, rawEvents []rawEvent, strings map[uint64]string) (events []*Event, stacks map[uint64][]*Frame, err error) {
@@ -416,6 +442,15 @@ func parseEvents(ver int, rawEvents []rawEvent, strings map[uint64]string) (even
 				lastG = 0
 			case EvGoSysExit, EvGoWaiting, EvGoInSyscall:
 				e.G = e.Args[0]
+			case EvUserTaskCreate:
+				// e.Args 0: taskID, 1: parentID, 2: nameID
+				e.SArgs = []string{strings[e.Args[2]]}
+			case EvUserRegion:
+				// e.Args 0: taskID, 1: mode, 2: nameID
+				e.SArgs = []string{strings[e.Args[2]]}
+			case EvUserLog:
+				// e.Args 0: taskID, 1: keyID
+				e.SArgs = []string{strings[e.Args[1]], raw.sargs[0]}
 			}
 			batches[lastP] = append(batches[lastP], e)
 		}
@@ -553,6 +588,11 @@ func postProcessTrace(ver int, events []*Event) error {
 	ps := make(map[int]pdesc)
 	gs[0] = gdesc{state: gRunning}
 	var evGC, evSTW *Event
+	// searchTasks are the search subtrees in progress,
+	// by search id and graph node id.
+	searchTasks := make(map[[2]uint64]*Event)
+	tasks := make(map[uint64]*Event)           // task id to task creation events
+	activeRegions := make(map[uint64][]*Event) // goroutine id to stack of regions
 
 	checkRunning := func(p pdesc, g gdesc, ev *Event, allowG0 bool) error {
 		name := EventDescriptions[ev.Type].Name
@@ -637,6 +677,63 @@ func postProcessTrace(ver int, events []*Event) error {
 				g.evMarkAssist.Link = ev
 				g.evMarkAssist = nil
 			}
+		case EvSearchTaskStart:
+			if err := checkRunning(p, g, ev, false); err != nil {
+				return err
+			}
+			task := [2]uint64{ev.Args[0], ev.Args[1]}
+			if searchTasks[task] != nil {
+				return fmt.Errorf("search %v subtree %v started twice (offset %v, time %v)", ev.Args[0], ev.Args[1], ev.Off, ev.Ts)
+			}
+			searchTasks[task] = ev
+		case EvSearchTaskEnd:
+			if err := checkRunning(p, g, ev, false); err != nil {
+				return err
+			}
+			// The subtree may have been started before tracing.
+			task := [2]uint64{ev.Args[0], ev.Args[1]}
+			if start := searchTasks[task]; start != nil {
+				start.Link = ev
+				delete(searchTasks, task)
+			}
+		case EvUserTaskCreate:
+			taskid := ev.Args[0]
+			if prevEv, ok := tasks[taskid]; ok {
+				return fmt.Errorf("task id conflicts (id:%d), %v vs %v", taskid, ev, prevEv)
+			}
+			tasks[taskid] = ev
+		case EvUserTaskEnd:
+			// The task may have been created before tracing.
+			taskid := ev.Args[0]
+			if taskCreateEv, ok := tasks[taskid]; ok {
+				taskCreateEv.Link = ev
+				delete(tasks, taskid)
+			}
+		case EvUserRegion:
+			mode := ev.Args[1]
+			regions := activeRegions[ev.G]
+			switch mode {
+			case 0: // region start
+				activeRegions[ev.G] = append(regions, ev)
+			case 1: // region end
+				// The region may have been started before tracing.
+				n := len(regions)
+				if n == 0 {
+					break
+				}
+				s := regions[n-1]
+				if s.Args[0] != ev.Args[0] || s.SArgs[0] != ev.SArgs[0] {
+					return fmt.Errorf("misuse of region in goroutine %d: region end %v when the inner-most active region start event is %v", ev.G, ev, s)
+				}
+				s.Link = ev
+				if n > 1 {
+					activeRegions[ev.G] = regions[:n-1]
+				} else {
+					delete(activeRegions, ev.G)
+				}
+			default:
+				return fmt.Errorf("invalid user region mode: %v", ev)
+			}
 		case EvGCSweepDone:
 			if p.evSweep == nil {
 				return fmt.Errorf("bogus sweeping end (offset %v, time %v)", ev.Off, ev.Ts)
@@ -841,6 +938,24 @@ func symbolize(events []*Event, bin string) error {
 	return nil
 }
 
+// readStr reads a length-prefixed string from r.
+func readStr(r io.Reader, off0 int) (s string, off int, err error) {
+	var ln uint64
+	ln, off, err = readVal(r, off0)
+	if err != nil {
+		return "", off, err
+	}
+	if ln > 1e6 {
+		return "", off, fmt.Errorf("string at offset %d has too large length %v", off, ln)
+	}
+	buf := make([]byte, ln)
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		return "", off, fmt.Errorf("failed to read trace at offset %d: read %v, want %v, error %v", off, n, ln, err)
+	}
+	return string(buf), off + n, nil
+}
+
 // readVal reads unsigned base-128 value from r.
 func readVal(r io.Reader, off0 int) (v uint64, off int, err error) {
 	off = off0
@@ -869,12 +984,20 @@ func Print(events []*Event) {
 
 // PrintEvent dumps the event to stdout. For debugging.
 func PrintEvent(ev *Event) {
+	fmt.Printf("%s\n", ev)
+}
+
+func (ev *Event) String() string {
 	desc := EventDescriptions[ev.Type]
-	fmt.Printf("%v %v p=%v g=%v off=%v", ev.Ts, desc.Name, ev.P, ev.G, ev.Off)
+	w := new(bytes.Buffer)
+	fmt.Fprintf(w, "%v %v p=%v g=%v off=%v", ev.Ts, desc.Name, ev.P, ev.G, ev.Off)
 	for i, a := range desc.Args {
-		fmt.Printf(" %v=%v", a, ev.Args[i])
+		fmt.Fprintf(w, " %v=%v", a, ev.Args[i])
+	}
+	for _, a := range ev.SArgs {
+		fmt.Fprintf(w, " %q", a)
 	}
-	fmt.Printf("\n")
+	return w.String()
 }
 
 // argNum returns total number of args for the event accounting for timestamps,
@@ -967,7 +1090,13 @@ const (
 	EvGoBlockGC         = 42 // goroutine blocks on GC assist [timestamp, stack]
 	EvGCMarkAssistStart = 43 // GC mark assist start [timestamp, stack]
 	EvGCMarkAssistDone  = 44 // GC mark assist done [timestamp]
-	EvCount             = 45
+	EvUserTaskCreate    = 45 // trace.NewTask [timestamp, internal task id, internal parent id, name string id, stack]
+	EvUserTaskEnd       = 46 // end of task [timestamp, internal task id, stack]
+	EvUserRegion        = 47 // trace.WithRegion [timestamp, internal task id, mode(0:start, 1:end), name string id, stack]
+	EvUserLog           = 48 // trace.Log [timestamp, internal task id, key string id, stack, value string]
+	EvSearchTaskStart   = 49 // search engine starts expanding a subtree [timestamp, search id, graph node id, parent graph node id]
+	EvSearchTaskEnd     = 50 // search engine is done with a subtree [timestamp, search id, graph node id]
+	EvCount             = 51
 )
 
 var EventDescriptions = [EvCount]struct {
@@ -1021,4 +1150,10 @@ var EventDescriptions = [EvCount]struct {
 	EvGoBlockGC:         {"GoBlockGC", 1008, true, []string{}},
 	EvGCMarkAssistStart: {"GCMarkAssistStart", 1009, true, []string{}},
 	EvGCMarkAssistDone:  {"GCMarkAssistDone", 1009, false, []string{}},
+	EvUserTaskCreate:    {"UserTaskCreate", 1011, true, []string{"taskid", "pid", "typeid"}},
+	EvUserTaskEnd:       {"UserTaskEnd", 1011, true, []string{"taskid"}},
+	EvUserRegion:        {"UserRegion", 1011, true, []string{"taskid", "mode", "typeid"}},
+	EvUserLog:           {"UserLog", 1011, true, []string{"id", "keyid"}},
+	EvSearchTaskStart:   {"SearchTaskStart", 1011 | verSearch, false, []string{"search", "id", "parent"}},
+	EvSearchTaskEnd:     {"SearchTaskEnd", 1011 | verSearch, false, []string{"search", "id"}},
 }
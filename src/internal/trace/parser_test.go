@@ -6,6 +6,7 @@ package trace
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"strings"
@@ -65,6 +66,9 @@ func TestParseVersion(t *testing.T) {
 		"go 1.5 trace\x00\x00\x00\x00": 1005,
 		"go 1.7 trace\x00\x00\x00\x00": 1007,
 		"go 1.10 trace\x00\x00\x00":    1010,
+		"go 1.11 trace\x00\x00\x00":    1011,
+		"go 1.11s trace\x00\x00":       1011 | verSearch,
+		"go 1.11x trace\x00\x00":       -1,
 		"go 1.25 trace\x00\x00\x00":    1025,
 		"go 1.234 trace\x00\x00":       1234,
 		"go 1.2345 trace\x00":          -1,
@@ -100,3 +104,174 @@ func TestTimestampOverflow(t *testing.T) {
 		t.Fatalf("failed to parse: %v", err)
 	}
 }
+
+func TestUpstreamEventTypes(t *testing.T) {
+	// Go 1.11 traces number the user annotation events from 45.
+	upstream := map[byte]string{
+		45: "UserTaskCreate",
+		46: "UserTaskEnd",
+		47: "UserRegion",
+		48: "UserLog",
+	}
+	for typ, name := range upstream {
+		if got := EventDescriptions[typ].Name; got != name {
+			t.Errorf("event %d is %s, want %s", typ, got, name)
+		}
+	}
+	for _, typ := range []byte{EvSearchTaskStart, EvSearchTaskEnd} {
+		if typ <= 48 {
+			t.Errorf("search event %s numbered %d, want after the upstream events", EventDescriptions[typ].Name, typ)
+		}
+	}
+}
+
+func TestSearchTasks(t *testing.T) {
+	w := NewWriter()
+	w.Emit(EvBatch, 0, 0)
+	w.Emit(EvFrequency, 1e9)
+	w.Emit(EvGoCreate, 1, 10, 0, 0)
+	w.Emit(EvGoStartLocal, 1, 10)
+	w.Emit(EvSearchTaskStart, 1, 7, 1, 0)
+	w.Emit(EvSearchTaskStart, 1, 7, 2, 1)
+	w.Emit(EvSearchTaskEnd, 1, 7, 2)
+	w.Emit(EvSearchTaskEnd, 1, 7, 1)
+	res, err := Parse(w, "")
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	var starts []*Event
+	for _, ev := range res.Events {
+		if ev.Type == EvSearchTaskStart {
+			starts = append(starts, ev)
+		}
+	}
+	if len(starts) != 2 {
+		t.Fatalf("got %d search subtrees, want 2", len(starts))
+	}
+	for _, ev := range starts {
+		if ev.Link == nil || ev.Link.Type != EvSearchTaskEnd || ev.Link.Args[1] != ev.Args[1] {
+			t.Errorf("subtree %d is not linked to its end: %v", ev.Args[1], ev.Link)
+		}
+	}
+
+	w = NewWriter()
+	w.Emit(EvBatch, 0, 0)
+	w.Emit(EvFrequency, 1e9)
+	w.Emit(EvGoCreate, 1, 10, 0, 0)
+	w.Emit(EvGoStartLocal, 1, 10)
+	w.Emit(EvSearchTaskStart, 1, 7, 1, 0)
+	w.Emit(EvSearchTaskStart, 1, 7, 1, 0)
+	if _, err := Parse(w, ""); err == nil {
+		t.Errorf("subtree started twice is not detected")
+	}
+}
+
+func TestSearchEventsRoundTrip(t *testing.T) {
+	emit := func(w *Writer) {
+		w.Emit(EvBatch, 0, 0)
+		w.Emit(EvFrequency, 1e9)
+		w.Emit(EvGoCreate, 1, 10, 0, 0)
+		w.Emit(EvGoStartLocal, 1, 10)
+		w.Emit(EvSearchTaskStart, 1, 7, 3, 1)
+		w.Emit(EvSearchTaskEnd, 1, 7, 3)
+	}
+	w := NewWriter()
+	emit(w)
+	res, err := Parse(w, "")
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	var got []*Event
+	for _, ev := range res.Events {
+		if ev.Type == EvSearchTaskStart || ev.Type == EvSearchTaskEnd {
+			got = append(got, ev)
+		}
+	}
+	want := []struct {
+		typ  byte
+		args []uint64
+	}{
+		{EvSearchTaskStart, []uint64{7, 3, 1}},
+		{EvSearchTaskEnd, []uint64{7, 3}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d search events, want %d", len(got), len(want))
+	}
+	for i, ev := range got {
+		w := want[i]
+		if ev.Type != w.typ || ev.G != 10 {
+			t.Errorf("event %d is %s on goroutine %d, want %s on goroutine 10",
+				i, EventDescriptions[ev.Type].Name, ev.G, EventDescriptions[w.typ].Name)
+		}
+		for j, arg := range w.args {
+			if ev.Args[j] != arg {
+				t.Errorf("%s argument %s is %d, want %d", EventDescriptions[ev.Type].Name, EventDescriptions[ev.Type].Args[j], ev.Args[j], arg)
+			}
+		}
+	}
+
+	// Upstream traces have no search events.
+	var buf bytes.Buffer
+	buf.WriteString("go 1.11 trace\x00\x00\x00")
+	w = NewWriter()
+	emit(w)
+	buf.Write(w.Bytes()[16:])
+	if _, err := Parse(&buf, ""); err == nil || !strings.Contains(err.Error(), "unknown event type 49") {
+		t.Errorf("search event in a go 1.11 trace: got error %v, want unknown event type 49", err)
+	}
+}
+
+func TestUserAnnotations(t *testing.T) {
+	w := NewWriter()
+	w.Emit(EvBatch, 0, 0)
+	w.Emit(EvFrequency, 1e9)
+	w.EmitString(1, "order")
+	w.EmitString(2, "steam")
+	w.EmitString(3, "orderID")
+	w.Emit(EvGoCreate, 1, 10, 0, 0)
+	w.Emit(EvGoStartLocal, 1, 10)
+	w.Emit(EvUserTaskCreate, 1, 5, 0, 1, 0) // [timestamp, task id, parent task id, name string id, stack]
+	w.EmitLog("42", 1, 5, 3, 0)             // [timestamp, task id, key string id, stack]
+	w.Emit(EvUserRegion, 1, 5, 0, 2, 0)     // [timestamp, task id, mode, name string id, stack]
+	w.Emit(EvUserRegion, 1, 5, 1, 2, 0)
+	w.Emit(EvUserTaskEnd, 1, 5, 0) // [timestamp, task id, stack]
+	res, err := Parse(w, "")
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	var got []string
+	for _, ev := range res.Events {
+		switch ev.Type {
+		case EvUserTaskCreate:
+			if ev.Link == nil || ev.Link.Type != EvUserTaskEnd {
+				t.Errorf("task is not linked to its end: %v", ev.Link)
+			}
+		case EvUserRegion:
+			if ev.Args[1] == 0 && (ev.Link == nil || ev.Link.Type != EvUserRegion || ev.Link.Args[1] != 1) {
+				t.Errorf("region is not linked to its end: %v", ev.Link)
+			}
+		case EvUserLog:
+		default:
+			continue
+		}
+		got = append(got, fmt.Sprint(ev.SArgs))
+	}
+	want := []string{"[order]", "[orderID 42]", "[steam]", "[steam]"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got string arguments %v, want %v", got, want)
+	}
+
+	w = NewWriter()
+	w.Emit(EvBatch, 0, 0)
+	w.Emit(EvFrequency, 1e9)
+	w.EmitString(1, "outer")
+	w.EmitString(2, "inner")
+	w.Emit(EvGoCreate, 1, 10, 0, 0)
+	w.Emit(EvGoStartLocal, 1, 10)
+	w.Emit(EvUserRegion, 1, 0, 0, 1, 0)
+	w.Emit(EvUserRegion, 1, 0, 0, 2, 0)
+	w.Emit(EvUserRegion, 1, 0, 1, 1, 0)
+	if _, err := Parse(w, ""); err == nil {
+		t.Errorf("overlapping regions are not detected")
+	}
+}
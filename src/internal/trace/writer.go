@@ -9,7 +9,7 @@ type Writer struct {
 
 func NewWriter() *Writer {
 	w := new(Writer)
-	w.Write([]byte("go 1.9 trace\x00\x00\x00\x00"))
+	w.Write([]byte("go 1.11s trace\x00\x00"))
 	return w
 }
 
@@ -30,6 +30,28 @@ func (w *Writer) Emit(typ byte, args ...uint64) {
 	if nargs == 3 {
 		buf[1] = byte(len(buf) - 2)
 	}
+	w.write(buf)
+}
+
+// EmitString writes a string dictionary entry to the trace.
+func (w *Writer) EmitString(id uint64, s string) {
+	buf := []byte{EvString}
+	buf = appendVarint(buf, id)
+	buf = appendVarint(buf, uint64(len(s)))
+	buf = append(buf, s...)
+	w.write(buf)
+}
+
+// EmitLog writes a UserLog event record with the given arguments and
+// message to the trace.
+func (w *Writer) EmitLog(message string, args ...uint64) {
+	w.Emit(EvUserLog, args...)
+	buf := appendVarint(nil, uint64(len(message)))
+	buf = append(buf, message...)
+	w.write(buf)
+}
+
+func (w *Writer) write(buf []byte) {
 	n, err := w.Write(buf)
 	if n != len(buf) || err != nil {
 		panic("failed to write")
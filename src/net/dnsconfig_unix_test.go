@@ -23,7 +23,7 @@ var dnsReadConfigTests = []struct {
 		name: "testdata/resolv.conf",
 		want: &dnsConfig{
 			servers:    []string{"8.8.8.8:53", "[2001:4860:4860::8888]:53", "[fe80::1%lo0]:53"},
-			search:     []string{"localdomain."},
+			__search:   []string{"localdomain."},
 			ndots:      5,
 			timeout:    10 * time.Second,
 			attempts:   3,
@@ -35,7 +35,7 @@ var dnsReadConfigTests = []struct {
 		name: "testdata/domain-resolv.conf",
 		want: &dnsConfig{
 			servers:  []string{"8.8.8.8:53"},
-			search:   []string{"localdomain."},
+			__search: []string{"localdomain."},
 			ndots:    1,
 			timeout:  5 * time.Second,
 			attempts: 2,
@@ -45,7 +45,7 @@ var dnsReadConfigTests = []struct {
 		name: "testdata/search-resolv.conf",
 		want: &dnsConfig{
 			servers:  []string{"8.8.8.8:53"},
-			search:   []string{"test.", "invalid."},
+			__search: []string{"test.", "invalid."},
 			ndots:    1,
 			timeout:  5 * time.Second,
 			attempts: 2,
@@ -58,7 +58,7 @@ var dnsReadConfigTests = []struct {
 			ndots:    1,
 			timeout:  5 * time.Second,
 			attempts: 2,
-			search:   []string{"domain.local."},
+			__search: []string{"domain.local."},
 		},
 	},
 	{
@@ -68,7 +68,7 @@ var dnsReadConfigTests = []struct {
 			ndots:    0,
 			timeout:  5 * time.Second,
 			attempts: 2,
-			search:   []string{"domain.local."},
+			__search: []string{"domain.local."},
 		},
 	},
 	{
@@ -78,7 +78,7 @@ var dnsReadConfigTests = []struct {
 			ndots:    15,
 			timeout:  5 * time.Second,
 			attempts: 2,
-			search:   []string{"domain.local."},
+			__search: []string{"domain.local."},
 		},
 	},
 	{
@@ -88,7 +88,7 @@ var dnsReadConfigTests = []struct {
 			ndots:    0,
 			timeout:  5 * time.Second,
 			attempts: 2,
-			search:   []string{"domain.local."},
+			__search: []string{"domain.local."},
 		},
 	},
 	{
@@ -99,7 +99,7 @@ var dnsReadConfigTests = []struct {
 			attempts: 2,
 			lookup:   []string{"file", "bind"},
 			servers:  []string{"169.254.169.254:53", "10.240.0.1:53"},
-			search:   []string{"c.symbolic-datum-552.internal."},
+			__search: []string{"c.symbolic-datum-552.internal."},
 		},
 	},
 }
@@ -136,7 +136,7 @@ func TestDNSReadMissingFile(t *testing.T) {
 		ndots:    1,
 		timeout:  5 * time.Second,
 		attempts: 2,
-		search:   []string{"domain.local."},
+		__search: []string{"domain.local."},
 	}
 	if !reflect.DeepEqual(conf, want) {
 		t.Errorf("missing resolv.conf:\ngot: %+v\nwant: %+v", conf, want)
@@ -204,7 +204,7 @@ func TestDNSNameLength(t *testing.T) {
 		}
 
 		var shortestSuffix int
-		for _, suffix := range tt.want.search {
+		for _, suffix := range tt.want.__search {
 			if shortestSuffix == 0 || len(suffix) < shortestSuffix {
 				shortestSuffix = len(suffix)
 			}
@@ -0,0 +1,98 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Runtime support for search statements.
+//
+// The compiler lowers a search statement to a set of engine goroutines
+// which split the search tree into subtrees. Each subtree is described
+// by a graph node with an ID that is unique within the search and the
+// ID of the graph node it was split off from. The engine reports the
+// subtrees to the execution tracer, so that the trace shows how the
+// tree was partitioned among the engine goroutines.
+
+package runtime
+
+import "runtime/internal/atomic"
+
+// searchSeq is the ID of the last search statement started.
+var searchSeq uint64
+
+// searchStart returns the ID of a new search statement.
+func searchStart() int {
+	return int(atomic.Xadd64(&searchSeq, 1))
+}
+
+// searchTaskStart records that the calling engine goroutine starts
+// expanding the subtree with graph node id, split off from the subtree
+// with graph node parent, in the search statement with ID searchID.
+func searchTaskStart(searchID, id, parent int) {
+	if trace.enabled {
+		traceEvent(traceEvSearchTaskStart, -1, uint64(searchID), uint64(id), uint64(parent))
+	}
+}
+
+// searchTaskEnd records that the calling engine goroutine is done with
+// the subtree with graph node id in the search statement with ID searchID.
+func searchTaskEnd(searchID, id int) {
+	if trace.enabled {
+		traceEvent(traceEvSearchTaskEnd, -1, uint64(searchID), uint64(id))
+	}
+}
+
+// searchBudget takes one node from the budget of nodes a search
+// statement with the maxNodes option may expand, and reports whether
+// the budget allowed it.
+func searchBudget(budget *int64) bool {
+	return atomic.Xaddint64(budget, -1) >= 0
+}
+
+// searchNanotime returns the time for the statistics of a search
+// statement.
+func searchNanotime() int64 {
+	return nanotime()
+}
+
+// A SearchPanic is the value with which a search statement panics when
+// a clause of the search panicked on one of its engine goroutines. The
+// engine stops the search at the first such panic and raises the
+// SearchPanic on the goroutine running the search statement once all
+// engine goroutines are done. Stack keeps the source positions of the
+// original panic, and the message of a SearchPanic that is not
+// recovered includes it.
+type SearchPanic struct {
+	Value interface{} // value passed to panic
+	Stack []byte      // trace of the panicking goroutine, formatted by Stack
+}
+
+func (p *SearchPanic) Error() string {
+	var msg string
+	switch v := p.Value.(type) {
+	case error:
+		msg = v.Error()
+	case stringer:
+		msg = v.String()
+	case string:
+		msg = v
+	default:
+		msg = "value of type " + typestring(v)
+	}
+	return "search clause panicked: " + msg + "\n\n" + string(p.Stack)
+}
+
+// searchPanic returns the SearchPanic for the value v recovered on an
+// engine goroutine, with the stack of the calling goroutine, which is
+// still panicking. A SearchPanic of a nested search is kept as is.
+func searchPanic(v interface{}) interface{} {
+	if p, ok := v.(*SearchPanic); ok {
+		return p
+	}
+	buf := make([]byte, 4096)
+	for {
+		n := Stack(buf, false)
+		if n < len(buf) {
+			return &SearchPanic{Value: v, Stack: buf[:n]}
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
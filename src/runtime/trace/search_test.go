@@ -0,0 +1,60 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace_test
+
+import (
+	"bytes"
+	"internal/trace"
+	. "runtime/trace"
+	"testing"
+)
+
+func TestSearchTasks(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := Start(buf); err != nil {
+		t.Fatalf("failed to start tracing: %v", err)
+	}
+	leaves := 0
+	search 0; int; 4 {
+	children:
+		if node >= 7 {
+			return nil
+		}
+		return []int{2*node + 1, 2*node + 2}
+	accept:
+		return node >= 7
+	results:
+		leaves++
+	}
+	Stop()
+	saveTrace(t, buf, "TestSearchTasks")
+	if leaves != 8 {
+		t.Fatalf("search found %d leaves, want 8", leaves)
+	}
+
+	res, err := trace.Parse(buf, "")
+	if err == trace.ErrTimeOrder {
+		t.Skipf("skipping trace: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("failed to parse trace: %v", err)
+	}
+	starts, ends := 0, 0
+	for _, ev := range res.Events {
+		switch ev.Type {
+		case trace.EvSearchTaskStart:
+			starts++
+			if ev.Link == nil || ev.Link.Type != trace.EvSearchTaskEnd ||
+				ev.Link.Args[0] != ev.Args[0] || ev.Link.Args[1] != ev.Args[1] {
+				t.Errorf("search %d subtree %d is not linked to its end: %v", ev.Args[0], ev.Args[1], ev.Link)
+			}
+		case trace.EvSearchTaskEnd:
+			ends++
+		}
+	}
+	if starts == 0 || starts != ends {
+		t.Errorf("got %d search subtrees started and %d ended, want as many, at least 1", starts, ends)
+	}
+}
@@ -273,7 +273,7 @@ func anyToSockaddr(rsa *RawSockaddrAny) (Sockaddr, error) {
 func Accept(fd int) (nfd int, sa Sockaddr, err error) {
 	var rsa RawSockaddrAny
 	var len _Socklen = SizeofSockaddrAny
-	nfd, err = _accept(fd, &rsa, &len)
+	nfd, err = accept(fd, &rsa, &len)
 	if err != nil {
 		return
 	}
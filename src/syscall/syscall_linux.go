@@ -436,7 +436,7 @@ func anyToSockaddr(rsa *RawSockaddrAny) (Sockaddr, error) {
 func Accept(fd int) (nfd int, sa Sockaddr, err error) {
 	var rsa RawSockaddrAny
 	var len _Socklen = SizeofSockaddrAny
-	nfd, err = _accept(fd, &rsa, &len)
+	nfd, err = accept(fd, &rsa, &len)
 	if err != nil {
 		return
 	}
@@ -451,7 +451,7 @@ func Accept(fd int) (nfd int, sa Sockaddr, err error) {
 func Accept4(fd int, flags int) (nfd int, sa Sockaddr, err error) {
 	var rsa RawSockaddrAny
 	var len _Socklen = SizeofSockaddrAny
-	nfd, err = _accept4(fd, &rsa, &len, flags)
+	nfd, err = accept4(fd, &rsa, &len, flags)
 	if err != nil {
 		return
 	}
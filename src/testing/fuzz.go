@@ -0,0 +1,296 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testing
+
+import (
+	"flag"
+	"fmt"
+	"internal/race"
+	"os"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+var (
+	matchFuzz        = flag.String("test.fuzz", "", "fuzz the fuzz target matching `regexp`")
+	fuzzDuration     = flag.Duration("test.fuzztime", 0, "fuzz for duration `d` (default 0, fuzz until a failure is found or interrupted)")
+	minimizeDuration = flag.Duration("test.fuzzminimizetime", 60*time.Second, "spend at most duration `d` minimizing a failing input")
+	fuzzCacheDir     = flag.String("test.fuzzcachedir", "", "keep interesting fuzzing inputs in `dir` (for use only by cmd/go)")
+)
+
+// An internal type but exported because it is cross-package; part of the implementation
+// of the "go test" command.
+type InternalFuzzTarget struct {
+	Name string
+	Fn   func(f *F)
+}
+
+// corpusEntry is an alias to the same type as internal/fuzz.CorpusEntry.
+// It is an alias because package testing cannot import internal/fuzz,
+// which is reached through testDeps instead.
+type corpusEntry = struct {
+	Name   string
+	Path   string
+	Values []interface{}
+}
+
+// F is a type passed to fuzz targets.
+//
+// A fuzz target adds seed inputs with Add and then calls Fuzz with a
+// function that is run on each input. Without the -fuzz flag, go test
+// runs the function on the seed inputs and on the inputs stored in
+// testdata/fuzz/FuzzXxx, as an ordinary test. With the -fuzz flag, it
+// also generates new inputs until one of them fails.
+//
+// Like a Test function, a fuzz target ends when it returns or calls any of
+// the methods FailNow, Fatal, Fatalf, SkipNow, Skip, or Skipf.
+type F struct {
+	*common
+	t       *T // the test running the fuzz target
+	deps    testDeps
+	name    string // name of the fuzz target, without subtest elements
+	fuzzing bool   // fuzz rather than only run the corpus
+
+	corpus     []corpusEntry // seed corpus added with Add
+	fuzzCalled bool
+}
+
+var _ TB = (*F)(nil)
+
+// fuzzTypes are the types that fuzz function parameters may have.
+var fuzzTypes = map[reflect.Type]bool{
+	reflect.TypeOf(([]byte)("")): true,
+	reflect.TypeOf((string)("")): true,
+	reflect.TypeOf((bool)(true)): true,
+	reflect.TypeOf((byte)(0)):    true,
+	reflect.TypeOf((rune)(0)):    true,
+	reflect.TypeOf((float32)(0)): true,
+	reflect.TypeOf((float64)(0)): true,
+	reflect.TypeOf((int)(0)):     true,
+	reflect.TypeOf((int8)(0)):    true,
+	reflect.TypeOf((int16)(0)):   true,
+	reflect.TypeOf((int64)(0)):   true,
+	reflect.TypeOf((uint)(0)):    true,
+	reflect.TypeOf((uint16)(0)):  true,
+	reflect.TypeOf((uint32)(0)):  true,
+	reflect.TypeOf((uint64)(0)):  true,
+}
+
+// Add adds the arguments to the seed corpus of the fuzz target. The
+// arguments must match, in number and type, the parameters of the function
+// passed to Fuzz after its *T. Add must be called before Fuzz.
+func (f *F) Add(args ...interface{}) {
+	if f.fuzzCalled {
+		panic("testing: F.Add called after F.Fuzz")
+	}
+	vals := make([]interface{}, len(args))
+	copy(vals, args)
+	f.corpus = append(f.corpus, corpusEntry{Name: fmt.Sprintf("seed#%d", len(f.corpus)), Values: vals})
+}
+
+// Fuzz runs the fuzz function ff on the corpus of the fuzz target. ff must
+// be a function with no results whose first parameter is a *T and whose
+// other parameters are the values to fuzz, for example
+//	func(t *testing.T, data []byte, n int)
+// The values may have type []byte, string, bool, byte, rune, float32,
+// float64, or any integer type other than uintptr.
+//
+// Each input of the corpus runs as a subtest named "seed#N" for the
+// arguments of the Nth call to Add, or after the file holding it for the
+// inputs in testdata/fuzz/FuzzXxx. A failing input found by 'go test -fuzz'
+// is written to that directory, so from then on it is run by 'go test'
+// like any other test.
+//
+// When fuzzing, ff runs sequentially on one input after another, each
+// with a new *T. Coverage of the package under test guides how new inputs
+// are generated. ff must therefore be fast and deterministic, and must
+// not keep its arguments after it returns. An input that makes ff fail
+// or panic is minimized and reported; one that makes the whole process
+// crash, for example by exhausting memory, is not caught.
+//
+// Fuzz may be called only once by a fuzz target.
+func (f *F) Fuzz(ff interface{}) {
+	if f.fuzzCalled {
+		panic("testing: F.Fuzz called more than once")
+	}
+	f.fuzzCalled = true
+	f.Helper()
+
+	fn := reflect.ValueOf(ff)
+	if fn.Kind() != reflect.Func {
+		panic("testing: F.Fuzz must receive a function")
+	}
+	fnType := fn.Type()
+	if fnType.NumIn() < 2 || fnType.In(0) != reflect.TypeOf((*T)(nil)) {
+		panic("testing: fuzz function must receive at least two arguments, where the first argument is a *T")
+	}
+	if fnType.NumOut() != 0 {
+		panic("testing: fuzz function must not return a value")
+	}
+	var types []reflect.Type
+	for i := 1; i < fnType.NumIn(); i++ {
+		t := fnType.In(i)
+		if !fuzzTypes[t] {
+			panic(fmt.Sprintf("testing: unsupported type for fuzzing %v", t))
+		}
+		types = append(types, t)
+	}
+
+	for _, e := range f.corpus {
+		if err := f.deps.CheckCorpus(e.Values, types); err != nil {
+			f.Fatalf("%s: %v", e.Name, err)
+		}
+	}
+	corpusDir := "testdata/fuzz/" + f.name
+	stored, err := f.deps.ReadCorpus(corpusDir, types)
+	if err != nil {
+		f.Fatal(err)
+	}
+	corpus := append(f.corpus, stored...)
+
+	if f.fuzzing {
+		f.fuzz(fn, types, corpus, corpusDir)
+		return
+	}
+	for _, e := range corpus {
+		vals := e.Values
+		f.t.Run(e.Name, func(t *T) {
+			callFuzzFn(fn, t, vals)
+		})
+	}
+}
+
+// callFuzzFn calls the fuzz function fn with t and vals.
+func callFuzzFn(fn reflect.Value, t *T, vals []interface{}) {
+	args := make([]reflect.Value, 0, 1+len(vals))
+	args = append(args, reflect.ValueOf(t))
+	for _, v := range vals {
+		args = append(args, reflect.ValueOf(v))
+	}
+	fn.Call(args)
+}
+
+// fuzz runs the fuzzing engine on fn, starting from corpus, and
+// reports the failing input it finds, if any.
+func (f *F) fuzz(fn reflect.Value, types []reflect.Type, corpus []corpusEntry, corpusDir string) {
+	cacheDir := ""
+	if *fuzzCacheDir != "" {
+		cacheDir = *fuzzCacheDir + "/" + f.name
+	}
+	err := f.deps.CoordinateFuzzing(*fuzzDuration, *minimizeDuration, corpus, types, cover.Counters, corpusDir, cacheDir, func(e corpusEntry) error {
+		return f.fuzzOnce(fn, e.Values)
+	})
+	if err != nil {
+		f.Fail()
+		f.mu.Lock()
+		fmt.Fprintf(indenter{f.common}, "%s\n", strings.TrimRight(err.Error(), "\n"))
+		f.mu.Unlock()
+	}
+}
+
+// fuzzOnce runs fn on vals with a new T, recovering from panics. If fn
+// fails, fuzzOnce returns an error holding the output of the T.
+func (f *F) fuzzOnce(fn reflect.Value, vals []interface{}) error {
+	t := &T{
+		common: common{
+			signal:  make(chan bool),
+			barrier: make(chan bool),
+			name:    f.name,
+		},
+		context: f.t.context,
+	}
+	t.w = indenter{&t.common}
+	go func() {
+		defer func() {
+			if t.raceErrors+race.Errors() > 0 {
+				t.Errorf("race detected during execution of test")
+			}
+			t.duration += time.Since(t.start)
+			err := recover()
+			if !t.finished && err == nil {
+				err = fmt.Errorf("test executed panic(nil) or runtime.Goexit")
+			}
+			if err != nil {
+				t.Fail()
+				t.mu.Lock()
+				fmt.Fprintf(t.w, "panic: %v\n\n%s", err, debug.Stack())
+				t.mu.Unlock()
+			}
+			t.done = true
+			t.signal <- true
+		}()
+		t.start = time.Now()
+		t.raceErrors = -race.Errors()
+		callFuzzFn(fn, t, vals)
+		t.finished = true
+	}()
+	<-t.signal
+	if !t.Failed() {
+		return nil
+	}
+	return fmt.Errorf("--- FAIL: %s (%s)\n%s", t.name, fmtDuration(t.duration), t.output)
+}
+
+// fuzzTests returns tests that run the fuzz targets on their corpus,
+// without fuzzing.
+func fuzzTests(deps testDeps, fuzzTargets []InternalFuzzTarget) []InternalTest {
+	tests := make([]InternalTest, len(fuzzTargets))
+	for i, ft := range fuzzTargets {
+		ft := ft
+		tests[i] = InternalTest{ft.Name, func(t *T) {
+			ft.Fn(&F{common: &t.common, t: t, deps: deps, name: ft.Name})
+		}}
+	}
+	return tests
+}
+
+// runFuzzing fuzzes the fuzz target matching -test.fuzz, if any.
+// It reports whether no failing input was found.
+func runFuzzing(deps testDeps, fuzzTargets []InternalFuzzTarget) (ok bool) {
+	if *matchFuzz == "" {
+		return true
+	}
+	m := newMatcher(deps.MatchString, *matchFuzz, "-test.fuzz")
+	var target *InternalFuzzTarget
+	for i := range fuzzTargets {
+		if _, matched, _ := m.fullName(nil, fuzzTargets[i].Name); !matched {
+			continue
+		}
+		if target != nil {
+			fmt.Fprintf(os.Stderr, "testing: will not fuzz, -test.fuzz matches more than one fuzz target: %s, %s\n", target.Name, fuzzTargets[i].Name)
+			return false
+		}
+		target = &fuzzTargets[i]
+	}
+	if target == nil {
+		fmt.Fprintln(os.Stderr, "testing: warning: no fuzz targets to fuzz")
+		return true
+	}
+
+	ctx := newTestContext(1, newMatcher(deps.MatchString, "", ""))
+	t := &T{
+		common: common{
+			signal:  make(chan bool),
+			barrier: make(chan bool),
+			w:       os.Stdout,
+			chatty:  *chatty,
+		},
+		context: ctx,
+	}
+	tRunner(t, func(t *T) {
+		t.Run(target.Name, func(t *T) {
+			f := &F{common: &t.common, t: t, deps: deps, name: target.Name, fuzzing: true}
+			target.Fn(f)
+			if !f.fuzzCalled {
+				f.Errorf("fuzz target did not call F.Fuzz")
+			}
+		})
+		// See runTests.
+		go func() { <-t.signal }()
+	})
+	return !t.Failed()
+}
@@ -0,0 +1,83 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testing
+
+import (
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// FuzzSeedCorpus checks that go test runs fuzz targets on their seed corpus.
+func FuzzSeedCorpus(f *F) {
+	f.Add([]byte("seed"), 4)
+	f.Add([]byte{}, 0)
+	f.Fuzz(func(t *T, b []byte, n int) {
+		if len(b) != n {
+			t.Errorf("got %d bytes, want %d", len(b), n)
+		}
+	})
+}
+
+func TestFuzzOnce(t *T) {
+	// Like runFuzzing, run with a context that matches all subtests.
+	ft := &T{
+		common: common{
+			signal:  make(chan bool),
+			barrier: make(chan bool),
+		},
+		context: newTestContext(1, newMatcher(regexp.MatchString, "", "")),
+	}
+	f := &F{common: &ft.common, t: ft, name: "FuzzOnce"}
+	for _, tt := range []struct {
+		name string
+		fn   interface{}
+		want string // "" if the input must not fail
+	}{
+		{"pass", func(t *T, s string) {}, ""},
+		{"skip", func(t *T, s string) { t.Skip("skipped") }, ""},
+		{"error", func(t *T, s string) { t.Errorf("bad %s", s) }, "bad input"},
+		{"fatal", func(t *T, s string) { t.Fatal("fatal"); panic("unreachable") }, "fatal"},
+		{"panic", func(t *T, s string) { panic("boom " + s) }, "panic: boom input"},
+		{"goexit", func(t *T, s string) { runtime.Goexit() }, "runtime.Goexit"},
+		{"subtest", func(t *T, s string) { t.Run("sub", func(t *T) { t.Error("in subtest") }) }, "in subtest"},
+	} {
+		err := f.fuzzOnce(reflect.ValueOf(tt.fn), []interface{}{"input"})
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("%s: got error %v, want none", tt.name, err)
+		case tt.want != "" && err == nil:
+			t.Errorf("%s: got no error, want %q", tt.name, tt.want)
+		case tt.want != "" && !strings.Contains(err.Error(), tt.want):
+			t.Errorf("%s: got error %q, want %q", tt.name, err, tt.want)
+		}
+	}
+	if f.Failed() {
+		t.Errorf("failing fuzz inputs made the fuzz target fail")
+	}
+}
+
+func TestFuzzFuncValidation(t *T) {
+	for _, fn := range []interface{}{
+		nil,
+		"not a function",
+		func(t *T) {},
+		func(b []byte) {},
+		func(t *T, b []byte) bool { return false },
+		func(t *T, p uintptr) {},
+		func(t *T, s []string) {},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("F.Fuzz(%T) did not panic", fn)
+				}
+			}()
+			f := &F{common: &t.common, t: t, name: "FuzzValidation"}
+			f.Fuzz(fn)
+		}()
+	}
+}
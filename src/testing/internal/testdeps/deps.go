@@ -12,12 +12,17 @@ package testdeps
 
 import (
 	"bufio"
+	"context"
+	"internal/fuzz"
 	"internal/testlog"
 	"io"
+	"os"
+	"reflect"
 	"regexp"
 	"runtime/pprof"
 	"strings"
 	"sync"
+	"time"
 )
 
 // TestDeps is an implementation of the testing.testDeps interface,
@@ -126,3 +131,40 @@ func (TestDeps) StopTestLog() error {
 	log.w = nil
 	return err
 }
+
+// NotifyInterrupt, if not nil, arranges for the first interrupt signal
+// received while fuzzing to call stop, and returns a function undoing
+// the arrangement. It is set by package
+// testing/internal/testdeps/interrupt, which the generated main package
+// imports only when fuzzing, so that other test binaries do not link
+// os/signal.
+var NotifyInterrupt func(stop func()) (release func())
+
+func (TestDeps) CoordinateFuzzing(timeout, minimizeTimeout time.Duration, seed []fuzz.CorpusEntry, types []reflect.Type, counters map[string][]uint32, corpusDir, cacheDir string, fn func(fuzz.CorpusEntry) error) error {
+	// The first interrupt stops fuzzing gracefully, so that the
+	// interesting inputs found so far are kept.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if NotifyInterrupt != nil {
+		defer NotifyInterrupt(cancel)()
+	}
+
+	return fuzz.CoordinateFuzzing(ctx, fuzz.CoordinateFuzzingOpts{
+		Log:             os.Stdout,
+		Timeout:         timeout,
+		MinimizeTimeout: minimizeTimeout,
+		Seed:            seed,
+		Types:           types,
+		Counters:        counters,
+		CorpusDir:       corpusDir,
+		CacheDir:        cacheDir,
+	}, fn)
+}
+
+func (TestDeps) ReadCorpus(dir string, types []reflect.Type) ([]fuzz.CorpusEntry, error) {
+	return fuzz.ReadCorpus(dir, types)
+}
+
+func (TestDeps) CheckCorpus(vals []interface{}, types []reflect.Type) error {
+	return fuzz.CheckCorpus(vals, types)
+}
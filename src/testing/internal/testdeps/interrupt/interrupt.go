@@ -0,0 +1,39 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package interrupt lets the first interrupt signal stop fuzzing
+// gracefully. The main package generated by 'go test -fuzz' imports it
+// for its side effect of setting testdeps.NotifyInterrupt; other test
+// binaries do without it, so that they do not link os/signal.
+package interrupt
+
+import (
+	"os"
+	"os/signal"
+	"testing/internal/testdeps"
+)
+
+func init() {
+	testdeps.NotifyInterrupt = notify
+}
+
+// notify arranges for the first interrupt to call stop. A second one is
+// handled as usual.
+func notify(stop func()) (release func()) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-c:
+			signal.Stop(c)
+			stop()
+		case <-done:
+		}
+	}()
+	return func() {
+		signal.Stop(c)
+		close(done)
+	}
+}
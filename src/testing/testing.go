@@ -191,6 +191,38 @@
 //         // <tear-down code>
 //     }
 //
+// Fuzzing
+//
+// Functions of the form
+//     func FuzzXxx(*testing.F)
+// are considered fuzz targets. A fuzz target adds seed inputs to its corpus
+// with F.Add and passes a fuzz function to F.Fuzz:
+//
+//     func FuzzParseQuery(f *testing.F) {
+//         f.Add("x=1&y=2")
+//         f.Fuzz(func(t *testing.T, query string) {
+//             v, err := url.ParseQuery(query)
+//             if err != nil {
+//                 return
+//             }
+//             if _, err := url.ParseQuery(v.Encode()); err != nil {
+//                 t.Errorf("re-parsing %q: %v", v.Encode(), err)
+//             }
+//         })
+//     }
+//
+// By default, "go test" runs the fuzz function on each input of the corpus:
+// the seed inputs and the files in testdata/fuzz/FuzzXxx. Fuzz targets are
+// thus ordinary regression tests, selected with the -run flag.
+//
+// When its -fuzz flag is provided, "go test" also fuzzes the single fuzz
+// target that the flag matches: it keeps running the fuzz function on
+// random mutations of the corpus, preferring mutations that reach code of
+// the package under test not reached before, until an input fails or the
+// time given by -fuzztime runs out. A failing input is minimized and
+// written to testdata/fuzz/FuzzXxx, where it becomes a regression test.
+// See the documentation of F.Fuzz for details.
+//
 // Main
 //
 // It is sometimes necessary for a test program to do extra setup or teardown
@@ -224,6 +256,7 @@ import (
 	"internal/race"
 	"io"
 	"os"
+	"reflect"
 	"runtime"
 	"runtime/debug"
 	"runtime/trace"
@@ -894,6 +927,13 @@ func (f matchStringOnly) WriteProfileTo(string, io.Writer, int) error { return e
 func (f matchStringOnly) ImportPath() string                          { return "" }
 func (f matchStringOnly) StartTestLog(io.Writer)                      {}
 func (f matchStringOnly) StopTestLog() error                          { return errMain }
+func (f matchStringOnly) CoordinateFuzzing(time.Duration, time.Duration, []corpusEntry, []reflect.Type, map[string][]uint32, string, string, func(corpusEntry) error) error {
+	return errMain
+}
+func (f matchStringOnly) ReadCorpus(string, []reflect.Type) ([]corpusEntry, error) {
+	return nil, errMain
+}
+func (f matchStringOnly) CheckCorpus([]interface{}, []reflect.Type) error { return errMain }
 
 // Main is an internal function, part of the implementation of the "go test" command.
 // It was exported because it is cross-package and predates "internal" packages.
@@ -902,15 +942,16 @@ func (f matchStringOnly) StopTestLog() error                          { return e
 // new functionality is added to the testing package.
 // Systems simulating "go test" should be updated to use MainStart.
 func Main(matchString func(pat, str string) (bool, error), tests []InternalTest, benchmarks []InternalBenchmark, examples []InternalExample) {
-	os.Exit(MainStart(matchStringOnly(matchString), tests, benchmarks, examples).Run())
+	os.Exit(MainStart(matchStringOnly(matchString), tests, benchmarks, nil, examples).Run())
 }
 
 // M is a type passed to a TestMain function to run the actual tests.
 type M struct {
-	deps       testDeps
-	tests      []InternalTest
-	benchmarks []InternalBenchmark
-	examples   []InternalExample
+	deps        testDeps
+	tests       []InternalTest
+	benchmarks  []InternalBenchmark
+	fuzzTargets []InternalFuzzTarget
+	examples    []InternalExample
 
 	timer     *time.Timer
 	afterOnce sync.Once
@@ -931,17 +972,21 @@ type testDeps interface {
 	StopTestLog() error
 	WriteHeapProfile(io.Writer) error
 	WriteProfileTo(string, io.Writer, int) error
+	CoordinateFuzzing(time.Duration, time.Duration, []corpusEntry, []reflect.Type, map[string][]uint32, string, string, func(corpusEntry) error) error
+	ReadCorpus(string, []reflect.Type) ([]corpusEntry, error)
+	CheckCorpus([]interface{}, []reflect.Type) error
 }
 
 // MainStart is meant for use by tests generated by 'go test'.
 // It is not meant to be called directly and is not subject to the Go 1 compatibility document.
 // It may change signature from release to release.
-func MainStart(deps testDeps, tests []InternalTest, benchmarks []InternalBenchmark, examples []InternalExample) *M {
+func MainStart(deps testDeps, tests []InternalTest, benchmarks []InternalBenchmark, fuzzTargets []InternalFuzzTarget, examples []InternalExample) *M {
 	return &M{
-		deps:       deps,
-		tests:      tests,
-		benchmarks: benchmarks,
-		examples:   examples,
+		deps:        deps,
+		tests:       tests,
+		benchmarks:  benchmarks,
+		fuzzTargets: fuzzTargets,
+		examples:    examples,
 	}
 }
 
@@ -964,8 +1009,14 @@ func (m *M) Run() int {
 		return 2
 	}
 
+	// Fuzz targets run on their corpus as ordinary tests.
+	tests := m.tests
+	if len(m.fuzzTargets) > 0 {
+		tests = append(tests[:len(tests):len(tests)], fuzzTests(m.deps, m.fuzzTargets)...)
+	}
+
 	if len(*matchList) != 0 {
-		listTests(m.deps.MatchString, m.tests, m.benchmarks, m.examples)
+		listTests(m.deps.MatchString, tests, m.benchmarks, m.examples)
 		return 0
 	}
 
@@ -975,13 +1026,13 @@ func (m *M) Run() int {
 	defer m.after()
 	m.startAlarm()
 	haveExamples = len(m.examples) > 0
-	testRan, testOk := runTests(m.deps.MatchString, m.tests)
+	testRan, testOk := runTests(m.deps.MatchString, tests)
 	exampleRan, exampleOk := runExamples(m.deps.MatchString, m.examples)
 	m.stopAlarm()
-	if !testRan && !exampleRan && *matchBenchmarks == "" {
+	if !testRan && !exampleRan && *matchBenchmarks == "" && *matchFuzz == "" {
 		fmt.Fprintln(os.Stderr, "testing: warning: no tests to run")
 	}
-	if !testOk || !exampleOk || !runBenchmarks(m.deps.ImportPath(), m.deps.MatchString, m.benchmarks) || race.Errors() > 0 {
+	if !testOk || !exampleOk || !runFuzzing(m.deps, m.fuzzTargets) || !runBenchmarks(m.deps.ImportPath(), m.deps.MatchString, m.benchmarks) || race.Errors() > 0 {
 		fmt.Println("FAIL")
 		return 1
 	}
@@ -0,0 +1,111 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This benchmark compares the forms a children clause can return its
+// children in: a channel fed by a goroutine, a buffered channel, a
+// slice, and a pull function.
+
+package searchbench
+
+import "testing"
+
+// queensChildren is queens with the children returned in the given form.
+// A children clause returns its children in a single form, so each form
+// has a search statement of its own.
+func queensChildren(n, concurrency int, form string) int {
+	count := 0
+	switch form {
+	case "goroutine", "chan":
+		buffered := form == "chan"
+		search queen{0, 0}; queen; concurrency {
+		children:
+			if buffered {
+				c := make(chan queen, n)
+				for r := 1; r <= n; r++ {
+					c <- queen{node.column + 1, r}
+				}
+				close(c)
+				return c
+			}
+			c := make(chan queen)
+			go func(column int) {
+				for r := 1; r <= n; r++ {
+					c <- queen{column + 1, r}
+				}
+				close(c)
+			}(node.column)
+			return c
+		accept:
+			return len(solution) == n
+		reject:
+			return attacked(solution, node)
+		results:
+			count++
+		}
+	case "slice":
+		search queen{0, 0}; queen; concurrency {
+		children:
+			s := make([]queen, n)
+			for r := range s {
+				s[r] = queen{node.column + 1, r + 1}
+			}
+			return s
+		accept:
+			return len(solution) == n
+		reject:
+			return attacked(solution, node)
+		results:
+			count++
+		}
+	case "func":
+		search queen{0, 0}; queen; concurrency {
+		children:
+			r := 0
+			return func() (queen, bool) {
+				if r == n {
+					return queen{}, false
+				}
+				r++
+				return queen{node.column + 1, r}, true
+			}
+		accept:
+			return len(solution) == n
+		reject:
+			return attacked(solution, node)
+		results:
+			count++
+		}
+	}
+	return count
+}
+
+// attacked reports whether a queen of solution attacks q.
+func attacked(solution []queen, q queen) bool {
+	for _, s := range solution {
+		if s.row == q.row || s.row-s.column == q.row-q.column || s.row+s.column == q.row+q.column {
+			return true
+		}
+	}
+	return false
+}
+
+var childrenForms = []string{"goroutine", "chan", "slice", "func"}
+
+func TestChildrenForms(t *testing.T) {
+	for _, form := range childrenForms {
+		for _, concurrency := range []int{1, 4} {
+			if n := queensChildren(8, concurrency, form); n != 92 {
+				t.Errorf("%s, concurrency %d: got %d solutions, want 92", form, concurrency, n)
+			}
+		}
+	}
+}
+
+func BenchmarkChildren(b *testing.B) {
+	for _, form := range childrenForms {
+		b.Run(form, func(b *testing.B) {
+			benchmarkScaling(b, func(concurrency int) { queensChildren(9, concurrency, form) })
+		})
+	}
+}
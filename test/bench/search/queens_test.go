@@ -0,0 +1,53 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This benchmark counts the solutions to the n-queens problem, a search
+// tree whose subtrees vary widely in size.
+
+package searchbench
+
+import "testing"
+
+type queen struct {
+	column, row int
+}
+
+func queens(n, concurrency int) int {
+	count := 0
+	search queen{0, 0}; queen; concurrency {
+	children:
+		c := make(chan queen, n)
+		for r := 1; r <= n; r++ {
+			c <- queen{node.column + 1, r}
+		}
+		close(c)
+		return c
+	accept:
+		return len(solution) == n
+	reject:
+		for _, q := range solution {
+			if q.row == node.row || q.row-q.column == node.row-node.column || q.row+q.column == node.row+node.column {
+				return true
+			}
+		}
+		return false
+	results:
+		count++
+	}
+	return count
+}
+
+func TestQueens(t *testing.T) {
+	for _, concurrency := range []int{1, 4} {
+		if n := queens(8, concurrency); n != 92 {
+			t.Errorf("concurrency %d: got %d solutions, want 92", concurrency, n)
+		}
+	}
+}
+
+func queens11(concurrency int) { queens(11, concurrency) }
+
+func BenchmarkQueens11(b *testing.B) {
+	benchmarkScaling(b, queens11)
+}
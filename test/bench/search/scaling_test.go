@@ -0,0 +1,76 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package searchbench measures how search statements scale with the
+// number of engine goroutines. Each benchmark runs with a concurrency of
+// 1, 2, 4, ... up to GOMAXPROCS. The -cpu flag only applies to the
+// innermost benchmarks, so the GOMAXPROCS environment variable sets the
+// range, e.g.
+//
+//	GOMAXPROCS=8 go test -bench .
+//
+// TestScaling logs the speedup of each concurrency over a concurrency of
+// 1 for the GOMAXPROCS set by -cpu, e.g.
+//
+//	go test -run Scaling -scaling -cpu 8 -v
+//
+// With enough parallel work and as many cores as GOMAXPROCS, the speedup
+// should be close to the concurrency.
+package searchbench
+
+import (
+	"flag"
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+var scaling = flag.Bool("scaling", false, "log the speedup of the benchmarks over a concurrency of 1")
+
+// concurrencies returns 1, 2, 4, ... up to GOMAXPROCS.
+func concurrencies() []int {
+	n := runtime.GOMAXPROCS(0)
+	var list []int
+	for p := 1; p < n; p *= 2 {
+		list = append(list, p)
+	}
+	return append(list, n)
+}
+
+// benchmarkScaling runs f with increasing concurrency.
+func benchmarkScaling(b *testing.B, f func(concurrency int)) {
+	for _, p := range concurrencies() {
+		b.Run(fmt.Sprintf("P%d", p), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				f(p)
+			}
+		})
+	}
+}
+
+func TestScaling(t *testing.T) {
+	if !*scaling {
+		t.Skip("skipping without -scaling")
+	}
+	for _, w := range []struct {
+		name string
+		f    func(concurrency int)
+	}{
+		{"Queens11", queens11},
+		{"Sudoku", sudokus},
+	} {
+		var base int64 // ns/op with a concurrency of 1
+		for _, p := range concurrencies() {
+			r := testing.Benchmark(func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					w.f(p)
+				}
+			})
+			if p == 1 {
+				base = r.NsPerOp()
+			}
+			t.Logf("%s/P%d: %d ns/op, %.2fx speedup over P1", w.name, p, r.NsPerOp(), float64(base)/float64(r.NsPerOp()))
+		}
+	}
+}
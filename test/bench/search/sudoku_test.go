@@ -0,0 +1,119 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This benchmark solves sudoku puzzles by filling the cell with the
+// fewest candidates first.
+
+package searchbench
+
+import "testing"
+
+// A grid is a sudoku board in row-major order; 0 is an empty cell.
+type grid [81]int8
+
+func parseGrid(s string) grid {
+	var g grid
+	for i := range g {
+		if c := s[i]; c >= '1' && c <= '9' {
+			g[i] = int8(c - '0')
+		}
+	}
+	return g
+}
+
+// candidates returns the digits that can be placed in cell i of g as a
+// bit set.
+func (g *grid) candidates(i int) uint {
+	row, col := i/9, i%9
+	box := row/3*27 + col/3*3
+	used := uint(0)
+	for k := 0; k < 9; k++ {
+		used |= 1 << uint(g[row*9+k])
+		used |= 1 << uint(g[k*9+col])
+		used |= 1 << uint(g[box+k/3*9+k%3])
+	}
+	return ^used & 0x3fe
+}
+
+// children returns the grids that fill the empty cell of g with the
+// fewest candidates.
+func (g *grid) children() <-chan grid {
+	best, bestSet, bestCount := -1, uint(0), 10
+	for i, d := range g {
+		if d != 0 {
+			continue
+		}
+		set := g.candidates(i)
+		count := 0
+		for s := set; s != 0; s &= s - 1 {
+			count++
+		}
+		if count < bestCount {
+			best, bestSet, bestCount = i, set, count
+		}
+	}
+	c := make(chan grid, 9)
+	if best >= 0 {
+		for d := int8(1); d <= 9; d++ {
+			if bestSet&(1<<uint(d)) != 0 {
+				next := *g
+				next[best] = d
+				c <- next
+			}
+		}
+	}
+	close(c)
+	return c
+}
+
+func (g *grid) full() bool {
+	for _, d := range g {
+		if d == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// sudoku returns the number of solutions of puzzle.
+func sudoku(puzzle string, concurrency int) int {
+	count := 0
+	search parseGrid(puzzle); grid; concurrency {
+	children:
+		return node.children()
+	accept:
+		return node.full()
+	results:
+		count++
+	}
+	return count
+}
+
+// Puzzles with few clues, which take a large search.
+var puzzles = []string{
+	"4.....8.5.3..........7......2.....6.....8.4......1.......6.3.7.5..2.....1.4......",
+	"52...6.........7.13...........4..8..6......5...........418.........3..2...87.....",
+	"6.....8.3.4.7.................5.4.7.3..2.....1.6.......2.....5.....8.6......1....",
+}
+
+func TestSudoku(t *testing.T) {
+	for _, concurrency := range []int{1, 4} {
+		for _, p := range puzzles {
+			if n := sudoku(p, concurrency); n != 1 {
+				t.Errorf("concurrency %d: %s: got %d solutions, want 1", concurrency, p, n)
+			}
+		}
+	}
+}
+
+// sudokus solves all the puzzles.
+func sudokus(concurrency int) {
+	for _, p := range puzzles {
+		sudoku(p, concurrency)
+	}
+}
+
+func BenchmarkSudoku(b *testing.B) {
+	benchmarkScaling(b, sudokus)
+}
@@ -0,0 +1,206 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test branch-and-bound searches with a cost clause on a traveling
+// salesman problem: every strategy finds the shortest tour, the results
+// clause sees ever shorter tours, and the best clause gets the shortest.
+
+package main
+
+import "fmt"
+
+const cities = 8
+
+var x, y [cities]int
+
+func init() {
+	r := 1
+	for i := range x {
+		r = r * 48271 % 2147483647
+		x[i] = r % 100
+		r = r * 48271 % 2147483647
+		y[i] = r % 100
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func dist(a, b int) float64 {
+	return float64(abs(x[a]-x[b]) + abs(y[a]-y[b]))
+}
+
+// length returns the length of the path from city 0 through the tour,
+// back to city 0 if the tour is complete.
+func length(tour []int) float64 {
+	d, last := 0.0, 0
+	for _, c := range tour {
+		d += dist(last, c)
+		last = c
+	}
+	if len(tour) == cities-1 {
+		d += dist(last, 0)
+	}
+	return d
+}
+
+// shortest returns the length of the shortest tour by enumerating all.
+func shortest(tour []int, visited uint) float64 {
+	if len(tour) == cities-1 {
+		return length(tour)
+	}
+	best := -1.0
+	for c := 1; c < cities; c++ {
+		if visited&(1<<uint(c)) == 0 {
+			if d := shortest(append(tour, c), visited|1<<uint(c)); best < 0 || d < best {
+				best = d
+			}
+		}
+	}
+	return best
+}
+
+func unvisited(solution []int) []int {
+	var list []int
+	for c := 1; c < cities; c++ {
+		seen := false
+		for _, s := range solution {
+			seen = seen || s == c
+		}
+		if !seen {
+			list = append(list, c)
+		}
+	}
+	return list
+}
+
+type outcome struct {
+	best      []int
+	calls     int
+	delivered []float64
+	pruned    int
+}
+
+func tour(strategy string, concurrency int) (o outcome) {
+	switch strategy {
+	case "depthFirst":
+		search 0; int; concurrency {
+		children:
+			return unvisited(solution)
+		accept:
+			return len(solution) == cities-1
+		cost:
+			return length(solution)
+		results:
+			o.delivered = append(o.delivered, length(solution))
+		best:
+			o.best = solution
+			o.calls++
+		stats:
+			o.pruned = stats.Pruned
+		}
+	case "breadthFirst":
+		search 0; int; concurrency {
+		strategy:
+			breadthFirst
+		children:
+			return unvisited(solution)
+		accept:
+			return len(solution) == cities-1
+		cost:
+			return length(solution)
+		results:
+			o.delivered = append(o.delivered, length(solution))
+		best:
+			o.best = solution
+			o.calls++
+		stats:
+			o.pruned = stats.Pruned
+		}
+	case "bestFirst":
+		search 0; int; concurrency {
+		strategy:
+			bestFirst
+		priority:
+			return length(solution)
+		children:
+			return unvisited(solution)
+		accept:
+			return len(solution) == cities-1
+		cost:
+			return length(solution)
+		results:
+			o.delivered = append(o.delivered, length(solution))
+		best:
+			o.best = solution
+			o.calls++
+		stats:
+			o.pruned = stats.Pruned
+		}
+	case "iterativeDeepening":
+		search 0; int; concurrency {
+		strategy:
+			iterativeDeepening
+		children:
+			return unvisited(solution)
+		accept:
+			return len(solution) == cities-1
+		cost:
+			return length(solution)
+		results:
+			o.delivered = append(o.delivered, length(solution))
+		best:
+			o.best = solution
+			o.calls++
+		stats:
+			o.pruned = stats.Pruned
+		}
+	}
+	return
+}
+
+func main() {
+	want := shortest(nil, 1)
+	for _, strategy := range []string{"depthFirst", "breadthFirst", "bestFirst", "iterativeDeepening"} {
+		for _, concurrency := range []int{1, 4} {
+			o := tour(strategy, concurrency)
+			if o.calls != 1 || len(o.best) != cities-1 || length(o.best) != want {
+				panic(fmt.Sprintf("%s, concurrency %d: best clause called %d times with %v of length %v, want length %v", strategy, concurrency, o.calls, o.best, length(o.best), want))
+			}
+			if n := len(o.delivered); n == 0 || o.delivered[n-1] != want {
+				panic(fmt.Sprintf("%s, concurrency %d: delivered %v, want last %v", strategy, concurrency, o.delivered, want))
+			}
+			for i := 1; i < len(o.delivered); i++ {
+				if o.delivered[i] >= o.delivered[i-1] {
+					panic(fmt.Sprintf("%s, concurrency %d: delivered %v, want decreasing lengths", strategy, concurrency, o.delivered))
+				}
+			}
+			if o.pruned == 0 {
+				panic(fmt.Sprintf("%s, concurrency %d: no candidates pruned", strategy, concurrency))
+			}
+		}
+	}
+
+	// Without an accepted solution, the best clause is not called.
+	called := false
+	search 0; int; 4 {
+	children:
+		return unvisited(solution)
+	accept:
+		return false
+	cost:
+		return 0
+	best:
+		called = true
+	}
+	if called {
+		panic("best clause called without solutions")
+	}
+}
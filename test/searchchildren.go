@@ -0,0 +1,48 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that the children clause must return a <-chan T, a []T,
+// or a func() (T, bool), and the same one throughout.
+// Does not compile.
+
+package p
+
+func f() {
+	search 0; int {
+	children:
+		return make(chan string) // ERROR "cannot use make\(chan string\) \(type chan string\) as type <-chan int, \[\]int, or func\(\) \(int, bool\) in return argument"
+	}
+	search 0; int {
+	children:
+		return [1]int{node + 1} // ERROR "cannot use \[1\]int literal \(type \[1\]int\) as type <-chan int, \[\]int, or func\(\) \(int, bool\) in return argument"
+	}
+	search 0; int {
+	children:
+		return func() int { return node } // ERROR "cannot use func literal \(type func\(\) int\) as type <-chan int, \[\]int, or func\(\) \(int, bool\) in return argument"
+	}
+	search 0; int {
+	children:
+		var x interface{}
+		return x // ERROR "cannot use x \(type interface {}\) as type <-chan int, \[\]int, or func\(\) \(int, bool\) in return argument"
+	}
+	search 0; int {
+	children:
+		if node > 0 {
+			return nil
+		}
+		return []int{node + 1}
+	}
+	search 0; int {
+	children:
+		if node > 0 {
+			return []int{node + 1}
+		}
+		return make(chan int) // ERROR "cannot use make\(chan int\) \(type chan int\) as type \[\]int in return argument"
+	}
+	search 0; int {
+	children:
+	} // ERROR "missing return at end of function"
+}
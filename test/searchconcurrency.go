@@ -0,0 +1,36 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that the concurrency of a search statement must be a
+// non-negative integer.
+// Does not compile.
+
+package p
+
+func kids(n int) <-chan int { return nil }
+
+func f(workers float64, n int64) {
+	search 0; int; "four" { // ERROR "search concurrency .four. \(type string\) must be integer"
+	children:
+		return kids(node)
+	}
+	search 0; int; 2.5 { // ERROR "search concurrency 2.5 \(type float64\) must be integer"
+	children:
+		return kids(node)
+	}
+	search 0; int; workers { // ERROR "search concurrency workers \(type float64\) must be integer"
+	children:
+		return kids(node)
+	}
+	search 0; int; -1 { // ERROR "search concurrency -1 must not be negative"
+	children:
+		return kids(node)
+	}
+	search 0; int; n {
+	children:
+		return kids(node)
+	}
+}
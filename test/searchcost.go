@@ -0,0 +1,54 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that the cost clause of a search statement returns a float64
+// and requires an accept clause, and that the best clause requires a
+// cost clause.
+// Does not compile.
+
+package p
+
+func kids(n int) []int { return nil }
+
+func f(replay bool) {
+	search 0; int {
+	children:
+		return kids(node)
+	cost: // ERROR "cost clause without accept clause in search statement"
+		return 0
+	}
+	search 0; int {
+	children:
+		return kids(node)
+	accept:
+		return true
+	cost:
+		return len(solution) // ERROR "cannot use len\(solution\) \(type int\) as type float64 in return argument"
+	}
+	search 0; int {
+	children:
+		return kids(node)
+	best: // ERROR "best clause without cost clause in search statement"
+	}
+	search 0; int; 4; deterministic = replay {
+	children:
+		return kids(node)
+	accept:
+		return true
+	cost: // ERROR "cost clause in deterministic search"
+		return float64(node)
+	}
+	search 0; int; 4 {
+	children:
+		return kids(node)
+	accept:
+		return true
+	cost:
+		return float64(node)
+	best:
+		println(solution)
+	}
+}
@@ -0,0 +1,153 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that a search stops when its context is done.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// infinite searches the infinite binary tree until ctx is done.
+// The children of a node are sent by a goroutine on an unbuffered
+// channel, which blocks until the engine receives them.
+func infinite(ctx context.Context, concurrency int) int {
+	nodes := make(chan int, 1)
+	search 1; int; concurrency; ctx {
+	children:
+		select {
+		case nodes <- 1:
+		default:
+		}
+		c := make(chan int)
+		go func(n int) {
+			c <- 2 * n
+			c <- 2*n + 1
+			close(c)
+		}(node)
+		return c
+	}
+	return len(nodes)
+}
+
+// selecting is like infinite, but the goroutine sending the children
+// gives up once done is closed.
+func selecting(ctx context.Context, concurrency int) int {
+	nodes := make(chan int, 1)
+	search 1; int; concurrency; ctx {
+	children:
+		select {
+		case nodes <- 1:
+		default:
+		}
+		c := make(chan int)
+		go func(n int, done <-chan struct{}) {
+			defer close(c)
+			for _, k := range []int{2 * n, 2*n + 1} {
+				select {
+				case c <- k:
+				case <-done:
+					return
+				}
+			}
+		}(node, done)
+		return c
+	}
+	return len(nodes)
+}
+
+// blocked searches a tree whose root has a child whose children are
+// sent by a goroutine that blocks until done is closed. The search must
+// return once ctx is done rather than wait for the goroutine.
+func blocked(ctx context.Context, concurrency int) {
+	search 0; int; concurrency; ctx {
+	children:
+		if node > 0 {
+			c := make(chan int)
+			go func(done <-chan struct{}) {
+				<-done
+				close(c)
+			}(done)
+			return c
+		}
+		c := make(chan int, 2)
+		c <- 1
+		c <- 2
+		close(c)
+		return c
+	}
+}
+
+// cancelled cancels the search context from an accept clause.
+func cancelled() int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	accepted := 0
+	search 0; int; ; ctx {
+	children:
+		c := make(chan int, 2)
+		c <- node + 1
+		c <- node + 1
+		close(c)
+		return c
+	accept:
+		if node == 5 {
+			accepted++
+			cancel()
+			return true
+		}
+		return false
+	}
+	return accepted
+}
+
+// checkGoroutines waits for the goroutines started by a search to exit.
+func checkGoroutines(want int) {
+	for i := 0; runtime.NumGoroutine() > want; i++ {
+		if i == 100 {
+			panic(fmt.Sprintf("%d goroutines still running, want %d", runtime.NumGoroutine(), want))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func main() {
+	base := runtime.NumGoroutine()
+
+	for _, f := range []func(context.Context, int) int{infinite, selecting} {
+		for _, concurrency := range []int{1, 4} {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			if n := f(ctx, concurrency); n != 1 {
+				panic(fmt.Sprintf("concurrency %d: search did not run", concurrency))
+			}
+			if ctx.Err() != context.DeadlineExceeded {
+				panic(fmt.Sprintf("concurrency %d: search returned before the deadline", concurrency))
+			}
+			cancel()
+			checkGoroutines(base)
+		}
+	}
+
+	for _, concurrency := range []int{1, 4} {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		start := time.Now()
+		blocked(ctx, concurrency)
+		if d := time.Since(start); d > 2*time.Second {
+			panic(fmt.Sprintf("concurrency %d: search took %v with a 100ms deadline", concurrency, d))
+		}
+		cancel()
+		checkGoroutines(base)
+	}
+
+	if n := cancelled(); n != 1 {
+		panic(fmt.Sprintf("accepted %d nodes after cancelation, want 1", n))
+	}
+	checkGoroutines(base)
+}
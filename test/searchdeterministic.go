@@ -0,0 +1,93 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that a deterministic search delivers the solutions of a serial
+// search in the same order, under the same graph nodes at every
+// concurrency, and that stop cuts the search off where a serial search
+// stops.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+const depth = 14
+
+// A result is a solution as seen by the results clause.
+type result struct {
+	node, gid int
+}
+
+// run searches the binary tree of heap indexes of the given depth for
+// the multiples of 7 and returns the results. The search stops when a
+// node equal to stopAccept is accepted, when the children of stopChildren
+// are requested, or after stopResults results.
+func run(deterministic bool, concurrency, stopAccept, stopChildren, stopResults int) []result {
+	var results []result
+	search 1; int; concurrency; deterministic = deterministic {
+	children:
+		if node == stopChildren {
+			stop()
+		}
+		if len(solution) >= depth {
+			return nil
+		}
+		return []int{2 * node, 2*node + 1}
+	accept:
+		if node == stopAccept {
+			stop()
+		}
+		return node%7 == 0
+	results:
+		results = append(results, result{node, gid.ID})
+		if len(results) == stopResults {
+			stop()
+		}
+	}
+	return results
+}
+
+func nodes(results []result) []int {
+	var list []int
+	for _, r := range results {
+		list = append(list, r.node)
+	}
+	return list
+}
+
+func main() {
+	tests := []struct {
+		name                                  string
+		stopAccept, stopChildren, stopResults int
+	}{
+		{"complete", 0, 0, 0},
+		{"stop in accept near the root", 14, 0, 0},
+		{"stop in accept", 12005, 0, 0},
+		{"stop in children near the root", 0, 5, 0},
+		{"stop in children", 0, 3000, 0},
+		{"stop in results", 0, 0, 100},
+	}
+	for _, test := range tests {
+		serial := nodes(run(false, 1, test.stopAccept, test.stopChildren, test.stopResults))
+		if len(serial) == 0 {
+			panic(test.name + ": no solutions")
+		}
+		var first []result
+		for _, concurrency := range []int{1, 4, 8, 4} {
+			got := run(true, concurrency, test.stopAccept, test.stopChildren, test.stopResults)
+			if !reflect.DeepEqual(nodes(got), serial) {
+				panic(fmt.Sprintf("%s, concurrency %d: got %d solutions %v, want %d %v", test.name, concurrency, len(got), nodes(got), len(serial), serial))
+			}
+			if first == nil {
+				first = got
+			} else if !reflect.DeepEqual(got, first) {
+				panic(fmt.Sprintf("%s, concurrency %d: graph nodes %v, want %v", test.name, concurrency, got, first))
+			}
+		}
+	}
+}
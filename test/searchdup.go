@@ -0,0 +1,28 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that a search statement has at most one accept and one
+// reject clause.
+// Does not compile.
+
+package p
+
+func kids(n int) <-chan int { return nil }
+
+func f() {
+	search 0; int {
+	children:
+		return kids(node)
+	accept:
+		return len(solution) == 3
+	accept: // ERROR "duplicate accept block, first seen at"
+		return len(solution) == 4
+	reject:
+		return node < 0
+	reject: // ERROR "duplicate reject block, first seen at"
+		return node > 9
+	}
+}
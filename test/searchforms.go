@@ -0,0 +1,199 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that the children clause may return its children as a channel,
+// a slice, or a pull function.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// The children of node n are 2n and 2n+1, in the complete binary tree of
+// heap indexes of depth 4.
+const leaves = 1 << 4
+
+// sent returns the children of node on a channel.
+func sent(node int) <-chan int {
+	if node >= leaves {
+		return nil
+	}
+	c := make(chan int, 2)
+	c <- 2 * node
+	c <- 2*node + 1
+	close(c)
+	return c
+}
+
+// listed returns the children of node in a slice.
+func listed(node int) []int {
+	if node >= leaves {
+		return []int{}
+	}
+	return []int{2 * node, 2*node + 1}
+}
+
+// pulled returns a function pulling the children of node.
+func pulled(node int) func() (int, bool) {
+	next := 2 * node
+	return func() (int, bool) {
+		if node >= leaves || next > 2*node+1 {
+			return 0, false
+		}
+		next++
+		return next - 1, true
+	}
+}
+
+// expanded returns the nodes in the order a search with the given
+// strategy and concurrency expands them, with the children in form.
+func expanded(form, strategy string, concurrency int) []int {
+	var mu sync.Mutex
+	var order []int
+	add := func(node int) {
+		mu.Lock()
+		order = append(order, node)
+		mu.Unlock()
+	}
+	breadthFirst := strategy == "breadthFirst"
+	switch form {
+	case "chan":
+		if breadthFirst {
+			search 1; int; concurrency {
+			strategy:
+				breadthFirst
+			children:
+				add(node)
+				return sent(node)
+			}
+			break
+		}
+		search 1; int; concurrency {
+		children:
+			add(node)
+			if node >= leaves {
+				return nil
+			}
+			c := make(chan int)
+			go func() {
+				c <- 2 * node
+				c <- 2*node + 1
+				close(c)
+			}()
+			return c
+		}
+	case "slice":
+		if breadthFirst {
+			search 1; int; concurrency {
+			strategy:
+				breadthFirst
+			children:
+				add(node)
+				return listed(node)
+			}
+			break
+		}
+		search 1; int; concurrency {
+		children:
+			add(node)
+			if node >= leaves {
+				return nil
+			}
+			return listed(node)
+		}
+	case "pull":
+		if breadthFirst {
+			search 1; int; concurrency {
+			strategy:
+				breadthFirst
+			children:
+				add(node)
+				return pulled(node)
+			}
+			break
+		}
+		search 1; int; concurrency {
+		children:
+			add(node)
+			if node >= leaves {
+				return nil
+			}
+			return pulled(node)
+		}
+	}
+	return order
+}
+
+// stopped checks that a search of an infinite tree, whose nodes pull
+// their children from a function that never runs out, stops pulling
+// children once stop is called.
+func stopped(strategy string, concurrency int) {
+	var mu sync.Mutex
+	n := 0
+	next := func(node int, stop func()) func() (int, bool) {
+		child := 2 * node
+		return func() (int, bool) {
+			mu.Lock()
+			if n++; n == 100 {
+				stop()
+			}
+			mu.Unlock()
+			child++
+			return child, true
+		}
+	}
+	switch strategy {
+	case "depthFirst":
+		search 1; int; concurrency {
+		children:
+			return next(node, stop)
+		}
+	case "breadthFirst":
+		search 1; int; concurrency {
+		strategy:
+			breadthFirst
+		children:
+			return next(node, stop)
+		}
+	}
+	if n < 100 || n >= 100+concurrency {
+		panic(fmt.Sprintf("%s, concurrency %d: pulled %d children after stopping at 100", strategy, concurrency, n))
+	}
+}
+
+func main() {
+	var all []int
+	for n := 1; n < 2*leaves; n++ {
+		all = append(all, n)
+	}
+	preorder := []int{1, 2, 4, 8, 16, 17, 9, 18, 19, 5, 10, 20, 21, 11, 22, 23, 3, 6, 12, 24, 25, 13, 26, 27, 7, 14, 28, 29, 15, 30, 31}
+	for _, form := range []string{"chan", "slice", "pull"} {
+		if got := expanded(form, "depthFirst", 1); !reflect.DeepEqual(got, preorder) {
+			panic(fmt.Sprintf("%s: serial depth-first order %v, want %v", form, got, preorder))
+		}
+		if got := expanded(form, "breadthFirst", 1); !reflect.DeepEqual(got, all) {
+			panic(fmt.Sprintf("%s: serial breadth-first order %v, want %v", form, got, all))
+		}
+		for _, strategy := range []string{"depthFirst", "breadthFirst"} {
+			for _, concurrency := range []int{1, 4} {
+				got := expanded(form, strategy, concurrency)
+				sort.Ints(got)
+				if !reflect.DeepEqual(got, all) {
+					panic(fmt.Sprintf("%s, %s, concurrency %d: expanded %v, want %v", form, strategy, concurrency, got, all))
+				}
+			}
+		}
+	}
+	for _, strategy := range []string{"depthFirst", "breadthFirst"} {
+		for _, concurrency := range []int{1, 4} {
+			stopped(strategy, concurrency)
+		}
+	}
+}
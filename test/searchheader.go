@@ -0,0 +1,30 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that the options of a search statement are known, given at
+// most once, and follow the other header expressions.
+// Does not compile.
+
+package p
+
+import "context"
+
+func kids(n int) <-chan int { return nil }
+
+func f(ctx context.Context) {
+	search 0; int; maxNodes = 1; maxNodes = 2 { // ERROR "duplicate maxNodes option"
+	children:
+		return kids(node)
+	}
+	search 0; int; maxBreadth = 2 { // ERROR "unknown search option maxBreadth"
+	children:
+		return kids(node)
+	}
+	search 0; int; maxDepth = 2; ctx { // ERROR "search header expression after option"
+	children:
+		return kids(node)
+	}
+}
@@ -0,0 +1,121 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that a search with a key clause visits every node of a graph
+// with cycles once.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type point struct {
+	x, y int
+}
+
+// The maze is a graph with cycles: each open cell is connected to its
+// open neighbors.
+var maze = strings.Split(`
+#########
+#S..#...#
+#.#.#.#.#
+#.......#
+#.#.###.#
+#...#..E#
+#########`[1:], "\n")
+
+func (p point) neighbors() <-chan point {
+	c := make(chan point, 4)
+	for _, d := range []point{{1, 0}, {0, 1}, {-1, 0}, {0, -1}} {
+		if q := (point{p.x + d.x, p.y + d.y}); maze[q.y][q.x] != '#' {
+			c <- q
+		}
+	}
+	close(c)
+	return c
+}
+
+func open() int {
+	n := 0
+	for _, row := range maze {
+		n += strings.Count(row, ".") + strings.Count(row, "S") + strings.Count(row, "E")
+	}
+	return n
+}
+
+// reachable counts the cells visited by a search from the start.
+func reachable(concurrency int) int {
+	var mu sync.Mutex
+	visited := make(map[point]int)
+	search point{1, 1}; point; concurrency {
+	children:
+		mu.Lock()
+		visited[node]++
+		mu.Unlock()
+		return node.neighbors()
+	key:
+		return node
+	}
+	for p, n := range visited {
+		if n != 1 {
+			panic(fmt.Sprintf("concurrency %d: visited %v %d times", concurrency, p, n))
+		}
+	}
+	return len(visited)
+}
+
+// shortest returns the length of the shortest path from the start to
+// the exit.
+func shortest(concurrency int) int {
+	length := 0
+	search point{1, 1}; point; concurrency {
+	strategy:
+		breadthFirst
+	children:
+		return node.neighbors()
+	accept:
+		return maze[node.y][node.x] == 'E'
+	key:
+		return node
+	results:
+		length = len(solution)
+	}
+	return length
+}
+
+// parity searches the cycle 0, 1, ..., 9, 0 with keys modulo 2, so that
+// only the root and its successor are visited.
+func parity() []int {
+	var res []int
+	search 0; int {
+	children:
+		res = append(res, node)
+		c := make(chan int, 1)
+		c <- (node + 1) % 10
+		close(c)
+		return c
+	key:
+		return node % 2
+	}
+	return res
+}
+
+func main() {
+	for _, concurrency := range []int{1, 4} {
+		if got, want := reachable(concurrency), open(); got != want {
+			panic(fmt.Sprintf("concurrency %d: visited %d cells, want %d", concurrency, got, want))
+		}
+		if got := shortest(concurrency); got != 10 {
+			panic(fmt.Sprintf("concurrency %d: shortest path has length %d, want 10", concurrency, got))
+		}
+	}
+	if got := parity(); len(got) != 2 || got[0] != 0 || got[1] != 1 {
+		panic(fmt.Sprintf("visited %v, want [0 1]", got))
+	}
+}
@@ -0,0 +1,50 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that labels and branch statements are checked in search
+// clauses, and that a label named after a clause cannot label a
+// statement directly in a clause.
+// Does not compile.
+
+package p
+
+func f() {
+	search 0; int {
+	children:
+		for {
+			break nope // ERROR "break label not defined: nope"
+		}
+	unused: // ERROR "label unused defined and not used"
+		return nil
+	}
+	search 0; int {
+	children:
+		break // ERROR "break is not in a loop, switch, or select"
+		return nil
+	}
+}
+
+func g() {
+	search 0; int {
+	children:
+		return nil
+	key:
+		for i := 0; i < 3; i++ {
+			continue key // ERROR "continue label not defined: key \(key: directly in a search statement starts the key clause\)"
+		}
+		return node
+	}
+	search 0; int {
+	children:
+		{
+		key:
+			for i := 0; i < 3; i++ {
+				continue key
+			}
+		}
+		return nil
+	}
+}
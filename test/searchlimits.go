@@ -0,0 +1,127 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that the maxDepth and maxNodes options stop a search at their
+// limits and that the stats clause reports which limit fired.
+
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+type result struct {
+	expanded int // children clause calls
+	deepest  int // longest solution passed to a clause
+	limit    string
+}
+
+// binary searches the infinite complete binary tree of heap indexes.
+func binary(strategy string, concurrency, maxDepth, maxNodes int) result {
+	var mu sync.Mutex
+	var r result
+	children := func(node int, solution []int) []int {
+		mu.Lock()
+		r.expanded++
+		if len(solution) > r.deepest {
+			r.deepest = len(solution)
+		}
+		mu.Unlock()
+		return []int{2 * node, 2*node + 1}
+	}
+	switch strategy {
+	case "depthFirst":
+		search 1; int; concurrency; maxDepth = maxDepth; maxNodes = maxNodes {
+		children:
+			return children(node, solution)
+		stats:
+			r.limit = stats.Limit
+		}
+	case "breadthFirst":
+		search 1; int; concurrency; maxDepth = maxDepth; maxNodes = maxNodes {
+		strategy:
+			breadthFirst
+		children:
+			return children(node, solution)
+		stats:
+			r.limit = stats.Limit
+		}
+	case "iterativeDeepening":
+		search 1; int; concurrency; maxDepth = maxDepth; maxNodes = maxNodes {
+		strategy:
+			iterativeDeepening
+		children:
+			return children(node, solution)
+		stats:
+			r.limit = stats.Limit
+		}
+	}
+	return r
+}
+
+// finite searches a tree of depth 3 within generous limits.
+func finite(concurrency int) string {
+	limit := "unset"
+	search 1; int; concurrency; maxDepth = 3; maxNodes = 100 {
+	children:
+		if node >= 8 {
+			return nil
+		}
+		return []int{2 * node, 2*node + 1}
+	stats:
+		limit = stats.Limit
+	}
+	return limit
+}
+
+// stopped checks that calling stop before a limit is hit leaves the
+// limit unreported.
+func stopped(concurrency int) string {
+	limit := "unset"
+	search 1; int; concurrency; maxNodes = 1000 {
+	children:
+		if node == 1 {
+			stop()
+		}
+		return []int{2 * node, 2*node + 1}
+	stats:
+		limit = stats.Limit
+	}
+	return limit
+}
+
+func main() {
+	for _, strategy := range []string{"depthFirst", "breadthFirst", "iterativeDeepening"} {
+		for _, concurrency := range []int{1, 4} {
+			// Only the nodes within the budget are expanded.
+			r := binary(strategy, concurrency, 1000, 50)
+			if r.expanded != 50 || r.limit != "maxNodes" {
+				panic(fmt.Sprintf("%s, concurrency %d, maxNodes 50: got %+v", strategy, concurrency, r))
+			}
+
+			// No solution is longer than maxDepth.
+			r = binary(strategy, concurrency, 5, 1000)
+			if r.deepest != 5 || r.limit != "maxDepth" {
+				panic(fmt.Sprintf("%s, concurrency %d, maxDepth 5: got %+v", strategy, concurrency, r))
+			}
+
+			// A budget of 0 expands no node.
+			r = binary(strategy, concurrency, 1000, 0)
+			if r.expanded != 0 || r.limit != "maxNodes" {
+				panic(fmt.Sprintf("%s, concurrency %d, maxNodes 0: got %+v", strategy, concurrency, r))
+			}
+		}
+	}
+	for _, concurrency := range []int{1, 4} {
+		if limit := finite(concurrency); limit != "" {
+			panic(fmt.Sprintf("concurrency %d: finite search stopped by %q", concurrency, limit))
+		}
+		if limit := stopped(concurrency); limit != "" {
+			panic(fmt.Sprintf("concurrency %d: stopped search reports limit %q", concurrency, limit))
+		}
+	}
+}
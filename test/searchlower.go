@@ -0,0 +1,126 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that search statements are compiled from the header as written:
+// node types that have no source form of their own or are declared in
+// other packages, header expressions
+// evaluated exactly once, optional clauses, and user identifiers that
+// match names used by the engine.
+
+package main
+
+import (
+	"fmt"
+	"image"
+	"strings"
+)
+
+// path is the node type; its struct tag does not survive printing
+// and reparsing the type.
+type path = struct {
+	s string `search:"node"`
+}
+
+var evals int
+
+func start() path {
+	evals++
+	return path{}
+}
+
+// words returns all words over the alphabet "ab" of length n.
+func words(n int) []string {
+	var (
+		root, engine, lock, wg, ticket int // must not interfere
+		out                            = make(chan string, 1<<uint(n+1))
+	)
+	search start(); path; 2 {
+	children:
+		c := make(chan path, 2)
+		if len(node.s) < n {
+			c <- path{node.s + "a"}
+			c <- path{node.s + "b"}
+		}
+		close(c)
+		return c
+	accept:
+		if len(node.s) == n {
+			out <- node.s
+			return true
+		}
+		return false
+	}
+	close(out)
+	_, _, _, _, _ = root, engine, lock, wg, ticket
+	var res []string
+	for w := range out {
+		res = append(res, w)
+	}
+	return res
+}
+
+// count counts the nodes up to depth n not starting with "b",
+// using only children and reject clauses.
+func count(n int) int {
+	nodes := make(chan int, 1<<uint(n+1))
+	search path{}; path {
+	children:
+		c := make(chan path, 2)
+		nodes <- 1
+		if len(node.s) < n {
+			c <- path{node.s + "a"}
+			c <- path{node.s + "b"}
+		}
+		close(c)
+		return c
+	reject:
+		return strings.HasPrefix(node.s, "b")
+	}
+	close(nodes)
+	total := 0
+	for c := range nodes {
+		total += c
+	}
+	return total
+}
+
+// lattice counts the monotone lattice paths from the origin to (n, n),
+// with a node type from another package.
+func lattice(n int) int {
+	paths := 0
+	search image.Point{}; image.Point; 2 {
+	children:
+		var kids []image.Point
+		if node.X < n {
+			kids = append(kids, node.Add(image.Pt(1, 0)))
+		}
+		if node.Y < n {
+			kids = append(kids, node.Add(image.Pt(0, 1)))
+		}
+		return kids
+	accept:
+		return node == image.Pt(n, n)
+	results:
+		paths++
+	}
+	return paths
+}
+
+func main() {
+	if got := len(words(4)); got != 16 {
+		panic(fmt.Sprintf("got %d words, want 16", got))
+	}
+	if evals != 1 {
+		panic(fmt.Sprintf("search root evaluated %d times, want 1", evals))
+	}
+	// The root and the words of length 1 to 3 starting with "a".
+	if got := count(3); got != 8 {
+		panic(fmt.Sprintf("got %d nodes, want 8", got))
+	}
+	if got := lattice(3); got != 20 {
+		panic(fmt.Sprintf("got %d lattice paths, want 20", got))
+	}
+}
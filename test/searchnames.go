@@ -0,0 +1,39 @@
+// compile
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that the search clause labels children, accept and reject
+// are not reserved words.
+
+package p
+
+import "net"
+
+type tree struct {
+	children []*tree
+}
+
+func accept(l net.Listener) (net.Conn, error) {
+	return l.Accept()
+}
+
+func reject(children []*tree) bool {
+	accept := len(children) == 0
+	return accept
+}
+
+func leaves(t *tree) {
+	search t; *tree {
+	children:
+		c := make(chan *tree, len(node.children))
+		for _, children := range node.children {
+			c <- children
+		}
+		close(c)
+		return c
+	accept:
+		return reject(node.children)
+	}
+}
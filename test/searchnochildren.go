@@ -0,0 +1,17 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that a search statement without children clause is rejected.
+// Does not compile.
+
+package p
+
+func f() {
+	search 0; int { // ERROR "missing children clause in search statement"
+	accept:
+		return len(solution) == 3
+	}
+}
@@ -0,0 +1,62 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that the maxDepth and maxNodes options of a search statement
+// are non-negative integers, and that the deterministic option is a
+// boolean for a depth-first search without key and ordered clauses.
+// Does not compile.
+
+package p
+
+import "context"
+
+func kids(n int) <-chan int { return nil }
+
+func f(ctx context.Context, depth float64, nodes uint64, replay bool) {
+	search 0; int; maxDepth = "deep" { // ERROR "search maxDepth .deep. \(type string\) must be integer"
+	children:
+		return kids(node)
+	}
+	search 0; int; maxDepth = depth { // ERROR "search maxDepth depth \(type float64\) must be integer"
+	children:
+		return kids(node)
+	}
+	search 0; int; 4; maxNodes = -1 { // ERROR "search maxNodes -1 must not be negative"
+	children:
+		return kids(node)
+	}
+	search 0; int; 4; ctx; maxDepth = 10; maxNodes = nodes {
+	children:
+		return kids(node)
+	}
+	search 0; int; deterministic = 1 { // ERROR "search deterministic 1 \(type int\) must be boolean"
+	children:
+		return kids(node)
+	}
+	search 0; int; deterministic = true {
+	strategy: // ERROR "deterministic search with breadthFirst strategy"
+		breadthFirst
+	children:
+		return kids(node)
+	}
+	search 0; int; deterministic = replay {
+	children:
+		return kids(node)
+	key: // ERROR "key clause in deterministic search"
+		return node
+	}
+	search 0; int; deterministic = replay {
+	children:
+		return kids(node)
+	accept:
+		return true
+	ordered: // ERROR "ordered clause in deterministic search"
+	}
+	search 0; int; 4; ctx; maxNodes = 100; deterministic = replay && nodes > 0 {
+	children:
+		return kids(node)
+	}
+}
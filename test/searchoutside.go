@@ -0,0 +1,20 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that the statements of a search body belong to its clauses.
+// Does not compile.
+
+package p
+
+func f() {
+	search 0; int {
+		println() // ERROR "statement outside of a search clause"
+	stats:
+		println(stats.Expanded)
+	children:
+		return nil
+	}
+}
@@ -0,0 +1,170 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that a panic in a clause called on an engine goroutine stops the
+// search and is raised again by the search statement as a
+// *runtime.SearchPanic carrying the stack trace of the panic.
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+)
+
+type boomError struct{ clause string }
+
+func (e boomError) Error() string { return "boom in " + e.clause }
+
+// boom panics if the clause is the one to panic, at leaf 3003 or its
+// parent.
+func boom(clause, at string, node int) {
+	if clause == at && (node == 3003 || node == 1501) {
+		panic(boomError{clause})
+	}
+}
+
+// run searches the binary tree of heap indexes below 1<<12 for the leaves
+// divisible by 3, with a clause panicking at leaf 3003 or its parent, and
+// returns the recovered value.
+func run(strategy, at string, concurrency int) (v interface{}) {
+	defer func() { v = recover() }()
+	children := func(node int) []int {
+		boom("children", at, node)
+		if node >= 1<<11 {
+			return nil
+		}
+		return []int{2 * node, 2*node + 1}
+	}
+	switch strategy {
+	case "depthFirst":
+		search 1; int; concurrency {
+		children:
+			return children(node)
+		accept:
+			boom("accept", at, node)
+			return node >= 1<<11 && node%3 == 0
+		reject:
+			boom("reject", at, node)
+			return false
+		results:
+			boom("results", at, node)
+		}
+	case "breadthFirst":
+		search 1; int; concurrency {
+		strategy:
+			breadthFirst
+		children:
+			return children(node)
+		accept:
+			boom("accept", at, node)
+			return node >= 1<<11 && node%3 == 0
+		reject:
+			boom("reject", at, node)
+			return false
+		results:
+			boom("results", at, node)
+		}
+	case "deterministic":
+		search 1; int; concurrency; deterministic = true {
+		children:
+			return children(node)
+		accept:
+			boom("accept", at, node)
+			return node >= 1<<11 && node%3 == 0
+		reject:
+			boom("reject", at, node)
+			return false
+		}
+	case "cost":
+		search 1; int; concurrency {
+		children:
+			return children(node)
+		accept:
+			return node >= 1<<11 && node%3 == 0
+		cost:
+			// Leaf 3003 is the cheapest solution.
+			switch {
+			case node < 1<<11:
+				return -2
+			case node == 3003:
+				return -1
+			}
+			return 0
+		results:
+			boom("results", at, node)
+		}
+	}
+	return nil
+}
+
+// settle waits for the goroutines of finished searches to exit and
+// returns the number of goroutines.
+func settle(want int) int {
+	n := runtime.NumGoroutine()
+	for i := 0; i < 100 && n > want; i++ {
+		time.Sleep(10 * time.Millisecond)
+		n = runtime.NumGoroutine()
+	}
+	return n
+}
+
+func main() {
+	goroutines := runtime.NumGoroutine()
+	for _, test := range []struct{ strategy, at string }{
+		{"depthFirst", "children"},
+		{"depthFirst", "accept"},
+		{"depthFirst", "reject"},
+		{"depthFirst", "results"},
+		{"breadthFirst", "children"},
+		{"breadthFirst", "results"},
+		{"deterministic", "children"},
+		{"deterministic", "accept"},
+		{"cost", "results"},
+	} {
+		for _, concurrency := range []int{1, 4} {
+			name := fmt.Sprintf("%s, %s clause, concurrency %d", test.strategy, test.at, concurrency)
+			p, ok := run(test.strategy, test.at, concurrency).(*runtime.SearchPanic)
+			if !ok {
+				panic(name + ": no *runtime.SearchPanic")
+			}
+			if p.Value != (boomError{test.at}) {
+				panic(fmt.Sprintf("%s: panic value %v", name, p.Value))
+			}
+			if stack := string(p.Stack); !strings.Contains(stack, "main.boom(") || !strings.Contains(stack, "searchpanic.go:") {
+				panic(fmt.Sprintf("%s: stack without panic site:\n%s", name, stack))
+			}
+			if msg := p.Error(); !strings.HasPrefix(msg, "search clause panicked: boom in "+test.at+"\n\n") {
+				panic(fmt.Sprintf("%s: message %q", name, msg))
+			}
+			if n := settle(goroutines); n != goroutines {
+				panic(fmt.Sprintf("%s: %d goroutines left, want %d", name, n, goroutines))
+			}
+		}
+	}
+
+	// The search is usable after a panic, and a panic in the children
+	// of the root passes through.
+	if v := run("depthFirst", "children", 4); v == nil {
+		panic("no panic")
+	}
+	func() {
+		defer func() {
+			if v := recover(); v != "root" {
+				panic(fmt.Sprintf("recovered %v, want root", v))
+			}
+		}()
+		search 0; int; 4 {
+		children:
+			if node == 0 {
+				panic("root")
+			}
+			return nil
+		}
+	}()
+}
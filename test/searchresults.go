@@ -0,0 +1,117 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test the delivery of solutions by results and ordered clauses.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type Queen struct {
+	Column, Row int
+}
+
+// queens returns all solutions to the n-queens problem, delivered by a
+// results clause; if ordered is set, by an ordered clause.
+func queens(n, concurrency int, ordered bool) [][]Queen {
+	var res [][]Queen
+	if ordered {
+		search Queen{0, 0}; Queen; concurrency {
+		children:
+			return queenChildren(n, node)
+		accept:
+			return len(solution) == n
+		reject:
+			return attacked(node, solution)
+		ordered:
+			res = append(res, solution)
+		}
+		return res
+	}
+	search Queen{0, 0}; Queen; concurrency {
+	children:
+		return queenChildren(n, node)
+	accept:
+		return len(solution) == n
+	reject:
+		return attacked(node, solution)
+	results:
+		res = append(res, solution)
+	}
+	return res
+}
+
+func queenChildren(n int, node Queen) <-chan Queen {
+	c := make(chan Queen, n)
+	for r := 1; r <= n; r++ {
+		c <- Queen{node.Column + 1, r}
+	}
+	close(c)
+	return c
+}
+
+func attacked(node Queen, solution []Queen) bool {
+	for _, q := range solution {
+		if q.Row == node.Row || q.Row-q.Column == node.Row-node.Column || q.Row+q.Column == node.Row+node.Column {
+			return true
+		}
+	}
+	return false
+}
+
+// firstOrdered returns the first three solutions in depth-first order.
+func firstOrdered(n, concurrency int) [][]Queen {
+	var res [][]Queen
+	search Queen{0, 0}; Queen; concurrency {
+	children:
+		return queenChildren(n, node)
+	accept:
+		return len(solution) == n
+	reject:
+		return attacked(node, solution)
+	ordered:
+		res = append(res, solution)
+		if len(res) == 3 {
+			stop()
+		}
+	}
+	return res
+}
+
+func main() {
+	serial := queens(8, 1, false)
+	if len(serial) != 92 {
+		panic(fmt.Sprintf("got %d solutions, want 92", len(serial)))
+	}
+	// The delivered solutions are copies.
+	seen := make(map[[8]Queen]bool)
+	for _, s := range serial {
+		var a [8]Queen
+		copy(a[:], s)
+		if seen[a] {
+			panic(fmt.Sprintf("duplicate solution %v", s))
+		}
+		seen[a] = true
+	}
+
+	// Concurrent delivery does not race and misses no solution.
+	if n := len(queens(8, 8, false)); n != 92 {
+		panic(fmt.Sprintf("got %d solutions with concurrency 8, want 92", n))
+	}
+
+	// Ordered delivery matches the serial depth-first order.
+	for _, concurrency := range []int{1, 2, 8} {
+		if res := queens(8, concurrency, true); !reflect.DeepEqual(res, serial) {
+			panic(fmt.Sprintf("concurrency %d: ordered solutions differ from serial order", concurrency))
+		}
+		if res := firstOrdered(8, concurrency); !reflect.DeepEqual(res, serial[:3]) {
+			panic(fmt.Sprintf("concurrency %d: got first solutions %v, want %v", concurrency, res, serial[:3]))
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// errorcheck
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Verify that the root of a search statement must be assignable to the
+// node type.
+// Does not compile.
+
+package p
+
+type node struct{ depth int }
+
+func kids(n node) <-chan node { return nil }
+
+func f(r string, p *node) {
+	search "root"; int { // ERROR "cannot use .root. \(type string\) as type int in assignment"
+	children:
+		return nil
+	}
+	search r; int { // ERROR "cannot use r \(type string\) as type int in assignment"
+	children:
+		return nil
+	}
+	search p; node { // ERROR "cannot use p \(type \*node\) as type node in assignment"
+	children:
+		return kids(node)
+	}
+	search *p; node {
+	children:
+		return kids(node)
+	}
+}
@@ -0,0 +1,129 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test the statistics passed to the stats clause.
+
+package main
+
+import "fmt"
+
+type Queen struct {
+	Column, Row int
+}
+
+func queenChildren(n int, node Queen) <-chan Queen {
+	c := make(chan Queen, n)
+	if node.Column < n {
+		for r := 1; r <= n; r++ {
+			c <- Queen{node.Column + 1, r}
+		}
+	}
+	close(c)
+	return c
+}
+
+func attacked(node Queen, solution []Queen) bool {
+	for _, q := range solution {
+		if q.Row == node.Row || q.Row-q.Column == node.Row-node.Column || q.Row+q.Column == node.Row+node.Column {
+			return true
+		}
+	}
+	return false
+}
+
+// queens returns the statistics of a search for the solutions of the
+// 6-queens problem. Accepted nodes are not expanded.
+func queens(concurrency int, strategy string) (res Stats) {
+	const n = 6
+	switch strategy {
+	case "depthFirst":
+		search Queen{0, 0}; Queen; concurrency {
+		children:
+			return queenChildren(n, node)
+		accept:
+			return len(solution) == n
+		reject:
+			return attacked(node, solution)
+		stats:
+			res = Stats(stats)
+		}
+	case "breadthFirst":
+		search Queen{0, 0}; Queen; concurrency {
+		strategy:
+			breadthFirst
+		children:
+			return queenChildren(n, node)
+		accept:
+			return len(solution) == n
+		reject:
+			return attacked(node, solution)
+		stats:
+			res = Stats(stats)
+		}
+	}
+	return
+}
+
+// Stats has the fields of the __SearchStats type declared by a search
+// statement.
+type Stats struct {
+	Expanded, Rejected, Pruned, Accepted, MaxDepth, Goroutines int
+	Lifetimes                                                  []int64
+	Limit                                                      string
+}
+
+// deepening counts the iterations of an iterative-deepening search
+// through the statistics.
+func deepening() (res Stats) {
+	search 0; int {
+	strategy:
+		iterativeDeepening(3)
+	children:
+		c := make(chan int, 1)
+		c <- node + 1
+		close(c)
+		return c
+	stats:
+		res = Stats(stats)
+	}
+	return
+}
+
+func main() {
+	for _, strategy := range []string{"depthFirst", "breadthFirst"} {
+		for _, concurrency := range []int{1, 4} {
+			s := queens(concurrency, strategy)
+			// There are 4 solutions and 148 safe placements of
+			// fewer queens, including the root.
+			if s.Accepted != 4 || s.Expanded != 149 || s.MaxDepth != 6 {
+				panic(fmt.Sprintf("%s, concurrency %d: got %+v", strategy, concurrency, s))
+			}
+			// Every expanded node has 6 children, each of which is
+			// rejected, accepted or expanded.
+			if s.Rejected != 6*s.Expanded-s.Accepted-s.Expanded+1 {
+				panic(fmt.Sprintf("%s, concurrency %d: rejected %d nodes", strategy, concurrency, s.Rejected))
+			}
+			if s.Goroutines < 1 || len(s.Lifetimes) != s.Goroutines {
+				panic(fmt.Sprintf("%s, concurrency %d: %d goroutines with %d lifetimes", strategy, concurrency, s.Goroutines, len(s.Lifetimes)))
+			}
+			for _, d := range s.Lifetimes {
+				if d < 0 {
+					panic(fmt.Sprintf("%s, concurrency %d: negative lifetime %d", strategy, concurrency, d))
+				}
+			}
+		}
+	}
+
+	// The depth-first engine starts .workers goroutines.
+	if s := queens(4, "depthFirst"); s.Goroutines != 4 {
+		panic(fmt.Sprintf("depth-first search with concurrency 4 ran %d goroutines", s.Goroutines))
+	}
+
+	// Three iterations expand 1, 2 and 3 nodes.
+	if s := deepening(); s.Expanded != 6 || s.MaxDepth != 3 || s.Goroutines != 3 {
+		panic(fmt.Sprintf("iterative deepening: got %+v", s))
+	}
+}
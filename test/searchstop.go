@@ -0,0 +1,147 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that calling stop in a search clause terminates the search
+// without leaking goroutines.
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+type Queen struct {
+	Column, Row int
+}
+
+// queens finds solutions to the n-queens problem, stopping after the
+// first one. The children of a node are produced by a goroutine on an
+// unbuffered channel, so the engine must drain them after stopping.
+func queens(n, concurrency int) [][]Queen {
+	found := make(chan []Queen, n*n*n)
+	search Queen{0, 0}; Queen; concurrency {
+	children:
+		c := make(chan Queen)
+		go func(column int) {
+			for r := 1; r <= n; r++ {
+				c <- Queen{column + 1, r}
+			}
+			close(c)
+		}(node.Column)
+		return c
+	accept:
+		if len(solution) == n {
+			found <- append([]Queen(nil), solution...)
+			stop()
+			return true
+		}
+		return false
+	reject:
+		for _, q := range solution {
+			if q.Row == node.Row || q.Row-q.Column == node.Row-node.Column || q.Row+q.Column == node.Row+node.Column {
+				return true
+			}
+		}
+		return false
+	}
+	close(found)
+	var res [][]Queen
+	for s := range found {
+		res = append(res, s)
+	}
+	return res
+}
+
+// stopped checks that the search stops before visiting all nodes when
+// stop is called in the children clause.
+func stopped() {
+	visited := 0
+	search 0; int {
+	children:
+		visited++
+		if visited == 10 {
+			stop()
+		}
+		c := make(chan int, 2)
+		c <- 2 * node
+		c <- 2*node + 1
+		close(c)
+		return c
+	}
+	if visited != 10 {
+		panic(fmt.Sprintf("visited %d nodes, want 10", visited))
+	}
+}
+
+// endless checks that a search stops when the children of its nodes
+// are produced by goroutines that would send them forever. They select
+// on done to give up once the search is stopped.
+func endless(concurrency int) {
+	var accepted int32
+	search 0; int; concurrency {
+	children:
+		c := make(chan int)
+		go func(n int, done <-chan struct{}) {
+			defer close(c)
+			for {
+				select {
+				case c <- n + 1:
+				case <-done:
+					return
+				}
+			}
+		}(node, done)
+		return c
+	accept:
+		if node == 3 {
+			if atomic.AddInt32(&accepted, 1) == 1 {
+				stop()
+			}
+			return true
+		}
+		return false
+	}
+	if accepted == 0 {
+		panic(fmt.Sprintf("concurrency %d: no node accepted", concurrency))
+	}
+}
+
+// checkGoroutines waits for the goroutines started by a search to exit.
+func checkGoroutines(want int) {
+	for i := 0; runtime.NumGoroutine() > want; i++ {
+		if i == 100 {
+			panic(fmt.Sprintf("%d goroutines still running, want %d", runtime.NumGoroutine(), want))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func main() {
+	base := runtime.NumGoroutine()
+
+	if res := queens(8, 1); len(res) != 1 {
+		panic(fmt.Sprintf("got %d solutions, want 1", len(res)))
+	}
+	checkGoroutines(base)
+
+	// Other engine goroutines may accept a solution before they
+	// notice that the search stopped, but not all 92 of them.
+	if res := queens(8, 8); len(res) == 0 || len(res) >= 92 {
+		panic(fmt.Sprintf("got %d solutions with concurrency 8", len(res)))
+	}
+	checkGoroutines(base)
+
+	stopped()
+	checkGoroutines(base)
+
+	for _, concurrency := range []int{1, 4} {
+		endless(concurrency)
+		checkGoroutines(base)
+	}
+}
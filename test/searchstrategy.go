@@ -0,0 +1,185 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test the breadth-first, best-first and iterative-deepening search
+// strategies.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// The test tree is the complete binary tree of heap indexes: the
+// children of node n are 2n and 2n+1.
+func binary(node, depth int) <-chan int {
+	c := make(chan int, 2)
+	if node < 1<<uint(depth) {
+		c <- 2 * node
+		c <- 2*node + 1
+	}
+	close(c)
+	return c
+}
+
+// levels returns the depth of each node in the order they are expanded
+// by a breadth-first search.
+func levels(concurrency int) []int {
+	var mu sync.Mutex
+	var order []int
+	search 1; int; concurrency {
+	strategy:
+		breadthFirst
+	children:
+		mu.Lock()
+		order = append(order, len(solution))
+		mu.Unlock()
+		return binary(node, 4)
+	}
+	return order
+}
+
+// bestOrder returns the nodes in the order a best-first search with a
+// serial engine expands them. Odd nodes are preferred, and deeper nodes
+// among them.
+func bestOrder() []int {
+	var order []int
+	search 1; int; 1 {
+	strategy:
+		bestFirst
+	children:
+		order = append(order, node)
+		return binary(node, 3)
+	priority:
+		if node%2 == 1 {
+			return -float64(node)
+		}
+		return float64(node)
+	}
+	return order
+}
+
+// shallowest returns the solutions leading to target, which is found
+// at depth 3.
+func shallowest(target, concurrency int, strategy string) [][]int {
+	var res [][]int
+	children := func(node int) <-chan int {
+		c := make(chan int, 2)
+		if node < target {
+			c <- 2 * node
+			c <- 2*node + 1
+		}
+		close(c)
+		return c
+	}
+	switch strategy {
+	case "breadthFirst":
+		search 1; int; concurrency {
+		strategy:
+			breadthFirst
+		children:
+			return children(node)
+		accept:
+			return node == target
+		ordered:
+			res = append(res, solution)
+		}
+	case "iterativeDeepening":
+		search 1; int; concurrency {
+		strategy:
+			iterativeDeepening
+		children:
+			return children(node)
+		accept:
+			return node == target
+		ordered:
+			res = append(res, solution)
+		}
+	}
+	return res
+}
+
+// bounded counts the iterations of a bounded iterative-deepening search
+// of an infinite tree. The negative node at each depth is a solution.
+func bounded(bound int) (iterations int, solutions []int) {
+	search 0; int {
+	strategy:
+		iterativeDeepening(bound)
+	children:
+		if node == 0 {
+			iterations++
+		}
+		c := make(chan int, 2)
+		c <- len(solution) + 1
+		c <- -len(solution) - 1
+		close(c)
+		return c
+	accept:
+		return node < 0
+	results:
+		solutions = append(solutions, node)
+	}
+	return
+}
+
+// stopped checks that a breadth-first search of an infinite tree ends
+// when stop is called.
+func stopped(concurrency int) {
+	var mu sync.Mutex
+	expanded := 0
+	search 0; int; concurrency {
+	strategy:
+		breadthFirst
+	children:
+		mu.Lock()
+		expanded++
+		if expanded == 100 {
+			stop()
+		}
+		mu.Unlock()
+		c := make(chan int, 2)
+		c <- node
+		c <- node
+		close(c)
+		return c
+	}
+	if expanded < 100 || expanded >= 100+concurrency {
+		panic(fmt.Sprintf("concurrency %d: expanded %d nodes after stopping at 100", concurrency, expanded))
+	}
+}
+
+func main() {
+	for _, concurrency := range []int{1, 3, 8} {
+		want := []int{0, 1, 1, 2, 2, 2, 2, 3, 3, 3, 3, 3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4}
+		if got := levels(concurrency); !reflect.DeepEqual(got, want) {
+			panic(fmt.Sprintf("concurrency %d: breadth-first levels %v, want %v", concurrency, got, want))
+		}
+	}
+
+	if got, want := bestOrder(), []int{1, 3, 7, 15, 2, 5, 11, 4, 9, 6, 13, 8, 10, 12, 14}; !reflect.DeepEqual(got, want) {
+		panic(fmt.Sprintf("best-first order %v, want %v", got, want))
+	}
+
+	// Node 13 is reached from the root by the path 3, 6, 13 only.
+	for _, strategy := range []string{"breadthFirst", "iterativeDeepening"} {
+		for _, concurrency := range []int{1, 4} {
+			if got, want := shallowest(13, concurrency, strategy), [][]int{{3, 6, 13}}; !reflect.DeepEqual(got, want) {
+				panic(fmt.Sprintf("%s, concurrency %d: got %v, want %v", strategy, concurrency, got, want))
+			}
+		}
+	}
+
+	// Each iteration delivers the new solution at its depth limit.
+	if iterations, solutions := bounded(5); iterations != 5 || !reflect.DeepEqual(solutions, []int{-1, -2, -3, -4, -5}) {
+		panic(fmt.Sprintf("bounded search: %d iterations, solutions %v", iterations, solutions))
+	}
+
+	for _, concurrency := range []int{1, 4} {
+		stopped(concurrency)
+	}
+}
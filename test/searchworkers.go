@@ -0,0 +1,61 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that a concurrency below 1 at run time runs a search on one
+// engine goroutine.
+
+package main
+
+import "fmt"
+
+// leaves counts the 16 leaves of a complete binary tree of depth 4,
+// searched with the given concurrency, and returns the number of engine
+// goroutines started.
+func leaves(concurrency int) (n, goroutines int) {
+	search 0; int; concurrency {
+	children:
+		if len(solution) == 4 {
+			return nil
+		}
+		return []int{2*node + 1, 2*node + 2}
+	accept:
+		return len(solution) == 4
+	results:
+		n++
+	stats:
+		goroutines = stats.Goroutines
+	}
+	return n, goroutines
+}
+
+func main() {
+	for _, c := range []int{-1, 0, 1} {
+		n, g := leaves(c)
+		if n != 16 || g != 1 {
+			panic(fmt.Sprintf("concurrency %d: %d leaves on %d goroutines, want 16 on 1", c, n, g))
+		}
+	}
+	if n, _ := leaves(4); n != 16 {
+		panic(fmt.Sprintf("concurrency 4: %d leaves, want 16", n))
+	}
+
+	var max int8 = -3
+	n := 0
+	search 0; int; max {
+	children:
+		if node > 0 {
+			return nil
+		}
+		return []int{1, 2}
+	accept:
+		return node > 0
+	results:
+		n++
+	}
+	if n != 2 {
+		panic(fmt.Sprintf("concurrency int8(-3): %d results, want 2", n))
+	}
+}